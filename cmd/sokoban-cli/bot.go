@@ -0,0 +1,91 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/csixteen/sokoban/pkg/bot"
+)
+
+// runBot has an external program play the levels, through the text
+// protocol of package bot on the standard input and output. It fails if
+// any level isn't solved.
+func runBot(args []string) int {
+	fs := flag.NewFlagSet("bot", flag.ExitOnError)
+	levelsPath := fs.String("levels", "", "file or directory with the levels (defaults to the embedded ones)")
+	level := fs.Int("level", 0, "level to play, starting at 1 (defaults to all of them, in order)")
+	moveTime := fs.Duration("move-time", 10*time.Second, "time the agent has to send each command (0 for no limit)")
+	totalTime := fs.Duration("total-time", 10*time.Minute, "time the agent has to solve each level (0 for no limit)")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: sokoban-cli bot [-levels path] [-level n] [-move-time d] [-total-time d]")
+		fmt.Fprintln(fs.Output(), "\nPlays the levels with an agent that talks on the standard input and output.")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	allLevels, err := readLevels(*levelsPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+
+	if *level < 0 || *level > len(allLevels) {
+		fmt.Fprintf(os.Stderr, "There's no level %d, there are %d levels\n", *level, len(allLevels))
+		return 2
+	}
+	if *level > 0 {
+		allLevels = allLevels[*level-1 : *level]
+	}
+
+	cfg := bot.Config{MoveTime: *moveTime, TotalTime: *totalTime}
+	conn := bot.NewConn(os.Stdin, os.Stdout)
+
+	failed := 0
+	for _, l := range allLevels {
+		res, err := conn.Play(l, cfg)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "level %d: %v\n", l.ID, err)
+			failed++
+			continue
+		}
+
+		fmt.Fprintf(os.Stderr, "level %d: %s after %d moves and %d pushes (%v)\n",
+			l.ID, res.End, res.Moves, res.Pushes, res.Elapsed.Round(time.Millisecond))
+		if res.End != bot.Solved {
+			failed++
+		}
+		if res.End == bot.Quit {
+			break
+		}
+	}
+
+	if failed > 0 {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,95 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/csixteen/sokoban/pkg/game"
+	"github.com/csixteen/sokoban/pkg/levels"
+)
+
+// lint checks the levels in the given files or directories, or the
+// embedded ones if none is given, and reports every problem found on
+// them. It fails if there's any.
+func lint(args []string) int {
+	fs := flag.NewFlagSet("lint", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: sokoban-cli lint [path ...]")
+		fmt.Fprintln(fs.Output(), "\nChecks the levels in each file or directory, or the embedded levels if none is given.")
+	}
+	fs.Parse(args)
+
+	paths := fs.Args()
+	if len(paths) == 0 {
+		paths = []string{""}
+	}
+
+	total, failed := 0, 0
+	for _, path := range paths {
+		allLevels, err := readLevels(path)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 2
+		}
+
+		name := path
+		if name == "" {
+			name = LevelsPath
+		}
+
+		for _, level := range allLevels {
+			total++
+			if !lintLevel(name, level) {
+				failed++
+			}
+		}
+	}
+
+	if failed > 0 {
+		fmt.Printf("%d of %d levels have problems\n", failed, total)
+		return 1
+	}
+
+	fmt.Printf("All %d levels are fine\n", total)
+	return 0
+}
+
+// lintLevel prints the problems found on a level, if any, and tells
+// whether there were none.
+func lintLevel(name string, level *levels.Level) bool {
+	errs := game.Validate(level.Lines)
+
+	desc := fmt.Sprintf("level %d", level.ID)
+	if level.Title != "" {
+		desc += fmt.Sprintf(" (%s)", level.Title)
+	}
+
+	for _, err := range errs {
+		fmt.Printf("%s: %s: %v\n", name, desc, err)
+	}
+
+	return len(errs) == 0
+}
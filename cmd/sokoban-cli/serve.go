@@ -0,0 +1,66 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/csixteen/sokoban/pkg/server"
+)
+
+// serve serves the levels over HTTP with the JSON API of package
+// server. It only listens on localhost, so that the game can't be
+// reached from other machines.
+func serve(args []string) int {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	levelsPath := fs.String("levels", "", "file or directory with the levels (defaults to the embedded ones)")
+	port := fs.Int("port", 8080, "port to listen on")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: sokoban-cli serve [-levels path] [-port n]")
+		fmt.Fprintln(fs.Output(), "\nServes the levels over HTTP on localhost, with a JSON API.")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	allLevels, err := readLevels(*levelsPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+
+	addr := net.JoinHostPort("127.0.0.1", strconv.Itoa(*port))
+	log.Printf("Serving %d levels on http://%s", len(allLevels), addr)
+
+	if err := http.ListenAndServe(addr, server.New(allLevels)); err != nil {
+		log.Print(err)
+		return 1
+	}
+
+	return 0
+}
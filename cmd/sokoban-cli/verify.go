@@ -0,0 +1,194 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/csixteen/sokoban/pkg/game"
+	"github.com/csixteen/sokoban/pkg/levels"
+)
+
+// verify replays a file of solutions against a collection of levels,
+// and reports which of them are valid. It fails if any solution is
+// invalid, or if any level has no solution.
+func verify(args []string) int {
+	fs := flag.NewFlagSet("verify", flag.ExitOnError)
+	levelsPath := fs.String("levels", "", "file or directory with the levels (defaults to the embedded ones)")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: sokoban-cli verify [-levels path] solutions")
+		fmt.Fprintln(fs.Output(), "\nEach line of the solutions file has the number of a level followed by its solution in LURD.")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return 2
+	}
+
+	allLevels, err := readLevels(*levelsPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+
+	file, err := os.Open(fs.Arg(0))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+	defer file.Close()
+
+	solutions, err := readSolutions(file)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", fs.Arg(0), err)
+		return 2
+	}
+
+	failed := 0
+	for level := 1; level <= len(allLevels); level++ {
+		moves, ok := solutions[level]
+		if !ok {
+			fmt.Printf("Level %d: MISSING, no solution given\n", level)
+			failed++
+			continue
+		}
+		delete(solutions, level)
+
+		if !verifyLevel(allLevels[level-1], moves) {
+			failed++
+		}
+	}
+
+	var unknown []int
+	for level := range solutions {
+		unknown = append(unknown, level)
+	}
+	sort.Ints(unknown)
+	for _, level := range unknown {
+		fmt.Printf("Level %d: INVALID, no such level\n", level)
+		failed++
+	}
+
+	if failed > 0 {
+		fmt.Printf("%d of %d levels failed verification\n", failed, len(allLevels)+len(unknown))
+		return 1
+	}
+
+	fmt.Printf("All %d levels verified\n", len(allLevels))
+	return 0
+}
+
+// verifyLevel replays a solution, reports the result and tells whether
+// the solution is valid.
+func verifyLevel(l *levels.Level, moves string) bool {
+	level := l.ID
+	board, err := levels.NewBoard(l)
+	if err != nil {
+		fmt.Printf("Level %d: INVALID, level can't be played: %v\n", level, err)
+		return false
+	}
+
+	solved, err := game.Replay(board, moves)
+
+	var re *game.ReplayError
+	switch {
+	case errors.As(err, &re):
+		row, col := board.Player()
+		fmt.Printf(
+			"Level %d: INVALID, %v, with the player at row %d, column %d\n",
+			level,
+			err,
+			row+1,
+			col+1,
+		)
+		return false
+	case !solved:
+		fmt.Printf(
+			"Level %d: INVALID, level isn't solved after %d moves, %d pushes\n",
+			level,
+			board.Moves(),
+			board.Pushes(),
+		)
+		return false
+	}
+
+	fmt.Printf("Level %d: valid, %d moves, %d pushes\n", level, board.Moves(), board.Pushes())
+	return true
+}
+
+// readLevels reads the levels from a file or directory, or the
+// embedded levels if no path is given.
+func readLevels(path string) ([]*levels.Level, error) {
+	if path == "" {
+		return levels.Embedded()
+	}
+
+	c, err := levels.Load(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Levels, nil
+}
+
+// readSolutions reads a file where each line has the number of a level,
+// starting at 1, followed by its solution. Blank lines and lines
+// starting with `#` or `;` are ignored.
+func readSolutions(r io.Reader) (map[int]string, error) {
+	solutions := make(map[int]string)
+
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' || line[0] == ';' {
+			continue
+		}
+
+		id, moves := line, ""
+		if i := strings.IndexAny(line, " \t"); i >= 0 {
+			id, moves = line[:i], line[i+1:]
+		}
+
+		level, err := strconv.Atoi(strings.TrimSuffix(id, ":"))
+		if err != nil || level < 1 {
+			return nil, fmt.Errorf("line %d: invalid level %q", n, id)
+		}
+		if _, ok := solutions[level]; ok {
+			return nil, fmt.Errorf("line %d: level %d has more than one solution", n, level)
+		}
+
+		solutions[level] = moves
+	}
+
+	return solutions, scanner.Err()
+}
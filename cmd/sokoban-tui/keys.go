@@ -0,0 +1,113 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package main
+
+import (
+	"io"
+)
+
+type key int
+
+const (
+	keyOther key = iota
+	keyUp
+	keyDown
+	keyLeft
+	keyRight
+	keyUndo
+	keyRedo
+	keyReset
+	keyNext
+	keyPrevious
+	keyQuit
+)
+
+var keyBytes = map[byte]key{
+	'u':  keyUndo,
+	0x12: keyRedo, // Ctrl+R
+	'r':  keyReset,
+	'n':  keyNext,
+	'p':  keyPrevious,
+	'q':  keyQuit,
+	0x03: keyQuit, // Ctrl+C, since the terminal is in raw mode
+	// Vim keys, just like the player characters
+	'h': keyLeft,
+	'j': keyDown,
+	'k': keyUp,
+	'l': keyRight,
+}
+
+// Final byte of the escape sequences sent by the arrow keys.
+var arrowBytes = map[byte]key{
+	'A': keyUp,
+	'B': keyDown,
+	'C': keyRight,
+	'D': keyLeft,
+}
+
+// readKeys reads key presses from r until it's closed, and sends
+// them on the returned channel.
+func readKeys(r io.Reader) <-chan key {
+	keys := make(chan key)
+
+	go func() {
+		defer close(keys)
+
+		buf := make([]byte, 64)
+		for {
+			n, err := r.Read(buf)
+			for _, k := range parseKeys(buf[:n]) {
+				keys <- k
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	return keys
+}
+
+// parseKeys turns the bytes read from the terminal into key presses.
+// Arrow keys are sent as either `ESC [ x` or `ESC O x`.
+func parseKeys(buf []byte) []key {
+	var res []key
+
+	for i := 0; i < len(buf); i++ {
+		if buf[i] == 0x1b && i+2 < len(buf) && (buf[i+1] == '[' || buf[i+1] == 'O') {
+			if k, ok := arrowBytes[buf[i+2]]; ok {
+				res = append(res, k)
+			}
+			i += 2
+			continue
+		}
+
+		if k, ok := keyBytes[buf[i]]; ok {
+			res = append(res, k)
+		} else {
+			res = append(res, keyOther)
+		}
+	}
+
+	return res
+}
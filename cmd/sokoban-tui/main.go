@@ -0,0 +1,151 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/csixteen/sokoban/pkg/game"
+	"github.com/csixteen/sokoban/pkg/levels"
+)
+
+// tui holds the state of the game being played on the terminal.
+type tui struct {
+	allLevels     []*levels.Level
+	currentLevel  int
+	board         *game.Board // nil if the current level can't be played
+	err           error       // Why the current level can't be played
+	levelStart    time.Time
+	solved        bool // The current level was solved, waiting for a key
+	width, height int  // Size of the terminal
+}
+
+func (t *tui) startLevel(level int) {
+	t.currentLevel = level
+	t.board, t.err = levels.NewBoard(t.allLevels[level])
+	t.levelStart = time.Now()
+	t.solved = false
+}
+
+// handleKey applies a key press to the game, and tells whether the
+// game should go on.
+func (t *tui) handleKey(k key) bool {
+	if k == keyQuit {
+		return false
+	}
+
+	if t.solved {
+		if t.currentLevel+1 == len(t.allLevels) {
+			return false
+		}
+		t.startLevel(t.currentLevel + 1)
+		return true
+	}
+
+	switch k {
+	case keyNext:
+		if t.currentLevel+1 < len(t.allLevels) {
+			t.startLevel(t.currentLevel + 1)
+		}
+		return true
+	case keyPrevious:
+		if t.currentLevel > 0 {
+			t.startLevel(t.currentLevel - 1)
+		}
+		return true
+	}
+
+	// Nothing else can be done on levels that can't be played.
+	if t.board == nil {
+		return true
+	}
+
+	switch k {
+	case keyUp:
+		t.board.MoveUp()
+	case keyDown:
+		t.board.MoveDown()
+	case keyLeft:
+		t.board.MoveLeft()
+	case keyRight:
+		t.board.MoveRight()
+	case keyUndo:
+		t.board.Undo()
+	case keyRedo:
+		t.board.Redo()
+	case keyReset:
+		t.board.Reset()
+	}
+
+	t.solved = t.board.IsVictory()
+	return true
+}
+
+func (t *tui) run() error {
+	restore, err := makeRaw()
+	if err != nil {
+		return err
+	}
+	defer restore()
+
+	fmt.Print(enterScreen)
+	defer fmt.Print(leaveScreen)
+
+	t.width, t.height = terminalSize()
+	keys := readKeys(os.Stdin)
+	resized := watchResize()
+	second := time.NewTicker(time.Second)
+	defer second.Stop()
+
+	for {
+		fmt.Print(t.render())
+
+		select {
+		case k, ok := <-keys:
+			if !ok || !t.handleKey(k) {
+				return nil
+			}
+		case <-resized:
+			t.width, t.height = terminalSize()
+		case <-second.C:
+		}
+	}
+}
+
+func main() {
+	allLevels, err := levels.Embedded()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	t := &tui{allLevels: allLevels}
+	t.startLevel(0)
+
+	if err := t.run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,121 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/csixteen/sokoban/pkg/game"
+	"github.com/csixteen/sokoban/pkg/utils"
+)
+
+const (
+	enterScreen = "\x1b[?1049h\x1b[?25l" // Alternate screen, hidden cursor
+	leaveScreen = "\x1b[?25h\x1b[?1049l"
+	clearScreen = "\x1b[H\x1b[2J"
+	resetColor  = "\x1b[0m"
+)
+
+// Each cell takes two columns of the terminal, so that it looks
+// roughly square.
+var cellStyles = map[rune]string{
+	'w': "\x1b[44m  ",
+	'b': "\x1b[1;33m[]",
+	'o': "\x1b[1;32m[]",
+	'g': "\x1b[31m()",
+	'h': "\x1b[1;36m<@",
+	'j': "\x1b[1;36m@@",
+	'k': "\x1b[1;36m@@",
+	'l': "\x1b[1;36m@>",
+}
+
+// render draws the whole screen: the board centered on the terminal,
+// with the status of the level above it and the controls below it.
+func (t *tui) render() string {
+	var sb strings.Builder
+	sb.WriteString(clearScreen)
+
+	if t.board == nil {
+		fmt.Fprintf(&sb, "Level %d/%d can't be played: %v\r\n", t.currentLevel+1, len(t.allLevels), t.err)
+		sb.WriteString("n/p: level  q: quit")
+		return sb.String()
+	}
+
+	width, height := t.board.Bounds()
+	top := (t.height - height - 4) / 2
+	left := (t.width - width*2) / 2
+	if top < 0 || left < 0 {
+		sb.WriteString("Terminal is too small for this level.\r\n")
+		sb.WriteString("Please resize it, or press q to quit.")
+		return sb.String()
+	}
+
+	line := func(row int, s string) {
+		fmt.Fprintf(&sb, "\x1b[%d;%dH%s", top+row+1, left+1, s)
+	}
+
+	line(0, fmt.Sprintf(
+		"Level %d/%d  Moves: %d  Pushes: %d  Time: %s",
+		t.currentLevel+1,
+		len(t.allLevels),
+		t.board.Moves(),
+		t.board.Pushes(),
+		utils.FormatElapsed(time.Since(t.levelStart)),
+	))
+
+	deadlocked := make(map[game.Cell]bool)
+	for _, cell := range t.board.DeadlockedBlocks() {
+		deadlocked[cell] = true
+	}
+
+	for row := 0; row < height; row++ {
+		var cells strings.Builder
+		for col := 0; col < width; col++ {
+			elem, _ := t.board.Get(row, col)
+			style, ok := cellStyles[elem]
+			if !ok {
+				style = "  "
+			}
+			if t.board.IsGoal(row, col) && elem != 'g' && elem != 'o' {
+				style = "\x1b[41m" + style // Player on a goal
+			}
+			if deadlocked[game.Cell{Row: row, Col: col}] {
+				style = "\x1b[1;31m[]"
+			}
+			cells.WriteString(style + resetColor)
+		}
+		line(row+2, cells.String())
+	}
+
+	if t.solved {
+		line(height+3, "Level solved! Press any key to continue.")
+	} else if t.board.IsDeadlocked() {
+		line(height+3, "Deadlock! Press u to undo or r to reset.")
+	} else {
+		line(height+3, "arrows: move  u: undo  ^R: redo  r: reset  n/p: level  q: quit")
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,83 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build !windows
+// +build !windows
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strings"
+	"syscall"
+)
+
+// makeRaw puts the terminal in raw mode, so that keys are read as
+// soon as they're pressed and aren't echoed. It returns a function
+// that restores the previous mode.
+func makeRaw() (func(), error) {
+	state, err := stty("-g")
+	if err != nil {
+		return nil, fmt.Errorf("can't read terminal mode: %v", err)
+	}
+
+	if _, err := stty("raw", "-echo"); err != nil {
+		return nil, fmt.Errorf("can't set terminal to raw mode: %v", err)
+	}
+
+	return func() {
+		stty(strings.TrimSpace(state))
+	}, nil
+}
+
+// terminalSize returns the number of columns and rows of the terminal.
+func terminalSize() (int, int) {
+	var rows, cols int
+
+	out, err := stty("size")
+	if err == nil {
+		fmt.Sscan(out, &rows, &cols)
+	}
+	if rows == 0 || cols == 0 {
+		return 80, 24
+	}
+
+	return cols, rows
+}
+
+// watchResize returns a channel that receives a value every time the
+// terminal is resized.
+func watchResize() <-chan os.Signal {
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, syscall.SIGWINCH)
+	return c
+}
+
+func stty(args ...string) (string, error) {
+	cmd := exec.Command("stty", args...)
+	cmd.Stdin = os.Stdin
+	out, err := cmd.Output()
+	return string(out), err
+}
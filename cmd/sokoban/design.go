@@ -0,0 +1,185 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/csixteen/sokoban/pkg/game"
+	"github.com/csixteen/sokoban/pkg/levels"
+	"github.com/faiface/pixel"
+	"github.com/faiface/pixel/pixelgl"
+)
+
+// DesignInterval is how often the levels are checked for changes, in
+// designer mode.
+const DesignInterval = time.Second
+
+var (
+	designPath     string    // Levels being designed, if in designer mode
+	designStamp    string    // Identifies the version of the levels last seen
+	designErr      error     // Why the levels couldn't be reloaded, if they couldn't
+	designWarnings []string  // Problems with the levels, which don't keep them from being played
+	lastCheck      time.Time // Last time the levels were checked for changes
+)
+
+// startDesigning enters designer mode, in which the levels on the
+// given path are reloaded whenever they change on disk.
+func startDesigning(path string) {
+	stamp, err := levelsStamp(path)
+	if err != nil {
+		log.Printf("Can't watch levels for changes: %v", err)
+		return
+	}
+
+	designPath = path
+	designStamp = stamp
+	lastCheck = time.Now()
+}
+
+// checkLevels reloads the levels if they changed since they were last
+// checked, rebuilding the board of the current level. If they can't be
+// reloaded, the game goes on as it was, and the error is shown on
+// screen until they're fixed.
+func checkLevels(win *pixelgl.Window) {
+	if designPath == "" || time.Since(lastCheck) < DesignInterval {
+		return
+	}
+	lastCheck = time.Now()
+
+	stamp, err := levelsStamp(designPath)
+	if err != nil {
+		designErr = err
+		return
+	}
+	if stamp == designStamp {
+		return
+	}
+	designStamp = stamp
+
+	designErr = reloadLevels(win)
+}
+
+// reloadLevels reads the levels again and rebuilds the current board
+// from scratch. Only the current level has to be playable: problems
+// with the others, or with the current one that don't keep it from
+// being played, are shown as warnings.
+func reloadLevels(win *pixelgl.Window) error {
+	c, err := levels.Load(designPath)
+	if err != nil {
+		return err
+	}
+	if len(c.Levels) == 0 {
+		return errors.New("there are no levels")
+	}
+
+	current := currentLevel
+	if current >= len(c.Levels) {
+		current = len(c.Levels) - 1
+	}
+	if _, err := levels.NewBoard(c.Levels[current]); err != nil {
+		return fmt.Errorf("level %d: %w", c.Levels[current].ID, err)
+	}
+
+	boards := make([]*game.Board, len(c.Levels))
+	designWarnings = nil
+	for i, level := range c.Levels {
+		boards[i], _ = levels.NewBoard(level)
+		if errs := game.Validate(level.Lines); len(errs) > 0 {
+			warning := fmt.Sprintf("level %d: %v", level.ID, errs[0])
+			designWarnings = append(designWarnings, warning)
+			log.Printf("%s: %s", designPath, warning)
+		}
+	}
+
+	allLevels = c.Levels
+	menuBoards = boards
+	currentLevel = current
+
+	if inMenu {
+		selected := menuSelection
+		if selected >= len(allLevels) {
+			selected = len(allLevels) - 1
+		}
+		openMenu(win, selected)
+		return nil
+	}
+
+	// The current level was just built, so this can't fail.
+	board, _ = levels.NewBoard(allLevels[current])
+	dropHint()
+	walkPath = nil
+	selected = nil
+
+	size := newView(board).size()
+	win.SetBounds(pixel.R(0, 0, size.X, size.Y))
+
+	return nil
+}
+
+// drawDesignError shows why the levels couldn't be reloaded, or else
+// what's wrong with them.
+func drawDesignError(win *pixelgl.Window) {
+	switch {
+	case designErr != nil:
+		drawHUDLine(win, 4, "Can't reload levels: %v", designErr)
+	case len(designWarnings) == 1:
+		drawHUDLine(win, 4, "Warning: %s", designWarnings[0])
+	case len(designWarnings) > 1:
+		drawHUDLine(win, 4, "Warning: %s (and %d more)", designWarnings[0], len(designWarnings)-1)
+	}
+}
+
+// levelsStamp returns something that changes whenever the levels on
+// the given path do: the modification time and size of the file, or
+// of every file in the directory.
+func levelsStamp(path string) (string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", err
+	}
+
+	if !info.IsDir() {
+		return fmt.Sprintf("%v %d", info.ModTime(), info.Size()), nil
+	}
+
+	entries, err := ioutil.ReadDir(path)
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	for _, entry := range entries {
+		if !entry.IsDir() && !strings.HasPrefix(entry.Name(), ".") {
+			fmt.Fprintf(&sb, "%s %v %d\n", entry.Name(), entry.ModTime(), entry.Size())
+		}
+	}
+
+	return sb.String(), nil
+}
@@ -0,0 +1,185 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package main
+
+import (
+	"time"
+	"unicode"
+
+	"github.com/csixteen/sokoban/pkg/game"
+	"github.com/csixteen/sokoban/pkg/solver"
+	"github.com/faiface/pixel"
+	"github.com/faiface/pixel/imdraw"
+	"github.com/faiface/pixel/pixelgl"
+)
+
+// Limits of the search for a hint
+const (
+	HintTimeout   = 10 * time.Second
+	HintMaxNodes  = 500000
+	HintMaxMemory = 512 << 20
+)
+
+var spinner = []rune{'|', '/', '-', '\\'}
+
+// hint is a request for the solution of the board, which is searched
+// for in the background.
+type hint struct {
+	moves   string // Moves made on the board when the hint was asked for
+	started time.Time
+	stop    chan struct{} // Closed to stop the search
+	done    chan struct{}
+
+	// Only to be read once done is closed
+	solution string
+	err      error
+}
+
+var currentHint *hint
+
+// askHint starts searching for the solution of the board in its current
+// state, and stops the search for any previous hint. The search runs on
+// its own goroutine, so that the main loop doesn't block, and gives up
+// when it reaches any of the hint limits.
+func askHint(board *game.Board) {
+	if currentHint != nil && currentHint.moves == board.LURD() {
+		return
+	}
+	dropHint()
+
+	h := &hint{
+		moves:   board.LURD(),
+		started: time.Now(),
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+
+	problem := solver.NewProblem(board)
+	opts := solver.Options{
+		MaxNodes:  HintMaxNodes,
+		MaxMemory: HintMaxMemory,
+		Timeout:   HintTimeout,
+		Stop:      h.stop,
+	}
+	go func() {
+		h.solution, _, h.err = problem.Solve(opts)
+		close(h.done)
+	}()
+
+	currentHint = h
+}
+
+// dropHint forgets the current hint, stopping its search if it's still
+// running.
+func dropHint() {
+	if currentHint != nil {
+		close(currentHint.stop)
+		currentHint = nil
+	}
+}
+
+// drawHint highlights the block that should be pushed next, and the
+// direction it should be pushed to. The hint is dropped as soon as the
+// player makes or undoes a move.
+func drawHint(win *pixelgl.Window, board *game.Board) {
+	h := currentHint
+	if h == nil {
+		return
+	}
+	if h.moves != board.LURD() {
+		dropHint()
+		return
+	}
+
+	select {
+	case <-h.done:
+	default:
+		frame := int(time.Since(h.started)/(150*time.Millisecond)) % len(spinner)
+		drawHUDLine(win, 1, "Looking for a hint %c", spinner[frame])
+		return
+	}
+
+	if h.err != nil {
+		drawHUDLine(win, 1, "No hint: %v", h.err)
+		return
+	}
+
+	row, col, d, ok := nextPush(board, h.solution)
+	if !ok {
+		return
+	}
+
+	nRow, nCol := row, col
+	switch d {
+	case game.Up:
+		nRow--
+	case game.Down:
+		nRow++
+	case game.Left:
+		nCol--
+	case game.Right:
+		nCol++
+	}
+
+	v := newView(board)
+	from := v.cellCenter(row, col)
+	to := v.cellCenter(nRow, nCol)
+	half := pixel.V(TileSize/2, TileSize/2)
+
+	imd := imdraw.New(nil)
+	imd.Color = pixel.RGB(1, 0.8, 0)
+	imd.Push(from.Sub(half), from.Add(half))
+	imd.Rectangle(4)
+	imd.Push(from, to)
+	imd.Line(6)
+	imd.Push(to)
+	imd.Circle(10, 0)
+	imd.Draw(win)
+}
+
+// nextPush finds the first push of a solution in LURD notation, and
+// returns the position of the block to be pushed and the direction of
+// the push.
+func nextPush(board *game.Board, solution string) (int, int, game.Direction, bool) {
+	row, col := board.Player()
+
+	for _, c := range solution {
+		var d game.Direction
+		switch unicode.ToLower(c) {
+		case 'u':
+			d, row = game.Up, row-1
+		case 'd':
+			d, row = game.Down, row+1
+		case 'l':
+			d, col = game.Left, col-1
+		case 'r':
+			d, col = game.Right, col+1
+		}
+
+		if unicode.IsUpper(c) {
+			return row, col, d, true
+		}
+	}
+
+	return -1, -1, game.Up, false
+}
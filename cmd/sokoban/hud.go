@@ -0,0 +1,63 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/csixteen/sokoban/pkg/game"
+	"github.com/csixteen/sokoban/pkg/utils"
+	"github.com/faiface/pixel"
+	"github.com/faiface/pixel/pixelgl"
+	"github.com/faiface/pixel/text"
+	"golang.org/x/image/font/basicfont"
+)
+
+var hudAtlas = text.NewAtlas(basicfont.Face7x13, text.ASCII)
+
+// drawHUD draws the statistics of the current level on the
+// top-left corner of the window, along with a warning when the
+// level can no longer be solved.
+func drawHUD(win *pixelgl.Window, board *game.Board, elapsed time.Duration) {
+	drawHUDLine(
+		win,
+		0,
+		"Moves: %d  Pushes: %d  Time: %s",
+		board.Moves(),
+		board.Pushes(),
+		utils.FormatElapsed(elapsed),
+	)
+
+	if board.IsDeadlocked() {
+		drawHUDLine(win, 2, "Deadlock! Press u to undo or r to reset")
+	}
+}
+
+// drawHUDLine draws a line of text on the top-left corner of the
+// window, with line 0 being the topmost.
+func drawHUDLine(win *pixelgl.Window, line int, p string, args ...interface{}) {
+	hud := text.New(pixel.V(8, win.Bounds().H()-20-float64(line)*hudAtlas.LineHeight()), hudAtlas)
+	fmt.Fprintf(hud, p, args...)
+	hud.Draw(win, pixel.IM)
+}
@@ -23,13 +23,18 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"image"
+	"log"
+	"os"
+	"strings"
 	"time"
 
 	_ "image/png"
 
 	"github.com/csixteen/sokoban/pkg/game"
+	"github.com/csixteen/sokoban/pkg/levels"
 	"github.com/faiface/pixel"
 	"github.com/faiface/pixel/pixelgl"
 	"github.com/faiface/pixel/text"
@@ -52,11 +57,11 @@ var (
 )
 
 var (
-	allLevels    [][]string
+	allLevels    []*levels.Level
 	currentLevel = 0
+	levelStart   time.Time
+	levelElapsed time.Duration // Time spent on the level before it was resumed
 	board        *game.Board
-	boardWidth   int
-	boardHeight  int
 )
 
 var CharToTile = map[rune]int{
@@ -65,10 +70,10 @@ var CharToTile = map[rune]int{
 	'g': 98,
 	'o': 14,
 	// Char directions (Vim keys)
-	'h': 2,
-	'j': 0,
-	'k': 24,
-	'l': 26,
+	'h': 24,
+	'j': 2,
+	'k': 26,
+	'l': 0,
 }
 
 func loadPicture(path string) (pixel.Picture, error) {
@@ -88,22 +93,32 @@ func loadPicture(path string) (pixel.Picture, error) {
 
 func detectKeyPress(w *pixelgl.Window, board *game.Board) {
 	if w.JustPressed(pixelgl.KeyLeft) {
-		board.MoveUp()
+		board.MoveLeft()
 	}
 	if w.JustPressed(pixelgl.KeyRight) {
-		board.MoveDown()
+		board.MoveRight()
 	}
 	if w.JustPressed(pixelgl.KeyDown) {
-		board.MoveLeft()
+		board.MoveDown()
 	}
 	if w.JustPressed(pixelgl.KeyUp) {
-		board.MoveRight()
+		board.MoveUp()
 	}
 	if w.JustPressed(pixelgl.KeyQ) {
 		w.SetClosed(true)
 	}
 	if w.JustPressed(pixelgl.KeyR) {
-		board.Reset()
+		if w.Pressed(pixelgl.KeyLeftControl) || w.Pressed(pixelgl.KeyRightControl) {
+			board.Redo()
+		} else {
+			board.Reset()
+		}
+	}
+	if w.JustPressed(pixelgl.KeyU) {
+		board.Undo()
+	}
+	if strings.Contains(w.Typed(), "?") {
+		askHint(board)
 	}
 }
 
@@ -115,36 +130,66 @@ func drawBoard(
 	board *game.Board,
 ) {
 	batch.Clear()
+	drawCells(batch, sprites, frames, board, pixel.IM)
+	batch.Draw(win)
+}
 
-	for row := 0; row < boardHeight; row += 1 {
-		for col := 0; col < boardWidth; col += 1 {
+// drawCells draws the elements of the board onto the batch, with the
+// given matrix applied on top of the position of each cell.
+func drawCells(
+	batch *pixel.Batch,
+	sprites pixel.Picture,
+	frames []pixel.Rect,
+	board *game.Board,
+	m pixel.Matrix,
+) {
+	v := newView(board)
+
+	deadlocked := make(map[game.Cell]bool)
+	for _, cell := range board.DeadlockedBlocks() {
+		deadlocked[cell] = true
+	}
+
+	for row := 0; row < v.height; row += 1 {
+		for col := 0; col < v.width; col += 1 {
 			val, _ := board.Get(row, col)
 			if tile, ok := CharToTile[val]; ok {
 				elem := pixel.NewSprite(sprites, frames[tile])
-				r := float64(row*TileSize) + TileSize/2
-				c := float64(col*TileSize) + TileSize/2
-				elem.Draw(batch, pixel.IM.Moved(pixel.V(r, c)))
+				pos := pixel.IM.Moved(v.cellCenter(row, col)).Chained(m)
+				if deadlocked[game.Cell{Row: row, Col: col}] {
+					elem.DrawColorMask(batch, pos, colornames.Red)
+				} else {
+					elem.Draw(batch, pos)
+				}
 			}
 		}
 	}
-
-	batch.Draw(win)
 }
 
+// displayText clears the window and shows some text centered on it,
+// for the given number of seconds. The first line is shown larger than
+// the rest.
 func displayText(win *pixelgl.Window, duration int, p string, args ...interface{}) {
-	dt := float64(len(p)) * 13 / 2 // 13 because Face7x13
 	basicAtlas := text.NewAtlas(basicfont.Face7x13, text.ASCII)
-	basicTxt := text.New(
-		pixel.V(
-			float64((boardHeight*TileSize)/2)-dt,
-			float64((boardWidth*TileSize)/2),
-		),
-		basicAtlas,
-	)
-	fmt.Fprintf(basicTxt, p, args...)
+	lines := strings.Split(fmt.Sprintf(p, args...), "\n")
 
 	win.Clear(colornames.Black)
-	basicTxt.Draw(win, pixel.IM.Scaled(basicTxt.Orig, 2))
+
+	y := win.Bounds().H()/2 + float64(len(lines)-1)*basicAtlas.LineHeight()/2
+	for i, line := range lines {
+		scale := 1.0
+		if i == 0 {
+			scale = 2
+		}
+
+		basicTxt := text.New(pixel.ZV, basicAtlas)
+		fmt.Fprint(basicTxt, line)
+		x := win.Bounds().W()/2 - basicTxt.Bounds().W()*scale/2
+		basicTxt.Draw(win, pixel.IM.Scaled(pixel.ZV, scale).Moved(pixel.V(x, y)))
+
+		y -= basicAtlas.LineHeight() * (scale + 0.5)
+	}
+
 	win.Update()
 
 	showingText = true
@@ -157,7 +202,9 @@ func run() {
 
 	sprites, err := loadPicture(SpritesPath)
 	if err != nil {
-		panic(err)
+		log.Printf("Can't load sprites: %v", err)
+		exitStatus = 1
+		return
 	}
 
 	var tileFrames []pixel.Rect
@@ -170,54 +217,86 @@ func run() {
 	batch := pixel.NewBatch(&pixel.TrianglesData{}, sprites)
 
 	//----------------------------------------------
-	//     Load levels and create a new board
+	//     Load levels and show the level menu
+
+	var name string
+	allLevels, name, err = openLevels(*levelsFlag)
+	if err != nil {
+		log.Printf("Can't load levels: %v", err)
+		exitStatus = 1
+		return
+	}
+	loadProgress(name)
 
-	allLevels = loadLevels(LevelsPath)
-	board = game.NewBoard(allLevels[currentLevel])
-	boardWidth, boardHeight = board.Bounds()
+	if *designFlag {
+		if name == LevelsPath {
+			log.Print("Designer mode needs the levels to be given with -levels")
+		} else {
+			startDesigning(name)
+		}
+	}
 
 	cfg := pixelgl.WindowConfig{
-		Title: "Sokoban",
-		Bounds: pixel.R(
-			0,
-			0,
-			float64(boardHeight*TileSize),
-			float64(boardWidth*TileSize),
-		),
-		VSync: true,
+		Title:  "Sokoban",
+		Bounds: pixel.R(0, 0, menuSize().X, menuSize().Y),
+		VSync:  true,
 	}
 	win, err := pixelgl.NewWindow(cfg)
 	if err != nil {
-		panic(err)
+		log.Printf("Can't open window: %v", err)
+		exitStatus = 1
+		return
 	}
 
-	displayText(win, 2, "Level %d", currentLevel+1)
+	if *levelFlag > 0 && *levelFlag <= len(allLevels) {
+		startLevel(win, *levelFlag-1)
+	} else {
+		if *levelFlag != 0 {
+			log.Printf("There's no level %d, there are %d levels", *levelFlag, len(allLevels))
+		}
+		openMenu(win, resumeLevel())
+	}
 
 	// main loop
 	for !win.Closed() {
-		if board.IsVictory() {
-			currentLevel++
-			if currentLevel == len(allLevels) {
-				win.SetClosed(true)
+		checkLevels(win)
+
+		if inMenu {
+			if level := updateMenu(win); level >= 0 {
+				startLevel(win, level)
 			} else {
-				displayText(win, 2, "Level %d", currentLevel+1)
-				board = game.NewBoard(allLevels[currentLevel])
-				boardWidth, boardHeight = board.Bounds()
-				win.SetBounds(pixel.R(
-					0,
-					0,
-					float64(boardHeight*TileSize),
-					float64(boardWidth*TileSize),
-				))
+				drawMenu(win, batch, sprites, tileFrames)
+				drawDesignError(win)
+				win.Update()
+			}
+		} else if board.IsVictory() {
+			recordVictory(currentLevel, board, elapsed())
+			if currentLevel+1 == len(allLevels) {
+				openMenu(win, currentLevel)
+			} else {
+				startLevel(win, currentLevel+1)
 			}
 		}
 
-		if !showingText {
+		if !inMenu && !showingText {
+			if win.JustPressed(pixelgl.KeyEscape) {
+				recordQuit(currentLevel, board, elapsed())
+				openMenu(win, currentLevel)
+				continue
+			}
+
+			stopMouse(win)
+			walk(board)
 			detectKeyPress(win, board)
+			detectClick(win, board)
 
 			win.Clear(colornames.Darkslategray)
 
 			drawBoard(win, batch, sprites, tileFrames, board)
+			drawHint(win, board)
+			drawSelection(win, board)
+			drawHUD(win, board, elapsed())
+			drawDesignError(win)
 
 			win.Update()
 		}
@@ -232,16 +311,90 @@ func run() {
 				textDuration--
 				if textDuration == 0 {
 					showingText = false
+					levelStart = time.Now().Add(-levelElapsed)
+					levelElapsed = 0
 				}
 			}
 		default:
 		}
 	}
+
+	if !inMenu {
+		recordQuit(currentLevel, board, elapsed())
+	}
 }
 
+// startLevel creates a board for the given level and starts playing
+// it. If the level was left unfinished, its moves are replayed. If the
+// level can't be played, the menu is shown instead, along with why.
+func startLevel(win *pixelgl.Window, level int) {
+	b, err := levels.NewBoard(allLevels[level])
+	if err != nil {
+		log.Printf("Can't play level %d: %v", level+1, err)
+		openMenu(win, level)
+		menuError = err
+		return
+	}
+
+	inMenu = false
+	menuError = nil
+	currentLevel = level
+	dropHint()
+	walkPath = nil
+	selected = nil
+	cantPush = false
+
+	board = b
+	levelElapsed = 0
+	if level == resumeLevel() {
+		levelElapsed = resumeMoves(board)
+	}
+
+	size := newView(board).size()
+	win.SetBounds(pixel.R(0, 0, size.X, size.Y))
+
+	displayText(win, 2, "%s", describeLevel(allLevels[currentLevel]))
+}
+
+// describeLevel returns the text shown before a level starts: its
+// number and title, followed by its author and comment, if any.
+func describeLevel(level *levels.Level) string {
+	desc := fmt.Sprintf("Level %d", level.ID)
+	if level.Title != "" {
+		desc += ": " + level.Title
+	}
+	if level.Author != "" {
+		desc += "\n\nby " + level.Author
+	}
+	if level.Comment != "" {
+		desc += "\n\n" + level.Comment
+	}
+
+	return desc
+}
+
+// elapsed returns the time spent on the current level so far.
+func elapsed() time.Duration {
+	if showingText {
+		return levelElapsed
+	}
+
+	return time.Since(levelStart)
+}
+
+var (
+	levelsFlag = flag.String("levels", "", "file or directory with the levels to play (defaults to the embedded ones)")
+	levelFlag  = flag.Int("level", 0, "level to start playing right away, starting at 1 (defaults to the level menu)")
+	designFlag = flag.Bool("design", false, "reload the levels whenever they change on disk (needs -levels)")
+)
+
+// exitStatus is the exit status of the game, set when it can't go on.
+var exitStatus = 0
+
 func main() {
 	pkger.Include(SpritesPath)
-	pkger.Include(LevelsPath)
 
+	flag.Parse()
 	pixelgl.Run(run)
+	os.Exit(exitStatus)
 }
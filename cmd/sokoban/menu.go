@@ -0,0 +1,236 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package main
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/csixteen/sokoban/pkg/game"
+	"github.com/csixteen/sokoban/pkg/levels"
+	"github.com/csixteen/sokoban/pkg/utils"
+	"github.com/faiface/pixel"
+	"github.com/faiface/pixel/imdraw"
+	"github.com/faiface/pixel/pixelgl"
+	"github.com/faiface/pixel/text"
+	"golang.org/x/image/colornames"
+)
+
+const (
+	MenuColumns = 4   // Levels on each row of the menu
+	MenuRows    = 3   // Rows visible at once
+	ThumbSize   = 200 // Width and height of the area of each level
+	MenuHeader  = 40  // Height of the title of the menu
+)
+
+var (
+	inMenu        = false
+	menuSelection = 0
+	menuScroll    = 0           // First visible row
+	menuBoards    []*game.Board // Thumbnails of the levels, nil if they can't be played
+	menuError     error         // Why the last level chosen couldn't be played
+)
+
+// menuSize returns the size of the window while showing the menu.
+func menuSize() pixel.Vec {
+	return pixel.V(MenuColumns*ThumbSize, MenuHeader+MenuRows*ThumbSize)
+}
+
+// openMenu shows the level selection menu, with `selected` as the
+// initially selected level.
+func openMenu(win *pixelgl.Window, selected int) {
+	if len(menuBoards) != len(allLevels) {
+		menuBoards = make([]*game.Board, len(allLevels))
+		for i, level := range allLevels {
+			menuBoards[i], _ = levels.NewBoard(level)
+		}
+	}
+
+	inMenu = true
+	menuSelection = selected
+	menuScroll = 0
+	scrollToSelection()
+
+	win.SetBounds(pixel.R(0, 0, menuSize().X, menuSize().Y))
+}
+
+// updateMenu handles the input while the menu is shown, and returns
+// the level that was chosen, or -1 if none was.
+func updateMenu(win *pixelgl.Window) int {
+	switch {
+	case win.JustPressed(pixelgl.KeyLeft):
+		moveSelection(-1)
+	case win.JustPressed(pixelgl.KeyRight):
+		moveSelection(1)
+	case win.JustPressed(pixelgl.KeyUp):
+		moveSelection(-MenuColumns)
+	case win.JustPressed(pixelgl.KeyDown):
+		moveSelection(MenuColumns)
+	case win.JustPressed(pixelgl.KeyEnter), win.JustPressed(pixelgl.KeySpace):
+		return menuSelection
+	case win.JustPressed(pixelgl.KeyQ):
+		win.SetClosed(true)
+	}
+
+	if scroll := win.MouseScroll().Y; scroll != 0 {
+		rows := (len(menuBoards) + MenuColumns - 1) / MenuColumns
+		menuScroll -= int(math.Copysign(1, scroll))
+		if menuScroll > rows-MenuRows {
+			menuScroll = rows - MenuRows
+		}
+		if menuScroll < 0 {
+			menuScroll = 0
+		}
+	}
+
+	level, ok := levelAt(win.MousePosition())
+	if ok && win.MousePosition() != win.MousePreviousPosition() {
+		menuSelection = level
+	}
+	if ok && win.JustPressed(pixelgl.MouseButtonLeft) {
+		return level
+	}
+
+	return -1
+}
+
+func moveSelection(delta int) {
+	if s := menuSelection + delta; s >= 0 && s < len(menuBoards) {
+		menuSelection = s
+	}
+	scrollToSelection()
+}
+
+// scrollToSelection scrolls the menu so that the selected level is visible.
+func scrollToSelection() {
+	row := menuSelection / MenuColumns
+	if row < menuScroll {
+		menuScroll = row
+	}
+	if row >= menuScroll+MenuRows {
+		menuScroll = row - MenuRows + 1
+	}
+}
+
+// thumbRect returns the area of the window where a level is shown on
+// the menu, and whether it's currently visible.
+func thumbRect(level int) (pixel.Rect, bool) {
+	row := level/MenuColumns - menuScroll
+	col := level % MenuColumns
+	if row < 0 || row >= MenuRows {
+		return pixel.Rect{}, false
+	}
+
+	top := menuSize().Y - MenuHeader - float64(row*ThumbSize)
+	left := float64(col * ThumbSize)
+
+	return pixel.R(left, top-ThumbSize, left+ThumbSize, top), true
+}
+
+// levelAt returns the level shown on the menu at position v.
+func levelAt(v pixel.Vec) (int, bool) {
+	for level := range menuBoards {
+		if r, ok := thumbRect(level); ok && r.Contains(v) {
+			return level, true
+		}
+	}
+
+	return -1, false
+}
+
+// drawMenu draws every visible level of the menu, with a thumbnail
+// of the board, whether it was already solved and the best results.
+func drawMenu(
+	win *pixelgl.Window,
+	batch *pixel.Batch,
+	sprites pixel.Picture,
+	frames []pixel.Rect,
+) {
+	win.Clear(colornames.Black)
+
+	title := text.New(pixel.V(8, menuSize().Y-MenuHeader/2-4), hudAtlas)
+	if menuError != nil {
+		title.Color = colornames.Red
+		fmt.Fprintf(title, "Can't play that level: %v", menuError)
+	} else {
+		fmt.Fprint(title, "Select a level: arrows and Enter, or the mouse. q quits.")
+	}
+	title.Draw(win, pixel.IM)
+
+	imd := imdraw.New(nil)
+	batch.Clear()
+	labels := text.New(pixel.ZV, hudAtlas)
+
+	for level, board := range menuBoards {
+		r, ok := thumbRect(level)
+		if !ok {
+			continue
+		}
+
+		// Background of the level, with some spacing around it.
+		imd.Color = colornames.Darkslategray
+		if level == menuSelection {
+			imd.Color = colornames.Steelblue
+		}
+		imd.Push(r.Min.Add(pixel.V(4, 4)), r.Max.Sub(pixel.V(4, 4)))
+		imd.Rectangle(0)
+
+		// Labels below the thumbnail.
+		labels.Dot = pixel.V(r.Min.X+12, r.Min.Y+28)
+		labels.Color = colornames.White
+		fmt.Fprintf(labels, "Level %d", level+1)
+
+		if board == nil {
+			labels.Color = colornames.Red
+			fmt.Fprint(labels, "  (broken)")
+			continue
+		}
+
+		// Thumbnail of the board, scaled down to fit above the labels.
+		area := pixel.R(r.Min.X+12, r.Min.Y+44, r.Max.X-12, r.Max.Y-12)
+		size := newView(board).size()
+		scale := math.Min(area.W()/size.X, area.H()/size.Y)
+		origin := area.Center().Sub(size.Scaled(scale / 2))
+		drawCells(batch, sprites, frames, board, pixel.IM.Scaled(pixel.ZV, scale).Moved(origin))
+
+		if savedLevels == nil {
+			continue
+		}
+		if l := savedLevels.Level(level); l != nil && l.Solved {
+			labels.Color = colornames.Lightgreen
+			fmt.Fprint(labels, "  (solved)\n")
+			labels.Dot.X = r.Min.X + 12
+			fmt.Fprintf(
+				labels,
+				"Best: %dm %dp %s",
+				l.BestMoves,
+				l.BestPushes,
+				utils.FormatElapsed(l.BestTime),
+			)
+		}
+	}
+
+	imd.Draw(win)
+	batch.Draw(win)
+	labels.Draw(win, pixel.IM)
+}
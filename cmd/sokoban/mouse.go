@@ -0,0 +1,135 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package main
+
+import (
+	"time"
+
+	"github.com/csixteen/sokoban/pkg/game"
+	"github.com/faiface/pixel"
+	"github.com/faiface/pixel/imdraw"
+	"github.com/faiface/pixel/pixelgl"
+)
+
+// StepInterval is the time between two steps of the player, when it
+// walks to a cell that was clicked on.
+const StepInterval = 60 * time.Millisecond
+
+var (
+	walkPath []game.Direction // Moves left to reach the cell clicked on
+	lastStep time.Time
+
+	selected *game.Cell // Block to be pushed to the next cell clicked on
+	cantPush bool       // Whether the last block selected couldn't be pushed
+)
+
+// detectClick handles the clicks on the board. Clicking on a block
+// selects it, and clicking on another cell then pushes it there.
+// Clicking on any other cell walks the player there, if it can get
+// there without pushing any block.
+func detectClick(w *pixelgl.Window, board *game.Board) {
+	if !w.JustPressed(pixelgl.MouseButtonLeft) {
+		return
+	}
+
+	row, col, ok := newView(board).cellAt(w.MousePosition())
+	if !ok {
+		return
+	}
+
+	cantPush = false
+	walkPath = nil
+
+	if sel := selected; sel != nil {
+		selected = nil
+		if sel.Row == row && sel.Col == col {
+			return
+		}
+
+		path, ok := board.PushPath(sel.Row, sel.Col, row, col)
+		cantPush = !ok
+		walkPath = path
+	} else if board.IsBlock(row, col) {
+		selected = &game.Cell{Row: row, Col: col}
+	} else if path, ok := board.PathTo(row, col); ok {
+		walkPath = path
+	}
+
+	lastStep = time.Time{}
+}
+
+// stopMouse drops whatever was started with the mouse as soon as any
+// key is pressed.
+func stopMouse(w *pixelgl.Window) {
+	pressed := w.Typed() != ""
+	for _, key := range []pixelgl.Button{
+		pixelgl.KeyLeft,
+		pixelgl.KeyRight,
+		pixelgl.KeyUp,
+		pixelgl.KeyDown,
+		pixelgl.KeyR,
+		pixelgl.KeyU,
+		pixelgl.KeyEscape,
+	} {
+		pressed = pressed || w.JustPressed(key)
+	}
+
+	if pressed {
+		walkPath = nil
+		selected = nil
+		cantPush = false
+	}
+}
+
+// walk takes the next move towards the cell clicked on, once enough
+// time has gone by since the last one.
+func walk(board *game.Board) {
+	if len(walkPath) == 0 || time.Since(lastStep) < StepInterval {
+		return
+	}
+
+	board.Move(walkPath[0])
+	walkPath = walkPath[1:]
+	lastStep = time.Now()
+}
+
+// drawSelection highlights the block selected to be pushed, or tells
+// that it couldn't be pushed where it was asked to.
+func drawSelection(win *pixelgl.Window, board *game.Board) {
+	if cantPush {
+		drawHUDLine(win, 3, "The block can't be pushed there")
+	}
+
+	if selected == nil {
+		return
+	}
+
+	center := newView(board).cellCenter(selected.Row, selected.Col)
+	half := pixel.V(TileSize/2, TileSize/2)
+
+	imd := imdraw.New(nil)
+	imd.Color = pixel.RGB(0.3, 0.7, 1)
+	imd.Push(center.Sub(half), center.Add(half))
+	imd.Rectangle(4)
+	imd.Draw(win)
+}
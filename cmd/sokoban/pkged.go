@@ -9,4 +9,4 @@ import (
 	"github.com/markbates/pkger/pkging/mem"
 )
 
-var _ = pkger.Apply(mem.UnmarshalEmbed([]byte(`1f8b08000000000000ffecfad7eef4c8922708becb77cb9ca25609ec05b5d622825c0c1ad45a6b16eadd17ff2f4fd6a9aed9ae69ecf6d5a0832068eefc9936772303fcd75fcd584edbaf3ffff5d7cfc937ebaf3f7f81c156ac1b380ff33a81dc9417a03481db9a8155b3d747fa2fd93480d9d6dc7b518ce03675539a8cbffef8a50cf3b4ee76b2d7bffefcf5eb8f5f663214bffefcf54f003f657fddf193b52af6bf68779afe4119c99ed5bffe1c8fbeffe397b7277df1ebcf32e9b7e21f23b748b669fc0b2b4d62d317dbdfe8bf54fffb902fe67fa7fd62dbff13fa67ea3f7118537efce8fbd75fffb0ffbf76d5489af1d79ffb7a147ffcff17336932a6fcff4776b09afe6598f2df52c262dd9adfc181ff05c67ffddbbffddb1fbfcabf7cfed7ffca973fc164db8a7d03fbe22cfabf2fff9227fb0fe34f6dfc5cf3624f9afeb7acf1afa4fe07dc1fbfb6e62d7efd8963f81fbf86292f7efd8921d06ff2bfedcd6f340221d0ff01d1ff074cfb08f42786fc09e1ff0253184dc108890110fc2704fdfae357b3fdb7fc2796ffc8f9f6fc56c817e7af3f61822449e447aaf15b018ac214f5c72fb36fc6eed79ff01fbf9471faf52781133044d17ffc0a9afcd79f3804fff14bfaa17ef8dcfc470ef4c72f3bc9ff5b564dff0dfaf5e7ff1bfae3f7f17ffef18bf9b1749b8bec47a5f773810908c2318ca2b03f7e99dbcf0c0e132881a208fd6f7ffc32fe47781cc1ffc6ffbb87fff6c72fee7f064fc338424228f56f7ffc629b75afffa774a0244e2330fa6f7ffcf2fe9906b69fb26efbf527f543767fe5078368e28f5f629f54dbef4048c5f8fbaa6f73b216bf49e71fe44f60fecfdf355417ebffde17fe9fb42ffcf16bfeedc8bffeb2bbeaffd69f7fa4ea7fbc49fcdb1fbff2644f7efdf92b47ff3e322d47e2e1f731fe831ef3bf683447ff399fa3d1bfd3eaf81ff10912ff3bfe6f99dcf8cf11f75b5efec3f1432fd1908fd15ff4100d79f38ff921fe0ff3ffb0e8379da3bf4ff4bf9359cd688efe773a7e6c4263246efeb6eb2ffbd521bfe6bf6cff0f327feefe775864fec1363fd81f9e1889c71fcc7fd4f99ffdfa1d3bf4b7adbfe3f117ef8fac184dfeb61d89ff92f7979ee62f3aff0b8ba8e3cf5c8ea8ffd1fe2146ff632c7334bfa6ff34fe4bcfef03f98ff155fff607fd7da2ffd0f3a3f347f65f36fd95bba16efece5934fe334e31f29fe2f4d739fe4fd984fe85ffd1fd9b17899bdff9fd675d8dffeefb6fdbd57f6287ff58832a1a0f7fe5fc079bfce4e51ff9fccb7e75f86bf47fb1e9fff5eb67c5acc5b8ff73cdfc7361fc5e50ff33ed759bd7662fb6bfe7ffdbfeb3efd445b1ffcb3c56ff75abfdffcef277d7a5710843ff6ffb2eeec3e89f18fa278afe0b05a324419028f1bfb0ef62280ae1d0ffbabe8b10104a507ff7388aa6489422fe875d1726510a46ff1dfdb77fffc3ae8be0240a417fe3499446501ac2d0ffb2ebfe5f74e0088952f4bf77dd7fa4e1efbe0bd3c8ffeebcffbbf3feafebbcfff5fef1cf2edc84ace55e90265513c3308ce905b510540cc3403f4385e1988861186ef599276118a662845e7042171bad872ec8aa0bbf280a80c81ea605405a7b16b16ccd3041687fb07b18ea27164a6790db0bcf1a55f1b3a86da2a1cb7cb6065c5a7ebe27cf8a163a980da23e0c3b41f2addf829f29a45791a7e7b1dd4d5eb5fac89a5a45e2f456a9c0369107003400ab794f7e2d99fe86108dfa3db01665321c9f191c874f89903d9aef5f3c4793730948002ea7a35a6c3b487d1ade1b0b7eaefef9b8fac0442cf3dce601af89d3691be3b08ac88513fcf03b44ae5f7425cb9c5ee1d3beb74eafcac868f8b1828ad142c900ab8a8951ead09313851c91292def138ccef1dde3ec2b10ce030dc617533fb68bc8f24a36ad9ede2720e12d8e9a5f7dca6a2960b8682bd9a0d9b81e2a8ad33306fa944a2ae379eabebdf2f0649ec2e47265d4b880da922673f822f0778dcb8f0cbab8f6712c9934b45011395fdd83964621bab4aab22693132d6f5557d4f501a480adf56002732a3dd19bd29b722b1d94466fe5c24b911727a6027b9246efc306a90c4fad11264920d805d707cfe12b54417344090bb474c22c1159568c88493e85960b3882073ebe7b0a91bede7caa5805cc42ee0dd2a5c2852c01ae97f590226f9ea6efd7a74f0cba6af3a8c41bcbc614450d46a8b2118d88dbe16bec8a0aa018ecd601353a7d29c6eb464aa8ca199d8a041bc1b447f1ab12d507e4be3566e6003e84261582d0cb40b267ea2ce787f32a341e4b8237351f0976821983d2afc3e8a4514223a8e24a647dbee828ab7984d8f677248b0b67221b0c3888123859ef41e93c136c2ecfb3dcd98bc34b6011d56bf234d43f1d08bd591029511ea4afdd48b9747c76efb4536505a7213f2c86650122cf0b4da9aa383c47fb7bcf67eba3279de77918b258ed6527e153079fe7344d4700b57718e5290c5f1e05f27c1e77b22f1e73361d6613c7008f48b7b26148ddd3aa3ad360ad261bcb87c63c95f9b16fc7b84bbe984c09f99b2ddf4402788588c872e2d8c29f1c237e998803492763e56b6f28a0b8ceb05dc1d11ab9c59c9c9361b802878806094c1e421d86b10ca31e7a16ba20e67533567c511a217327b3353e932f05b9be14a6d73a8cd3ca816522e1bf58e55ad11c19f15500a678497007822c7c641ff38d9dbd406ff31b09839a88a0f966cb1770b29392d1719cd7807f5f972e3db9012a95f751b0103321ae58a932c11808101b58be20b8fc03277366cd5dace1adb317d163c130562d406c555f770b6d36c023020d6edf6985e4f7bd51233f148c4741bb026eb2ebed127419df32bfac59216282a993c4a85c058600494d775a15496a53faad245b31735965bbb40a309c737742c29016d48148dddb07589eb1b95f3a13df2036a02b8862b8b644eeb3f94d4157849af284ed73e4972a2841965fd07a085c70b8e33cd7e503733a8a0a59c84d2120e3173ada39e1932081ce1778149982dbeac573062550eb0882144e802b607841274f2c70e6f6f79bdc82ee688c9eae17688d6756aeed16a9e102f9b1701d27a0b1cac671009f67fc1dd4ea3e505d6cd6bc6c1219ab099db19f00dd9397e2466cc43e996b5abbbfb2f00415d7ebb96f46710ce3b60ff5414b6636d8a17f52da92761d56cd01ec3a2d640732601845bb404d3e7516902e4bb410087859f913c817e758f6c3e630febcbd8582b91febabcf33220b9769a5922085298863f0559795fb07febed028d9512adbb4f94276eca32918bf3a572d181fd4c450802032ea25707fcd9120308a67b830a8c5f078f55a9cb0cfd72f9c0187f8ca30c11cf8eaef38f2d94d91033a6e06c3631ff8a33ca35b5276c2f10cbedb9531676595c628488dfc56141267557748d81988969f2be44e8e2e54aa3cb4b88e47ac186db318ee986a7414c40082ecefba5d2eeeda52812da09504963aeaa70f8eb43dcbe932557b348515d4806a9e3a7508c1c4a6c45917cf98d9b789b392b1598a8b3395502f71cbb34765bfa54fbe1423b022c736407bbd23630a40389df156a0c273518c65068b69205214f695733c2dd2e54568bebfef79e6c1432272b50ce1214c567e3f145f3bca9dba7a107e31a6d279b4bd698a9256b4098f2f93f1af5be83dfb3631962b18029e5d5a00b85451617fc90cdd7d1bdcc998a5d339ba0ab6f74c350d4fceef98f646c5617efbbda9ca60d6e364f26475f8af7d2224099166289218de332c1beaa0fc3017ef519eb4c87333935fb405b6e2c03bd0b399cad94ba35a904c1900298b50526efb7aacd0b92f23a6f29baeb7acf21dadfab9f7ea614467e9335fe72f3eda2b9437545e8d0d2642ae9ce1422ee912b6670e96787c10afd3ba5004c6dbd9b70398a117d59772a005e01847b9b2ae64b41929be8a29b566785c2fbf556c757c530c4bca0a67c126bc14b6da9cc763c9a3779ad2acc645f3a95a98733d520926346abbb1763d611fa6570b1ee76f46b34fdfcebd577e56aea6829891633cb01e26d0a0ee8be29c6c1e523a579517e7befec55dbf0341f5998e41eb58572de40c24b50fbc5fb253e2873f4e2a96ab63a00c3d66d69f54d6d8ee6b026b6355802eb16b7344680cc83387c906e331aaaf13fe08f6d4dec7bc1247f6a4dbd293c2cce630df8d553899473dc3bdbb95415b86c70514ff5834b018b9b6f339f50aa0cb862cbfcadb7cdab10d39086388514332c8a2da21f0acae12483ece784c4f4f898f8ea749976d41e1679aee1b667d403b350ab04e456d5abe17db71a70f82bc8b1e96304d614aa371e1e124fafdf45f66edbfd47a9010df0f5e21c1a97777f2882fef0af8514d8064d6a99134d14059161fa18546825ebd95f2bf2796619982e9c66767bb173a52898851921107925139af6f185531f8d766aa7a93c786957ddf95f0615e4c475054e35985d645d8afb671dd5a234a24050fa2f7a34fe7e94727cf89f46036cd906bb2390a073a35f70e907ca2c7bcb044a961f2f252c1d93d1d62b4dad368c1143cc66c426e79abb5af65acc1d9a008c656fd28fda6e8f5db968cb82ad2cb41e3601341eae63da718d8fdf67cc2c7f28166b4be9689391e14615569c599649538e6607131ea62b5b30a9d12050674f0e64cb14981c833189ad78554107b25dbdb1f7b349937240cfdbbf79ed8dfd96dcb5f745283aa8906e7862e88c33fec4d9a53bd4aab8edb6c3b22dc1404e69bdd96767cb3d63677105b5457fb39fd484dd3e7c8b58a2b704a84d172712e6904bf93e33d77c3afc7e998f25745b19a306f47738b694e9d286e87219c64b29b490f477cdbb23476e5a5727f39a9843c730729457559ad0a7cc5641627d213e726f8fda5b8ed382dbbbf529365a546ff949c328274ad2ca17084b500a7893be2882830bafc45d7b9d724af95ed0e07d2caeab0cb44da00f19826b66d3cc0d2f9096749ab9dfaae1b6dc077ba89c7d6528694bd1d6db4403213a16f3f047038a9ba1d2c8cc82c22b31bab53a2dafbba17325ed281c466c613dd4113daf4b1376b39e71c28faf9b66814256bc985b7f77929dfdd01a818cfe3dbf1a519715bd67d8833a0f9fb945bc0ea719cb2b72dca9bc69fea498e81ef18f5ce9209e547af87104e1d8341b4c01bdb9910f51321ad71dd7dd45ea8cd36a3479d9bb69d10d3888058f7aa31dddbed737455eb4d4cd32dce4d299633f3f255e15c67941c2fc88171ca0765eb8a7a7bcfeac0a0e1ee0b82dc2d96765ea8b07c7d1745b160d43ade1e91147a8fe3e83723f7b973411de3eb35beb038c09104ab08741aa16f47eaa5e3b11264456fc47639798acf75791b3f72dc39576653fb02671408e64fd6767694a9c3b43b4d91f324dfe502f40eb216ad3b8c3c87cfc62a080600bf99a3b27e3c65bbb16482a6140ba4a89da0d512a713511d1d7543b360d6daf9d1f54ef6357df722b3ca8f4b16ef2349f166751b7e74b53584331537b632650f4e8a47d17d013ff90392cdf373517045d099835725bf2599b1065f24c5e7dba4e6f99af40989e3fb5d13dc1705af13bcf9486ddfb887056ff34160f375a0c862e1e226e235faa0513ce752fe7c71b92fb0c31be5e8b94336a40825f026aeafcc656b23d0014251189988f24c272e8ccfa54a4b49d5b2e74d220b384dd43b89fcd7581a9c117f7a2c7972cbad091252e4e6acae2511c78e6ece2f2ac9465dc8833b53e4fc245ca140bbf6da8b9a419c591ac99b7de75b58072cac87ca08bd4fb5653b674619440f6752a727e2cc7d4d4e8e33e1cddbf1f1036b344d3a44ad5eca8a19dca50e934fd5baab05da49d5ca9d56fddaab841b568772d8d8e57ed724ba3fba00ad987627fed5ce41ad3368128d4955f8bdf758f85b7d2f9526bede7ea480c7c9b5ec51325c9416519e7359e0c6b43e301ba0df2e43470b49f8ab51543898facf366e9fa15f372a7362ae139e2d686c39c3b6391bb4a0f6571d7698da6d8159286b4f3d52b1e0d4a6039544640a8d4cc311e7ee2664c7c21955e969e7e3b8fb198c578578f32391c2c3ab3eb2789f7c1b0361a3c59cb9f114f90e28fc8d6b3324711fb709e74d989d458124d7048bd8535d779d1cb80b9894770ed128ccfca95984bb82cee8878bfac008a20ec6196e0197cf8417fa4a84ef13ac1c3e42d24a103601a30401ee53b70a7e9cca6eb5d9b99612b2ae85f144a6c439afdfd343c79a8746ec4ce027bca8bea7e253e74b58758c9237ae4a64ac9fc4672f0099641a2ad0da36894242745a31886d77eb58e7f930c0b415f4ba11dc9ce5b3415ddab27f2ee701561668019aaec5a1f412b1d79ac03bc33a8b116975c5f631506becb54d91169701645280fbd753c5ac677851e002b0697c77bd7cd1fbe29cb6041e23dc46b5e85a8bd8299da6521b23afda11e57b5d3b419a6e76f210e4aac228e677e879de771faabc1ee2b43a92bddf993076738bfda02b0a1bc20ebe9bfeb9a1afa10c44ba0eea9072582a303eac7f2e4bb127d79a8a8a5703e676a8c156a65ee813067b3a50993977428e7044b4cf2ee7a5cc63c477f387263b36cdf5fc2456ec241e5c2c60161226397caec280c3c3ea11dfa0cc1c51fb48df07657216ef33bebf5710876c0f49d1ebcca85098fabe0cdd96717a8717b927a29aa7f4bc896f759f6a30f5d3247894ddd782c71c919db458fb8b4317c8cadfce22355b4b6808cb0e15d818dc175891d973a5c49b980c9c9cf483d58ba2a265e4bae86b3ba8d82ab0121310664cd88338bb60fef6371b6d9a97e6c3cfeff1b47d0e856e8eca4ea74fd2051cdce7639cf623b7ddc2cefd4cd73d19235f96b6e507364f63e99d5df834490a9799d2b0d41591b6597e21064ef06beb76ee6991a1fa2c6320c2fcde33f0729719d52bbbc88974588a1f436c42c60878b57df403e002d93673fc8d72e0bbe46159b14c78223539d8412b2edcdc8780dbcb093005bee85ef3c29f6c730cec10aee9e193b4534ffad6c907611f50e04942651544e77c950c4694cf2408f5470b34464a0c610644d362f247c31813b126bbea6710233a7a950d760859015941e569763bb0db39af2d60b6364e073a635c4db4e4b23d76f11387a6a13b0275a77dc674c5d7dd6fe3ee26f69096b31924bed72a2e5536eb2b87686e8a9cd95998eef9dd1d996b7525eae59bd64db7ea5c7b34033d137018e9bd19d1812b1fff64c46ff269c53ed39613a12d089fa8d3bfa38b10797a75514f80d010d3d743213047894fdb793389b86d8e0dddfc8624fc23fa217a3bade38c4ecd3608c8bee5b24c3abb7d1529701d6beb6dcb2ac6dde7b9cd8e6e7d8a7a2737269b30a6b444780cf960e3bac41356816b10588336b296840e30a3482e195b9f1218e5005d99f273b76f5d7fc4a483f6e410488f1e1e7ffee773c7942137be5877a0a901e347acff50d4eb16e2e568b16adb98ee7928c1d0fbb7ded7c3ce5233e7e2090152c168e76c8fa78298acd177a163d9f5b0b186567974d7d311ce81027a481abf4ae9ca89937ccf1cbf06b842979d8768afcecf03d1d71c5fbb3d8532c1e648c8c54a79b20ce296ebf6b1def86676d769aa27c79b78a9c585c2653545f16a1fe7dc3ea80ea5b21f89f7760e7a327aa6ee3e44fac5fd2cda671f92811d6d5bd76ff38009da4f278e9c4e11183626514f154b8d6137c7030c1aac7debae22b4cf8110e8a48de3b1579bc829fbc42fe4a6f15af41c525d6b9591e89d8b0bdd51f59a0d2a3eb450c21df480732ee164f3e3b771ddb277e7840a03c80b5ae88277b8d25e5a8b0c1fae3aa56cfa296b59c6fabc2ade994dbf76935570732d7c03266034fc10a378593c456d90a762a5dad4cada556715a9784e019550e835857c045ab35921a8f553797df1475f001959c3cd1374559481a549344bb4b368bdf4da358a4231634a6d2fd8be812825dca59a724cecf4f13ecd370895695a142161ae0908856e0862168948aab6ffb1cf4175c015b3081dde189cdf8b36254f1b909c51ee3c7ebf34b9be502ee9e84241b60a9caacf40400e912634f28b45da41db32a929ccc49b772a15de28c677241c4ca2bf7e930c22dc211b830d92eb91d344a599b54a488caea645e964ad90e396ef6c418c8dea3e548ddc67415bb279be4eaf75e733417baa56f0499704301629983ace14f77a7ac072890b07aeab29b7c9b36fc82def9bc24a685fd5d1e292fc9e0f8e164760b6453d3cd1837cdeaf520d3892f73b1767cde855acd4883fb19fa3be7d045ab5aa2516cb4e91fa84e99e00b8b1439fa7270c9176ae0139c16928529103a80036f8a2a7f804c8b8fa53f37044d938fd69f0ebc3104d3bcda40f6f66b967c91e1e0ae9673054fb79761b6ff6e595ded738ed27f18266f7eeac8efbd3d18a750d89f953639aafb4cfbc8f9f57320b67d7cf7317814e3db852ebcc00a8d15dbbf8fd530ff4433067fc30c7d456da9164adfd0eea50d6b8fc459bfa1c635ba374d4858fc0119660276342eca14b67f6913421fdaf77a463f114add1a0857127ce93a16f226531466fe977182296a9789bba59f81366e7dd94fb74a04deddaee3ec23304e17952ec668aeeef40a664290454a217559282ca52d33893876e678cfd502df028cb08e255998525264615e9beda7a1cba7bf13c2227bff645a712dbbdbab584cab5bf8d72c428a9b5a0bdd22d4bad62d4a887daf452756b9592d89d4bc853fac87edaddfc1599852ba2a34af87eb4f4f724b9e432dcb0883bcc1c1f0887cf477d63faa2109c619d7eba9036a860f963a5af65af1c47f27a1c7bbaebd4e0abd2b1164a7b68ad3cb6dc9bd8eeb67d2352914c5d7c9ab8a425f3d649dadefcd48eaa95b537d7bde9e789bd7b3d9b029f3176e9770ed19fe7d398bd193e06107d3f79e49d105456394632985818789f8667f48d90d61924c1681566f2d2d578600a06cec4908288612051f04c239a295a2c01e915d7d25d4e9975380652893ed95e72876427cfb043373848cfb0c36fe9af16afdb27de59e8fc408826e3bd5afb6d3c6abc775bd2cf9a1b0631967fde855276a3b051b16089fd02335897aa85a77e4a7ad6c17313a8e43d258f4b7ae02b5febfecd1388ca115e5ca97201485264c83da15fa6e9afec649d8b177c38975f20a4c7e2553aa28f889e4848906d64d99191b73dc07acd66ec7dca5e243c8a40d055c848951936a874177ef61bd0f6e6f05aa36379c7186c658320b17d94636667135d76d577ccda9510eda5ea89c2042ed7599311aa6f66f21e6fd49e528c12cd75acb6d21e380711bc5e396348a5d014e7795462951980cc8835c210d87ef82d93e839db70d5c3d5591e413911e54e5505e69bb796777d3973418b421172d314ca31faac82051f2d93ceb220211b26e841d07822689b8817805cebf9fabea4e0dce4076b04a62786e6b92b0f2ef42e28ef871c9d61becb48302ca236576c872766b40d52245d94e671ad70bdcedd985f6d0ab9650ab99931ab22191dd3d0eecaeb612762a06b6df890344e227b57b6ca3e0ee454cf77bcbba45538c3ed9bcb921562a18a32bea3cfce5ecfcbb03a653013db699214b64eb655ef7213e0f419ae5c830636c99bf099269987920fd8cfb7067f02bf2676099a5346ab3227d661849d3e279f878493f48e9cb0dd0c88d2307c8871757c950d8acf88bcd8e0fbe003bd557186854a8a625fad09b277ac10e993f8b69f2e0e59650b5b01dea3cfbec69ac2694e1431101fb007d44129f7942d8f11e05dd5788598584c9a9840c5e5b285a710144506f878a46c1fff3ef6fb05ca9732abd51a34c8cee95e2f34c5ccc574e3c5912b248252e44e45d58acc62a24ea515aa506f6224f7775dc1c2a72846f91a97102508f2637a3ff51ee4992acd81907ef0e33a05b4cbe0210abb8fc921f17c7d6d0ae153735b7ba35ef4e4031fe9a1618236682529d410f9c18f687ce02e59b00db446ee5e367dc09b0ce6ccf07b4720deb963ff66af89f9f7daacb8af6df13a1a57531088fe024c1f21def51c9fdb0765b1483ae6b6671df75deaf6462c4892a47403a2f54caea2767397017308ec4e1d52472862ecfb65ea23ad48382f2ced8a77bbfc0cba0ca52f1fb5c19dd31c72e85f887f3a737a41c414db85f574cac873645c85cc20dca7286a0914327341225e5818607090e539f120f13ee5e674b3ba097b8951af8c180d806a3ed5746ad0d481e6f84ae05d9334f434fe0a49e6a3a5eba0e6c00e551506d61daa81e6e8b4374e1d76ddb69407d462456a15b91dce5ab52b498a0fe6742b2e7513804a1c7a49a18982eb686eba46562f2aab7d41c18246083fbb83ecbc222fb271c40cb5aa94b7efddb22fa844fce9a52202ee4dba702febda79a8ae87b660ccafcf741feb73264054a58e2996c09c6f32b638f61824c99e2d53da8d62f26e168dfe96d6253b284207b10982d949203d097d98170392a2443bfcbd37a2d8287201f4bcd8bcd5aba889f11da6b7b1798ec8bd81ce8a88553ce9a3dcb077f7e7bdc27d0240792a67169945745a810899787ab916f71c22ee496021ca523320aa2b5690bf380aefccd057e9565b2a00c405b7d0e58fd78a33e01c5ac485caf42347a9868937992828b5d6e3ed7d592158183f372c748f7e766a1077551d6875c035527bff3cd3ca9d8b8b43b1f5b6a75e7d62be8f40289da7898ec67a1a9668d2a540acea039ac99a61d929841e4784e745bdfdc8343fe5bec63a5a1de8aee4858c02a5aa8f6a316c3e69129f2f8481546db86ad5bdfab5607401f309d1636a1afa8f70ab6135a63be2d8fed67366c787de6f599e666a8c9028c1cf7ed2ffaee7263284214e44d0bc326a345fc664ddaa8b76fb916895ab5003b3f219a70b10867fff6718705530138ab00880b64fdbd73ffde2ad33ce7d4b3fc4c3197a0ff8da336c240085a58f70c459d5eb99c97ea4faa3bb1aa4b79d4a783eab7f359fae1135f811aad501efb5ac56dbde9ac8dd019b473ddcae3338bf9f135f45e53ecb8fac0fe72e28a87890d7732ba838d3dff9c17a3710fde627df50dd40a052581bbfe76ad9a9c8386244d61aaf805b65a733c4972b712e1bed379337e3432b53c84ca2324193af3282df9f2ff074c3cbbae83abfe5ae9a03d7ec0d060c7c61436fa739aabcaa3e540369850ba6127d682f4211723f83f9ef1aa8ada955196e0ab9aa689f08ce164d828f761145c8abf774d30eda208825781c67f9a90787d52aab0bb8da7b495ff3a136529bc327d239efd92afc91330515f18361185e885ede2ea4ea9bc08f646008bfafd2a082eff0e1028ba16541d17ede65545b6d6ac1eb3b061abf98848b0c13b4df26e567ec017840197a828527f2a43486e13ed3403319d1b2f84b8b3709e680479b5492cd704f747665744a98a9bb71c5979b3fd006c51cf97e90e054c280e126e9c30bc9343739308dc06ddd0b3d5bfd8ddaaabda9183210e9a7f4b7d8b6a61c827a896d51b700a0e1a81a8741f673b5bed277c4b969f018a613b543480426524798b041849cb138ac8dd1ef6c70bf1295a95a5daa850cf147408667e3ad6fe7fc62ac66164acc48559c61b8cd0984bd6504767fdb24bf2248f7abadcbb5c34b58a692f559970e300401daa3d21034257f6f412feae0494e29d34139e2fb02086a7f3e85b9a05bebd2164fa99800b38c86a562f7798eefe39a1bac2f8f7f11ec57a51be3a3b284f8f1d9fe62089fa2f289673fec72b3503d3131db9ec07c7541448750bfec02f0609be478dcc70a2f751632fb8edd032446ce71ef6666e9b2210d0f7d845b8185cb6bc2dc6ff06720989b94bc850d619d04e3693d9a29b9bd6e58b1222fa1a740119bc3962cd77661813188968a0d07a3e416169606d1e0b5a90fa0e4ee07c67050c7bd341c75d917a4d3ce60d0f302e0442fc104ab8949d49bf5304148e2a7a20aee8211b5641c4e3710d7cb58c9899cebb352058e1dfdc63a2c74616e3dcce68aa8d5e5b3162164fe4c7c6e31191491519bb94ade4ec57c15da7803a9e1ca4f2e877b49708c2b04700655e9646133f41a2e0fd0542e4e0d341a04ec2bac5b5574782563b09dfd008c3b3906ff108c9475a13e5422c3bc3e73d86a7ff192e55cefc1c8029bc0d8c894f44804bc6d8fa38d329f9b59190c7dc45c8244ce8a19aa76b292c2f1d3650c225f34829c568667480b3be2a170bbc3df6ee3b578245c4388e71153b5b814bdd1774511700cb98cd5a09cc1790f48448615ab84b93f7a099b1d778edff104ad1d25217eee3256c066e48830f7564926c22b76b48ca4fceaa7d287475bd474549e8766e4614782fb48e9bc1e7f395d7e785dea8c71e80c481e6340f8ca23d6b4f6477ed1161fc3b843d81dae745da2ba8ca7284597686620e0f184a3c22eec8176e82cdb3a8271cad4fe8409ee15dd70396e66337c0f3f0fb818a8adbb9668b827e1de84d37d14ce647107a465cb6e5af37da0724b699ea078aa619857da92acea422582142bc3ee150b50abf05903c0c1c246ebe4a1131740311cd45e43830da5fb289943fa4fc37e0990662cbbbd76b7d84bf0687c82d491b3285fb8af90c8d30429af3e2e4cad3b09cae58bb8319d9d20a169c05a82e04bc9dabd99b3d0dcfc20f719d4d83768948fcf5dc5711e924e8fe3797c827207f59ca4116cd3b937a8a16ae002d4df74eeaa4c84ccb1cc5e73b7f1dfaf4a009d8c1b63aaaf9b3fc9889f6e39d6c9799e59300dbf0e4ded048a5e2377561d9ce8b7820a5fd4de9d00a4c21234a4dc11d6860548e278f612ae7dd00d40909e91a03bab3e3333f43d672c009fb22cd8336dc40a24fcc414b06eb3559d24dbeed852f91c53df4268d63b1479b335440209422bf1b10455397d44cd056f2f3abe060862b65fca20a515c0b8ac36f88234521f293682b902ee3e5d8e203eef9fe1d0c1d3a3012dfccae05b9ca03a0c800610c432d9022c2c46a37acf09a33fbf35360ff7be1d66f7991305976d3ac41684aa637cbdd4edd4a0c61b993b4e50ff92e042ee23087e65928a5a3be23d29a52e4e508e4007c90f98de270596e0883778e734115e1a7afad00098426f0a98effb2277f66a65c673ce68f08ac3301cc37881cb86364a3937e6b9dc619fe7dba6850d9e20484cd1263625c4e88ea920db02b20177185bff01cf1c3d5778074590b469fc36be6fa23fd2b7f16a970ab699bbdc848db33505cf8941d0b604b11145b59bb190af6b1cc1661967e5a8ea914d5508a6148dd29b04d2a97d7ecce6fa0a6557276a374720ab889d78f711778280b47df70805f392a6d0ece3e132c6f5b374611750298a1876abffbc7e59426d0ad860fbf40ff5710e5c760e43de8c295abf6f5716f42a81d4091aca79aaf87bd6a07aba22d60320a9a1e0a3a2e083684e2ad445928db0f7540f035df2c6b0bcd5ec588c471f85d0c9da5c2032d8565d197062b713900398a8620b65b110acd34102582dd90ce0548c554695b69ce1e073233adf5d30ae52dd20e2dcaf9d51242a8d3a80015466363ed0cc2c3308b2271ac579bd0d0b73ce247e4cd4b072b34509ffa2727cbb53d673aa0b6c6edb9a86b676e30574398de120a23af74ccb4d0e056140921ba099542e7e2846c48ac2dde5e79918c758b8587716302365900469c0cd4a1bdc7b1da6ccb4da442ab782fb01d7fe8b778cabaddd6ed7febe03b80b7e6514eca5af0d830d501a71a289df62d131ff9152d859f97a0dca0504c185a6b3bc3c3ee67896406a905ecfe745499ced988b6494e917ec5a2d089f20b060d4398ea395ec2d48e5afd1f560311c2b567c9301530b045e74a8a40a80b6da161e767b01733035e24ce8cf708988f28d2b710bc3bca175102c109e7f53b8a4fb2c5d7a4feb6018d4c7a454cb06dfe26505719b13c5aad52017f2a44c34f8bef3f367d7de0fd8d08a961782a084aad9c407cc9b4cb0ad2f135e17c59c6288f109e5396e999477158020356f762d59b58081c21582f4cb832562b74ef901e9f122157e34eaae8a58f6a14940047ef6869d8e299061d681699cc2734a902c8b339d5610e5cf32c7984e8e6fa7a1124d95c76bf91c00cafa20fb820842e7eb30264c161365a89f52fd21270d05af11c45be8e69c0f0d82f7b1e1d365655be6cb293240d986ee20ed04209e0380fefd12d8652582a7b634d8a556308223b16b25f9256dd0a0331f638295c571ca48804f6ac2602cda145e821ef80d808caf591b3c8a5297d0fe7841f48b9fcd09a6ad7903ce38e6f4e73cc0852008a2625d6fc3c1a026280020c81478e8b8546a4665bd6fd5565f86c5e8bd1ff71ba728436c09e05c4387c10a9bad8c425d6dbf48d431ed5aae4a65ffc60c8662629ca6f7719ce7b83c2cdb360ccaf4648872a1c26028ad2fcbddd85ddd2919c57328e523ac1aa41a05ac6398ff2251a78cac181d4c22f5dc01d2732faf95c5ac24d9e76951892bb5b2ec550b1aa660df30961023e1b80c322e468a689e67aadc5bd77794491a971d362b6c38a74b193c8f92cd5d5891d28b3f5b304f004b10e04595643f6734e0ac2229bc511a44d180c92c7244fb120485dd76f80988ed96a5dfe5e47d254c0cf956bba8aa300bda18effacae3028600c0572c80fb547908e3975600d85e434fdfec7d6b53c9d498946df598e406bbc74eb145c94300c13ebfe40a3e4b101210e96e31c9b47310a249b4f6190d6841691131ee402a520651f325405aaf780a88008a6e129e2d25d2d9b85018c91d253d90753c46c004dff12c2e284e905b48144ab602c8d393d9d8951e41445d227944c19202585c9ffc6b26c99303a4ac7a05eb5dbf84d4b2c551b6ae8a10203d662ca65ee4ba362a91af7225ae200e2ece59b954f57129866d1401aa5bdaff30a0862eee458380c99fccc69d20859bdf2203c3640f43094a07355e194f8bfaeaf37a70f88551f30417bb3c4a4162f496209673a5fcc3aaa0a50f4e703b088cacabc4e65714298abfbf25e6173563bff609e22f0c7ea9a89babc40bac8ba344873f7e7e179d671985dc4c48ad7da507cf0ca3e8dab72889d3057280f6f9ce5f947c136c74b58ac3c6aea5a2a5c984d2bf949e5770df06df84d8d94b0b6c0d5ca389552f8ee5f4e57d94e3fbdc28b9998f508795ccacb35f17b99d6365c841a81b0d05c70d06f7d9619c96df36d22a4ed9e613bb435ec504a9572f06b86d2595679ca4b2e7a5476285c0d4d70529c7998cf91a194e75190a668408d020fdecd077d4c74bf7981c742937b2a6ac6d0aabb4eb1744f51a04c764430bb018d3e3033104d0525624a6dfa83c9bc73e0122a70edbdec774afe88c8ed6b3fa741348b72d27a503265ea367979f73ec39b0a4e07d47621880717867ac730233462b0d4fc840aa454be06bebe8dbce7139a0e782e031195e8ae36cdc1616bcf0653af41b7d82c7f60e9cdba9131c4190b2b3ef39a4f6381a1147e1a0746fe926c1a46c2099a4c67df4c18e4571c65a6c81ab2e00488e80505c2bf6a28a93e11994356d5b9621b9a0b11bb4d34243f6c80f16a532c2b404b590c4bda3d4350d43720bcd07a6e05790a2c7f2fa546a4e7d295605a8aae086b7122a5ee0049f27a7351320510d39b3cec34ab5b91663c2754bb94a296c6bdbbdcbd98e04e361a2b78eb16bddab62a3eac932a85600fe0077c813d442c63a3d42892231e59ee25a81cb2acfaf6bba12ed6bae96dc1e8e05e73946b8e36fc91f546733326f514041e36b28a37305023dc1f4e35563e5a89220cde50adaebef8c5259bbcaa545331c846bc508aa8a0ea080428184a5675009d0890e5222c6f5edfbad638b033b5ae35c9635a89722edfeb417350fe5e914e2a41b701d58c1888db4e4f392e08c07395058319cbed13bb894ed92b753dffae5327e6e180cc6934a6ba4bbeb2857dd5128b72d8f65383c4bc5b9578de2116befb6e1eb0a321e33074b5ff5da6cea98e45fad4d510c5e13e4aa700a45ef75fbc57b687812b55ef88af910381e475f957e3edb57d6f5b4a00a23cb73e01236ced8b299910067bc6bc1b98c69f9ccce2d2cfcdc8b689b4ec54a1aecc0f75ca6db9acb969d9acdd7447f1898b6e24bd4b46db6ecf1db89816df035f391a30c875a7e5338a4e6945b8f87dfdf2675f3a99ee9494c5b135b5379aa20d8d890a3705fb632170b44c064b5a2fd0d45a3b21fe9e87586d1d9363988d07f63b1bec6af0f81203e565ba4ae3e45eac71a7ab4dc57b1eb6720ee9313673002909068ba8d06ae88edfbda0615eb7abc1e6745e893e5e7a6b37f9060df62fa2537fa88bb78e05181bdaa036ccede68dbf1b1e2e731d00e3da08f496162256bb8ad26c83d89cf11dd865a99468949729a18ecc3e84cfc92ef90a75ac26e8ab694229d5c659e357995700d1abed8256500858f9afc2d33c6f16ef20a9b1c32db99f785a32d3783d67d30068cdea9c9ebc1fdd9aa384d79c28c5781d96a8ff2ae56e6bbf7377782492c558badf08cf246df46d7981419adf606a3753a043bda7219b3af71cfeb0d3b786b631e171b2b893efdbdccb1b0b4f17605de041497d644a2b45dd204c4c6b828e4906cef89bb09eab1caeb1cf397370f9d01bc3496418b5013232f43f1519af6a48ec01036e5b2fa5d24d36b67be404d81a2bc525bebe8d54b9634882a51c81c61bb59d16b50ef13ec645a85da7e91c62abc3362186efd51e77698a511063c62df19b2e4800c4ef019989c23632be79ea38ff4b1736b6387db610c0605cb542ed199380512a37de02dbf49a089cecc6c6ff7765f8e4b407fdc064d5ba95a9792217b707785a65bab53f3ad710fb3a5030367f6e253ea2391d007ec257622df64a1c9953d1829245f1e9a68b28e508b7ca79b2992e97821b871b8800cf98471e6fa180dcf98b896db2488d926cc30f85e7c94f2c47439e17386f84090e09e95e52eca57e36522f91ee72b4d25562c843e1d05ac7bae83eccb12a31886536d478ddc02a7923d998c2940c99ec6e48865aeae3f026a6076e6dbb6d1e891281b4aca28f1fd39f6bca4c01793fbe21b6be3e9b2ca87f1d4ecc24d889c079ad614b6e2b20cfc1c1aeb69b0a47feb8e3596cb879597468efc7adfb6a98c4cb72c678b157a9d89c0bb913963da48dd5aec0cc64a83de225bd58abed9165f9db62a5c53886d761718634b23560041c814bdde59c5b4b12ae842b84710f68ec812759b8de0f2bfa65d8516e2be78d88d8671ade522969ed5af1e1c68f7d7d0b98731a2583bf2fb5b3c4bf8eceeb7fc1c7d5038d98d490701c897798589d8a9e18f1f3bafedc2ee9da070f1d4dbf11b3cb4df354e041f808e10364f744bf527244e10e5780682de4758d29d12c5adf5aae1f56a1e04af3377917de5ee536e0de179b1f74adfb67b10f7cee66b19ad79de3a494a2005b5e6046127e985398aa22a7214ef142f1054ca8ef382eebd5ebfbd9c25ee9b68cb4720c879c77d69d71849f3545dba189aebb694e121cdbb37c67676e7a664e2e6daa1e1b2b0cb4e96a26fb3f2cceca64444d13a81d96479430c5f678723696798a44f3f4bfbb5bb6926ca373c31e410ee268c5c8d524c4c1db1ae56e0f87b74d8292b77793e53a652408e0a42e0541f1c5b2bbbcc103119ca39c90ed4e15d33836009d6205b675bd98ac285f6e0931ca762f9161d3e1250be1e07d768715188f3dca7bd53d50eeb4d4cc06d5f07933bdf9ea2d32951e545d3590da430abfbb47674c36792e9f737d55247bcc2257db0806364df603a84077986c34763c047fd96f165e458c65fe60b5f4af8606905df95c070f5fea36a82a96bf6177e1a5d81550d5183c734d169a8e0a665beb16216ec46c170eebc3ad71c9f7c40be018172d955da8f7d00286c5d5dc5b87e8950f32035ac54698f3a25d7366a6851a3c2c94c8312ab97b2bac6f05f7f532d43e788f0fde8b8e0479540f1a3b601a49b2a296618daf30336f356c52df950f58d68df3bc7d4e3ce7e4ddb2c58fc9daed579207efe7e1981fff29f064e55be8cf1005e87ee5a83f784f6e4688835ff6c4b55e2da07287a6918f4bd76b56a502dd4b49ea479e3d90f202edc3c310a4647b59c71163fdce708c6d8bc3c989b4025813cf1ff43c6792b5dcb035bfa8208f01b08f1de7b32bcf79eab9ffadeffcc9960944ad5a5927aabd72ed6d35285c7b6bd7f431bb74197063f3116444dab0b8faad3f3715dbe797bfcab9ece241edee5190d489aa0cf3776d70b2e978051eba968f2ce8bf845b077b8d79dee567bb6489d8ee15bc583aa113c5baf9f258b5ad07a8e62274b4bc4be3b339dd781f72c4842773e32e456bdc2da114b112f4236faabd5d3aa7064133b27beec415a6dead82cc8071fac2a0976ce91be6963bbdf8e7c1d3920d01c605476372d8bb7351f4fceb9d89ed76cbdfa2dbb59db3dd94b0cd7ec370f40d9851f353b0436cdc59ba7d7e473810123678075b315943b7cb0782ff2d626d00bcd06ccee57b257c1b3c6c5efe6eb6a4f75bb5c438b2d99f6f4b0bf68cf02e7c7f56155d82ecc1f803dff408a5546fc4c2468ce0e68a4961f4c60f6a961c30cb7131c2282bc32bd4ccf27ce9a1c1e7fb680884b7f54366317ae4a3530af66725d4e023fda355be3ff34922652d0e7358a777b59b9e885584ab1d17d1111ac7b2d3775d8c49017ee6bd0d024ba2118ddb07be0453a56cfeabffc10d5ff7c85c012d6a63e58aed4497920bb821000bfa99062a2db49e4091ff43325610b17c4254c6d59a8f7dd9780d645a05700e6401e521688782e0af6383c6f9626c24c77482c6b019cb307f01cab06d435683f540b22c35ad95fcdb64a068ba180677ef0f36dd9d7718f5415450ee8519dc197f7f8950596b41a3969ff97e31aca40374e60155f0af6e0e4adba441fb0c8f007c028e74fa4f62546b0f386e2e94617618fbbd52fd0112d239887bd59e4379f21bde61f9dc1f75551bbfbde6b5f4f6223150630889d3c4de24436f399d398a1579565f4e1a18b8a07a88a6168f529efd62b75c3ca2c434389b7f885b72edb1d302a711366895b9e30fa530d460e75838b55df729876383c67e58c8372f5edd2ac539549098d7147f9fb26c45d1955fc96e0148c981ceec74a6bdde71f9bec0cca13b7d34bbe09b45c168750ec9a50d54d6e48982e9c75c688e1910fa45fe29022818c1872bd97896d094030c77ef5016db4a285d9af5596753489cdd18eb0344fa0b5d201210efd5f321b2919415537208ccf0495e01a221bd0ba6fa95dcb5d2c75adab7b784751acc5a3fa53b3ad96ef192b9b2c21e00c481a1e95cb386a10be21f659919d4a33534e64a24c1b91d3b36865a387000ef7f27c635282d0724d092d80201a0c5bb0bc5fa9dd4827bcea36c5d9c00c9f255185c30a119a71c7ffe7e96e06343dc77f3987074d5a89e73b8eb59e3569a121187731672f72da4aaf120db43897195432867244379d659e4329a5b3b38fa508318117a1ce27a803cd18bd28691dcead76cf3fe61677aa89f28dfed5ddc87593ca340462f99c70b2cb2000069fc413273a3b967a54245075696441e26e107a4521ceb762bf64c6e3cfbca4d64ce06642e71d474cc63c12301084ddcfc229f8b5a4b614a6a48480d3e9b7f25853ee4c6fce6cfce0b7e277339856405d6357557bcf9467c9752794333de4681a513a62f8d65d4c1dfde82746c5f29b01b7f6d54d048bc59c6de64e17913bce7007e0863e0a845d5674d8a1698298f8dddd93931c65b0037d4952fb45043ef41508598f68557d8b8c8c5248829e7a317e6be6dd9de9fe66af1e3f9bd1625f4520cf41f2a54de12816308f07a13ee13cda8381de186cf6f43645ddcc7cc95bcfabfba9e9d136f7bcd49a1beaa5e09bd7b0097b64bc8884a78fa5c5586d15824fd00c8d408127dea3a6e84e2307760dccea789d39acc5de5a224484a3416a4b7e9a3bb764d72d094c36b77d3d65d2251058de739440e8a77018fb49ccbfbd025ae7a46d47ec0ab348f3757e9d5cbaa138c09fb04d77ce3858f7dcf7b46fc77dfbe7db1ee81c678bdea7c7d4b8c6e4bb91ebd0fc355fd958ad5b47ef06ba815e4303840bb1c29d9bd931130419b6b464d184dfb3f670fe39e6bcb804b7e9c096f4b9ea46bbbb0d13fe3f5ce398f647074018377dd573a317324f11210ec08612cf175918a90060cdcfaa62b60af21244f80a2b3958ddeb51e4872de360952545f2924166c9704abe2a04bcbd8f96dc8f727f980f36cc20d9582c89245474b03580d75037a25ce731fe4d3efd2c0ebff50c276c6a3062689437a65ab56ea5030a9d094ae2a02eabfd6600725542a42148ceb980e680760b7fd9aa72f1a2e134c6bae6818552217ebe44d2f0e9d2bad325d42745ce9baa49f8371c1001813a7f7b6370979aa804a757dbef4e4a15f2025a0700bd15ea0589f7a72914262e39358278ef62a251144f72c256cfd530a355e584b9a1c4d640d9f21dbe2d43caf25f3d30213e71249c9dfb7ff5d59f168654c636c34527057aae71579315f82708301f7a848c4e68f40b3be1936725b8f3421fcb740edcb5bbe49fc18d24a95f526ce7760ca49866143a4573f7c5198aa4e0db1296f4f99920e4eb6b970a451be447d964a3e8343c1f850e347595f291eef1d134975181f0545c5bdadab4dafeaa5cdeaea3167b4e190d8d7ead94978abc00c6a70c685b1346bb8019ca6c40c7d01fd3a218f4a616a73d2d4ace3fc100d8aba2fa2ce02a1d99297665492db2b223a9d8ca95c1957dbdd55e1c8a03ab3ff7a3ce9dd3ed0d306a57848bc46c78cb295483980022d4bb1580410a45ffe36932ab3a6cd4639d2f07a149ca21d5db948eca6f183ae4d9d4b0c29e7eaf71a08c7c314adfb2e1194e3e78d3c370a339d2e44420b386a8fef949ffe90e52be822c3f2c97cd95742bad53530ad65f5d50700a89242b0d30303f0830f7de745db90025424004e25536a3e226a3922c0080b0efb011fe57a397ded3c8daa1edadc7c2e08dd2627e55de56835cecb70a2f6a443d0317c6026726920de55d67c219918d3a436af40ad4041cd07e563a453fbc5b61a88701bbd93a85625e52f99cf976e686ed703f7595a796c5dbe4fef3d40f1bca47f97f9efa1c50e226b22417e48ab5da1076fcc9f8b34204d013686137a6c568b0dd5201f2fe76fd0a58362fc540360a985696ea1ac3e199dc795d4ac5163e3ab8664a5d8bf62369293244e72dc39a6a0f56a7291095c9c372559d8fac4f9538028b41ddb6fb0756da5605651b4a306546bfba57151cbf6fb7c6f9d249b678b35ee95877c3106171bb31bf77226cede6b2138934c571c3be6424f2b7a8aa1611a8d9daf455259587860e2a8b26f78c26b19f48977a3e86773806b719be85799bfb3de9285a794d50feba16c2166fcbb5cf62da91297e0d2dabe70feef2cb39753df0543c1191e5394b1ce023f4bba330c259bb9e6451fb0094bb000711fbcc810294988e7d387ac7ea95347eabd68aa9b23886d3e452e08725817306b40cd1ff74d5c15b29c96e50afa75338d3b42dd500af89770f72ad889c0fb62bc8bcf957e78c551742e5094040f4f05633886138cd2c38c7c5597e88af8ec7155a615b3ff7b78958f9156a08ef73164270e66de67e782c8588918ba05a761663b2b0d9984ee0b400899d05a9538448ed899794d9db078a9e00c48ca38118878cc1ac1a8204e6a098027acc02e57922d337250c8049c7e3533c80ff48d043240decec002173c73b039a8612c523552722b5314aa8c9ede7d260b5550d4ffb9b8fa199258b951a360a1db41ed0f129520445807853a1a860a0f005e3ca96023fe516737df46584e183fa748efeb4fb4815fed7842bcaedee51e1666ab145badd96538a432c2ce3d224a1cc9ed3bb2acb38b0301ac583bf59e4b171b8bc0ea1cd31a85e00da85730deda9dd0ce46b29aa06f9b5605b5a1c712a32e90bd65deb0a8acb12edd60cdb9d7f5c08b3889e6390c77b3059f907163c0b0cfed3a315c3882daa66e58ed345eeed236d0b7c5f62e685ce88816548c78c6dcbe7952ea585596e61c740accd72326b02f4757012599030849e99c8d039186795189bc19bd941ebc790e71b4852a769ec3943c4a6429c6cf6b93f463cdfcd485e4c11a0f1606fbd287b47905691b04dc5b3f6ab75fa39d097ec504f4f079381edd4ee9f7739cef4d888beaa895fc24fa7fcd80eaa11226609ab5e621f5810d84a7fb81491f9dc8162b2dab3f4b38b18c6501dce6689a3e72b0468db79c9e1f41f8fb1f031194bf23470695eb21065da4041f535481cd4d856932317c728605c2cc65f6f4da552c12d9169d05bb29a363e5dfc1480f180808493e879cebde26cb7c4b50ce01436d2b8216ed39ccd0f16364310dcddb2b9b0cb81ce4792d8278af25df9e77f9e15bfb599d571eaad366d6721b735f0eace2eaca3aced90915f92d2056fe60cba99eb5da02feb74fd90a5ed47d7197a3259211e2122e40fc576f14056f050793466f4ab456067e1dee7e15e8b00a60db74d265b98ec12bf02e97b204f53bb9a991af97340a0d260a687be8b83589b0281197d5961c6c2c97b29c86c7703c5e8f459ca310221e688652c28509471c8d590750059af1096eaf50fb198d8785ead1fb139ecea58feace4cbbd8e62ca88455fcb8fda159e2b728d28ff104614faf7c3b82e9875774f6d3191543a6b63b23a1b894f87e596e8c2846371e02bf9579342c640fec0de4a4db24ce8be3a06071bf9f1ac397eac3c7df2aef1bdcdd2c9836bb5d23ec3bdd6bfb5ab4d2307ea944100d4664f960a5fc4e82ac99c8bd1e7bee829b231d92b174a6ea790610e7bbe804fd2c81a6217d01d2ebc0da557fbefc74538903b5cf86b31e8b37a13f0f214a0aae6f1b41a6a6c910368c3872e0d07d1dbce51e7084cc32e66f1fdab6549b68627828717d1f993aa6e64615cd2620e52d52c5a54e56bef21abe98b815b073c63ca62fbef01e74b260b0a7b0d1c7b0d5c0ee07c03e35e7b769c1a41e3033c392c873259e7e5510972be684319fd11c2f6e81c7e676e5e39d6d39025883d431d89e673aa855d37fefb8436f15a5efc4f2b5834c9b243931c7b94ff3adfba800e5746b68348f290a3aabc767daf13ee39d99952db3af582be5a93a0eae5139011b0323f05040e30b4b3581d7308854052942d926667cee0983f2e4ed9e79f05c27d7dadc18410c4c8b8dcdb83fe1328d260f7eb405e3162b474565cb74238e7d37577e50048161a5d7c5b03b90f8de26e80dec5aa48d09b1ab7603c9add6c2a579701f71e0bea0d54d59eee73f6841370a177e580c3e6caf9096fe0156a99aa3924d8178aca0b928b129627702266d0d84d89ae05462f8f6a4c067e438c336d68abd24cc6579d5b5dcf507412d3e29f8edf5168279b65e03f7bb6342f73c02e19389c8dc75f68aeb3de8b008181c7dcd266cac26853e8a105af6b72449d470919c4772976e6478bab021952b8b4bcaa95482ebc9abc6ec75d8c09e8eac4a8ba600bc075188810ed4161404de8e1a1a5c92cdf0ec7f3bb79ab2818410f5fc95ebe0b1768061d4a5c1dd446d697e2afd35f0536177c9dba58c57c2ffdab75dfa07acff5c1f6f2dc0e6ff942dff92c2880f384bb487ca2aa97c7c91e5c95f116875f95740fc5c89d276ffad131d75691bb76e1261242dfc782134458a35b59292d3c8c513c69274605e7c5ace03649fa4b576df03c7dd6d5d5cd9a46c32dee453c577c1dc87bf4fd77ce80b10e8f5a5bc760861915fefbbfb7cc03f3b06e3fdfb66147bf1f115a3ec71f80bdd8bceab86a1e5889bacb591c88f469d238d72ed9134ffb8943fce20b807d2c0ac47a0303331cc6a662df8082a1f1856e4f8b1138c768ed31439ae184330a8715db83edd98c43b7253e08f7091a7773159aace71e88d7c84ce7bd452659962230addd33d464fc6bafe42101e3f022f18b42b481a9ac203715d5c8252911087f9f9d68b6802c41cf953941501e2524c8158273208e777412185fd2bad6a98a8786891fe41ca9b167d764654956c89084d1bdc26ee7c02708c1a2ce62ff7f5cc835525cb9a39cc6379d4da1d2ca8c01287bbc1fcb3e3b07ec1e210020d649843ed039fe564eb1be8d402fb3e6222f9b8b3f385a512b5ce47673b3fe7ad2248f49a1085f6726e903364fffec5e46802396bbc3fc6346fecedf561b5f7ec4ce5ef0d0a76ddea93270ca0a33244bcaf479bc70af86a7c1b389aaa9d9b9807b67a8fe087b2e0d0ba19e6f61f290c9c9c66c15befd632eda53ecda96d5d97f79d9799e8b122b8206b1f2d666271c803d380d2307ec1cd073d31f6446f823cbfe5b6b7b4144ac0899dffc672fc0bffb7dccfe5f4c760e5b1d14f1bbaf6777fab9efcf1bd1fecb26850270b5022ea6517a118b47e1c81b015db226edfa05b05746860ea296518fab9795dd37ff3f2ea4fe387cc5767e061ca90f637148c01f89e8b59d2d8dac5886bce8c65b2f68bf32ce0b51b2768fafde4cbad6be79a694aefc3ffbf77a5ef11530ee66d27b0a62ebe87ad983c14431afdf37800a521705d047b08cf6040da74a30d915f4c572d93adc1c19d2ae5ef9b90eb355b2770cff8f5bf9dc9af93556468522f106c85110ffc44d2ca4ca6505d05bc6f96081953fcabc78908de5aabdd491e49d20374dcb34a338bce00f0b6007f5b00e0969ff2fbba2bcea5aab93cfb6e2b371c302fe7ef1ef36616800ec318e849119f4c6f4269cbdcde19e007b5212cdb2bb92f89ac6fe8be132fa4a3fae63d967a1619d7babc794e3c679f7ce1245379495b5f6f33ae02aede9d1b78166953aab5fe37784216bc2c1e8b2c21f4bc2ff5abda7ffc5647e96d69934b1e753af6bf12a462ec1b52e8da8cdffdc7f1fd26f4d2f82bcf9fa0f7df94745deb26e4c416883bffcf0db5f617fb65916c5835f4070bacf76434f218ff0e1703d42d0223f789b2228107eb9bc7a6d265fd2a928db01f8ef0e724f30652d749e6104145394a8b48114598e67da56dc913be95ebf3e72f91c00050bafaa1e4ee40f8bc3d93194ce86f66a35360bc9ba54079473805245a3d94063ce4887d849e30843ca0c2d5b41895e7e4005463192dd9ba056e284f665734184a7a122c2dc7a55b5c536892179eb3f49fcb1c29421fe8ad334ab1f39043004860fbd858c58d05fa4bb7ea134ace253d3a8e244d861cd4a2cf89adea613152d2a6afa9322ce234bb3d8b2c28f53fbb4559d201a99899c0537397bd444264be7e93ecd3e9f3cd72758905bb2a89e59181b9850e6c1a73a9397555e35000e1256c87c58c1ec84a98270053f957cafa6f5d54c74565d06a0859e1062171b2908e56988580d5996e40f1caebc284fdd0b4e4af626eb872fe9ad316496ef2ce02f3f0390ea3048b018d878e4113591a68d5aaaa17ebf506f6a8ef9359aa14697dc4e69892da57637e31f2a5c754c3bf376b29dc79f6db291e591966722369aa0c63cac9e764c7f7c63f99dab07fe8a3b5bd4a118bcf40960af4a5fa3a011fe40a87249b288855483aba8575bf7a06336992fec1258267a85c031c15f3afb00e4957a7fd174386dfbca6611a07dc847fbb506746003a4074d5a53f4556e0e1275298997450589f303a9ef746da33417d3feddd487c24a730b185b73a6058224e05dd7c519ccf14d690e9445016018087e1f8e9ca0d5066476fbb256736ece48b6a856f7e504a65ddd62fc316e4fc7686bceeca30f191f28156d3306fc139637236588b38bb12178357501790c6e909fa69971649ee9f345ce595c85e466094e0402325076f8dee5989d95fbcd661941756be0b69ac5bc15d73d7f9cfc039dc555c119ec701aaaa3501320f0ab41bd847b7c6a18ded4e16a073dd0882266e2bd67dda8fb549a41a29885de4027285b9cdac20ba65c4fd5f7686e4496a084a280839ba98b290ef54fff7804cd4ca54dc65cda09f6a665f9a63e8f6ed7951eedea4288e2225ed6b4266b3c0b02daf22ce7d02b3c8b67b42c4b93c7bf35475602f522af4b0e108304ff425fbdd93abdef74811cb379695a6cab395c3aaa77afa83a09878a721034611836352b75e6b76383d7e26eae1779fdacb65b23a697984bf0aa88c30309f25c9f8211c644a33fcf3d1d2c6555360ed65bdf055b6ac7873c610bbcbe1ff5517706a2df47c83f55d59b3ea8d88ea713cebdb3c9748781bd2a9064a91f5155b96efe7e448f1ee8a51eff6a04972b7987a2f8715ca72851cdb497515490b967daa0f5b3744c3d9982c7afadc3ffe62a10770880228eacfd50d5d44443c646ff6610fa69baf78aaaef879d6955815ef6a30010ac1618f37a94c69825893540c3a44457970290336a02d97c24281000a3ed83291024961b5476a61603a857fde900c9cfc8983c17fb49b2c011ff016005c2df047efd7916bfbb92ac6464fa8df74c3e1475db2b8cd0ea6b00107174472a00b0271800701cc76199eb4082fa51e2bd6b2c45bb256bd0d067ef1afbd414401498af6f47d1785f3e25b2209f74d4e1374bf18541d961633b6a190c08dafd74c8f3078b2028baaa0ab6a001228bd67160d02bdc8aef79bd52ef2214dd7eb01c80e85e8263e2814c05e0038cf21b73d93594aa8f8afd40a942a8dc0789b102f09e6803d9bd48a7f008147421f4018105b426365bcebe1a07377be6693ab30204a6e2bcb4ecd99df3aa2cf868fa74690df0c14d48baae26f6c1b78aa22dc8bcc572c0f7d410105c327f1a4f057cec1cc89dc37acbaaa2a82fbbc04bba28df07c90b7c21b07826fb102a5e83898b1a2b0aaa608bbc880e94896251e3a9ca0934bb08ee6520f489d028081c729c07a77754d707fe069e2914629a021381c96c5bf45703790f27804d7200d70457cfd4024061b8b02d0aa7f9a76633c60c0babc7cbeed4e7eb45d23a434817311a09f38a813c1a132e9776e36a5ba572374d82d3456cad78272e8b3f0f48cbb692658b5fd152b78201f07be642062d09959324c3ed9ca65ba17e1fededd52670fad9bdaf52e32fd9be95ffe6b9e97bf6701fa04ce6b9367cf4e5be1281679bd773fedb0f7d6d1efc688ef18af6684a24dd28a7f3aee04ddf5205025a79526e8f8158186089ca0baa3c2b36812b4af1177fba068b82c1cd4739a0bf7b121c34595e6d12578cfc6fdf74cbdb745681b787540471096f70b30aa76d95ccd152555555795c2779d159b3812a948968855f1bd0e366de6fd908e833d31d09c8d1a9e8b4ad0925a32b4e8e943a7b36a5260c0a90b04b79095de6059f3746f8c33eb87cc75a346da7d24483f14fa71c08ecd40205fb8edfca6025e29c55848cf97934504253a605009996fe5d6943fade5788f783a15c8437c1977412e04f810c6cf11840ad995648e482d8bce193a9bc1c9a6ff7579b369d189ba88d98c63c4150089d98daeb5040025b8a1aae32b7d2eb3133ec002c2d0149ad1b266a5ace0c7bca9f25bdd88da757959f19bcb53da267d56b9e2538597a801276fe1d498b933ddba850ba302840934466ed9f4524dd17852f55fd2c854598fbca1bebf23c10880e190bdb23da8dae422b81dad08a242e14264050ba6850c6a208ae7fc774598e2957e1454e892df2479e01c46f9126a4257ec56b11f0a5b1d9f980f0cea6aaf774c4069a409a360ef243238fb4406ea18ebdac2c14582ee27232133bb4fdc7e39705be1c35e1f6ccebc30fe4d5a8c141cab50e6d7f281cff8eecafa7fda9a1a05db6144a09299c370901604e230b08fcc456321320c8165216077663c73172f0055d4f188c95fea24f4fb81d20a6fa790fc97ed2f431a088d8c362e42de4ba6647dd4cd2476928f2b598d06da21790d30a8d99b9437678025a979d045594d6f8a3dca4855ea7e989cf9e0314c95592c2ca1ef871fc8f465ce6edcb875adb8b3a227063e864b3c908080ae7b9de0dc28e0b366e50b8f0842f1a90bfcc9bb4f0ccefdfd8aa4a6b30fe58be80040191b725dfd13db03d70f38d7243fa59b64e0d2f137d1b885ce79e4ae8d57214f8c5b66c3e8380219b58803b75b7ef11818503a6f20c660b08f25501fedfbef81780681f8ac432fd33c3a94bf59200b6b785860a02f348dc3b94dc6c00056be5bfbef8c5255d0ea911164626aa726a2a42fd40a44cb10d5cd775377d830395075bf05c24fe8de7c033a521599a9d86c6e64c26e624693a77602d4060ad8605c77f6f5dd216787e1670141ee153d143993c709279424f60e558206133b45996e54b8048025248f96d1148fd76309d16ac9b739bc3be1189505761805ba285473db5873ed952920a49ac793259006b6b4d7439053841b0e4e33ed15b8580fe5fc6d2ac398ca00d30bf776172a8fc8144d89fa50bb869fa4303898768cb9bae87015ba5217e2f51e48afa1d8f3cda9809f7ec09f02d26c25565c5e1dac0e08746752042b205d7de035a220f9278590bd76be3a09ac28b55fd0c4bd1c13a050aa0409869382f42b795cf50ac6167ce93eee6c2123cd46868388ba5cc8a6633abb0ed6b5140a58a83e2b13b5c3cd45f544202830d05e160e5707c262f857b2a5bef306a8268525570e5c2e1389ba6e2b07e8043852094cbef806f9dc2349cb450f576c51e48822542964a3e04287a0019f0e28198844514c1e800bbec0f96d3cd8b43451dec7a97765d7c1b8eb7986d7d92eab430675808aebf3fe2edf0a32d6bbc93664fb89ff933ec47012cbacf204ee7f056b4c5a197458599e96049fdad5ca9df7a6a552577266a4ab8e80cc918b888333a7cd2e31792fbbf92d05bab45074a786494e80af00227bcb2c027992d62e87ec20671beb18c126dd0a84d17a11803469582730242dc7e18058fe9f4725f0be69a23f501043e92244b55316abf90efebafa4bdeb3981e2a5e8b1155df01481104f9b5f7581e4b71aed953a9b3f5ee6d5001c4961469c83b0242f520a4cac244ddb3c57474a74f5c8f3dba2d4344aeb9c645de973d87959f21cfc33da86e759a3b2dc4ec260925ccc6a523f12d89f0d044b021d9b04e7687a906caf69a6b241b1ee8388a2f6c225be51c91c1dc1f69e0526aa6165d0327e78c263139dff7e9752b386c3d5e6ebcf052d34107166202904f730f9312d6fb8a6392f52e9089a68adbbd59455030a2094762174e60519a566eac4fe52340ec156d7e73eed6bac563b139f7fd4f603f52fb7d84abf101bacd1dca1772e03707284f98fd73e02de49901669fa32a3ea6a2aedeb0020aa76c895741a6212e3c75b50d5a13d845eb6f0cb7fe35ac5d90480ce0626325efb8dcdb867a518f88fc4e4f49a28a8fb4d76491b0e2b9da11b6020485c82c618feab99557557d6e30172b3b06a2d58964554d3c61204069886220d9fb4b39d672882b64046a0696f45fae0845bae855eecb44324085a34d09dba4943f4d843fc929855953e8e6067ca0b0667685465b0a1dfd0dc02aa06341f702a58552581bef04f9570f6bd75cb5239f3cb512543af0cf9c974318ce1540daaf1431abe0368d04efbd129667fd8799a6dc6ab9bb1497e4a76363d9ffd05325fbb035ff3c25933090eb8eb36a7204b1240f79ee0155933dfbfc29822312a8ccc938cc7aadbbf17ca17477054c07a03f4a7bc180610e4f488037fb0b8b2c8bbf6d12c2f99fa1e8af0ba086a87d1310b2d2553ed4bf943871fdffad71004efa26953b950e067216d895ce29adc1448abf440e3e98cbcc3a5da04cd18db5045f1e82212893c6dde08f9b8eda60004547b0fed06fc88e27e489ae51882b2ac0b5901eeab453a0e164e8930ba049f0db7c31466e2261436982035d1c2cfd709f857736bd280ae6b3a7670a1ae29a22ab58ee78a969ac243f86381e8963871b44d5acc110841d8056abdd64702498887b38466736da7ca12502f0a144497aec5fd9dcc3bb0977d3a4b07d9ec25307e75b5c720e4ae133ee300b1e39329edb41f0430aefb16ad38aae2b76ec3d73d3d131d57d371798b106bd300950e56539aab54b31570b813d9f8cc218fecc7f0f4794a558896e068d8615ea22b7673ebe3639fb11426568c8b893037df6f82bc3832a3360966940bda8f7baf878685426c48825906d47d941bc2f6808293921563b3fbd2254d1cc6923096488c0823833a4a36951857d800c08614fc6bb4e271f4f749e821ef2b5a71b41bae2ec72ead08f7518c68c3478954befdf9280b8dc419910c29590984b75e2500fe98ae474db38f73cb53086c35c39c7ce6f684aeba7b7daa635ef6c7dc194b60c67a52d62c8b8d5ee295ae3db80c53ff485ba77520f260878d4f0d53fb720b860f30764bb8a4c27fe78ace6913374daa67eca0371ee2b20e35808e0a5e648cd71ece3d0325b671ea15540c2500b15cccae4968bac52178fa02f21b66714fb17de80dffbdc5448839456d1b3dce0ed5dbef7123e9f155f9d60b76a819a3ddeccb3a56899f720d06a6c40c490bbc818117d286ecdd42134775efced66c4d5bd6d4f7181c7dec148b85c5caf3f3e3d251bb89f4094f737e8e78a6c3989175af214678a172e64a7425be8970eba8d3a3f5e2171fbb399b707d5c153ca0129543005a798cb504f93d040483a760cc3f2aca634a2cc7e75681c8f7eb9d96671a33e2f66627f1c9dbbf4b17d49a9f7e2db1731aee23983f0c2b60c7f0738e720e6ebf334a70f0425b3cef8549596629821f6bd9be0590d4f7c6595d3c26b75c49176f049e667d80eb4ca754e229bbd9045714453ea7dd1d404e9fda34f7b49784d17d05414166cdf443cc293f7e0822592533d398702f2b1e8014f2db28b2834c104f3a94cc1e227c234f24c5fc58302de87a5adae9802da759fcc537e84bd9d91047cdb90806ec4ef9a6fe475520603efa09dab5447fd4112335847a5a51be30119c8b77e6a40d2e255cd1ea3a8d6369b2b77e05d3ef4478ef5e9a5e3d7d7d400856af08d08ff69044bcad2780ef4902fb3269ed66ed886db8a3d142d838aed70cda981814859a69bbae453eded95b43321f6e355547749b2f350297708da69af6d0d85fbba81b5d1ba99295b75c3baa124b5b669c9d2b0871e7c36d51b646c910f452dc6f46eee5ad5487fb5290616db2355463c5dbf263bccd9a2990b1336763d32c1d6f529c5976160973589143544df34976f30af3188bb2cc9a141f06bde50d90df166555a0cacd7a437e98a0312f4f8f3865ee801980b6b9e027663f1a012c4640c1d0a47510bde29fb7e58e85992cafc9d6ce1bb53a0242f828081fb8c4d5f21e1a0f7ac1e674cc669fdce249a9bdbf29c4535eea4dda8a71a405750da347ec91fb393777a167814535ecb4439c5f589125947cc2f3cb726b4da24d00a3a9366975615349d2b8b526a8c356a65f461c2fb35116d0268753d2dc48eebc76dff342397e8164f63d48bb9dca5fe9af713cb91f285de678e2ac3ab10ce3f20edb945193c2944bd2929aa384cffa2c10f5050f39a2fc38a2fa9c03fa21ee130e78426be99906aa1a7f3795db96cad6624d723331f5fe39f53935ea5980eb80ac006424bdbb536efcb73ff6f5fc0861c2dd2a0e61dc02ce53af302518eb687dd08fd3453a76ae0010ad8cb81bf1b1748ae3695bb6476b184141a4656636cccd99725f3649acc7ac30a148ab0f09ca40a6e8678a04f973ccb2098ddd8ac8302481ea29c252925187052a43b4d4f0c72dfe435321eeaa2572f52bbbaa60ec1185bf77a934e38de08b30b6960e494eda5e5524f70ab138799e3d8c17bb32b67ccf79d183122f03f2e4e5e2aeb1e251cb32298164fa06353f1f53adfb1fcf6f47f7e532edb9e7a5fea69c850b928b148f2dc3cff3800498a457e02339c8795e4d5b4c804b10c659d5f79f092af18097c93e668630688af0986d759f1ecbd41445728c9dca45a0840cb9f69c527ab891f91da56ae772ee3c92a5251aff71b77ea9e26f675703c596dfb6426241a53f277cc5316506de9f2a1a65ba38b4c750a71d4b1457ec47b92086a7386ae0104f4828f5dfef22677fccc465d82f5c50e67804345e8c92b4b4914e88326757e730ee7763c579aa9a27c4a6fd39aa987dafe5dea428efe7a5ce21592430c38e01b332a0362ba88ada5aa4e0248470dea3b97be9760138b05413f6a86bbff57c20e95e6824dd9980bd5b46bede89555d226b9a79bb9559c455e47f9ac05e9b445dedcb5822c5f69710aa6895f15593c3c84e4632ea0841ff9ef81e570584cdcaf51b00b558c221227b658d53531ead6ee36139467c686d7ee8a6417af234745f0f988d321b86779e7477ffde67a25b610dac05c2ba50902bd271f9dd486569745e6d876d10a15697afa4eb1567a44cccd235271d8163d163e70953b5c03d5998580a2f95af3ced80337eee0e194b58e61b341eca3a7e28fbb0102144aad52120850fcfdb130710c234d3616e0aa29f9c6076125957fc2849e3bd0b5c31226362a8258b9a927c83a7aeca814d147ba85ade88961048cbdf16fd5e861398ac05f81088ad051f99d5fdbc388cacbd3a3812dd6fe8f60864f54ce34ec4a8287567c8ee7abd17d6537c02491bf1fc684c2b3dcea3fe499b560c40a0ed894f1d610800eb8feb3519a2cfd2693661624d56e4b2e6dcf798fe450176b6f01b4e7dfc98b2803e69a36332c74064371e8a09861df7b51e9985c3126d0ff45645ab50c0cf7559f2e8f7a089233e4625dfb6591e125b8dd5d30e3106c84921ef7a08f90d82c75f9447c000b84bbc44616e4997c28076b39e8207badc25e322e0e6196c7c7f00a485edb7a89eed09738de7ca61661d2d9774a3212f4076f22de043676d8639e18ce2f2785655e5c7b47b770f5a94ea05dcaf7977f3a893278ff5674daa5be8f6087de0328e88270b00db302c33fd0a1f2eefdab3798595dbb991279f0a1628a2d188799f3b0a183a2b2091d44dec64281cecc27a8b6e02bcb700262840c57699ee9e8b5524ac08e5bd76ac1b675a979140ae85f89e1f82144df34ef9a92ea19401529d13d5b63357409f2067e58270ca523e04f5c403dd64f00f45c80b4b7eeb4f418ec71446fa0d7778adea95d4ed6fc38005b84e11fd8d098cdc87d7c2aa412fca301d6a3fb019aed2059ade54b828681c954fe97ad22a0d00d209e631a52c9bb2b6fe8cd12e1ddf659962aee532c2229a4e9d09cd2cceb7ec0fce435e5efc2a06f0bd67071fd448f05cdddb4d54c0ccb69baab95327603b40e2e3836e568123cdce55e7e0bae96247749deeebaeb562dd898441bffc6b367b18142d3ec27f6205e8832bef4e4cdf42092f91fd8355fdd9d930051c47d67b48a9d4cb85a9b948053d59f92053689698cb2b3fc59f3ee3936c3b8ce494da3b1864a3f403130651beecefb96ca56fca79e7ef03a7e38800f1390eeb53bcf0101d6bf04ee8d50c5caf388aa0d419698144756364168c349f945f829b12e2469054b23e08ac06ba09fe3c50cc7f69810c6fdeceaa465d4468f0dd83c19c9c0c820b2759fbbc7cda41a60f26a07c3c32c8f5d8b8f0d943532d5c1f715f20270eb2b1234412feadce84b5e5f51b3aa37c0b1aa96a14996c02ac453af0d47aca0f8408c5e7ad53e809de0260f99641f9919bf8916bd5d3232dfda29343b7cb0d3510cd13ac604e500d3995a966da5f37de3fd4939eda79155911d423402519ab5b7e1d7c0714bbc1dfe4e5308ad60169e9a25efbaaa6c9137f3a4dd92e3db82057ef9ff9cdab9dd9a7c1c6a9c8d8ada392a955c4663c6d31096b950b8b3bdc7a2d51dffa8d2afb5cca0d1880f57d3b022a123923a23c71625ac0c940ed3e0ee793eb44562eea1ec4d0b50163ffda0126ea5daeabe656ffe9930f07a44573ad0fac329e7214906b7313e559ffd33f2f23099198eca2127a0ba89123f227cfb0587bd8847c3e60a142e967039da5b89eed5e60e2465a610e9de0bca4ab8d052ff50ffe63de24e912be671cca4e1ebf104f9032172eaf36fe717dbb85fc75149e2dd74256cf9ba16f7657c86a631f99245928f8b97f22d1df8180dfce46dfb4e3b077cb0034cfb93509df4f455b53256341bfb41bae2ed59a3ccc84e19593480b702be46664de284cdaec6b3a9a841ba740974817cf7d86514290f2884dc5c21fae42c753e7968e931902de202aa6fd7b547258568e10a6db574531c85f877400b2dcfdbd0272b9c2702f939e869fbcdb9b2e0531be98afd1a6edd48648b77890c4bca7cd69cbbb9e99756751369720a8c1c07795b390c065ff040d21a4071d184d140b5eec2b62f4f0071d784efec48e83a4eb5405cec2c6cd2ce4caa13f0d0fd98e7ff73d9ac892413f87e7d3d42b40925747487c92a61c6a3817ec6ff6c530c1c7f74d7a36cb779aa94c2fd87f88340380ba259c4562c1f0ab68f61177b07b8e234705b6a6ac44127f710fed1af38054b943686d2c3002e341cc73c146bed21c269940bd532bcdbc6b078026cdb927e5d1370acd5b9d76085695fb4593fb68c16f3a8e04e291226cc90e39b3df2e851f29a2e84faa80b48ccdb41c1d8c6ef9e097347c6dd7f9638eb1167eeeba40f31d429955680660e6aa3bf0abb00a9bd8aa8f28b50de0a1d7a27f6723f6b14f9fc2b5ddc0e1b479c3050b8b115624479d4ee4b485a2710fe86fd3e863cd2b7ca8db162dc4724e3fb121be0d99190609760f2e481502ec7d3d6b3728dc1f4851777c98d91e6bc7b6c5d541776f78dd3a3f631da23cf62e45ee496e9d8bf7ae70a86b066315f36540e7e1651701a681b5552f4ae33bf9e518fc5cf1a21d28c7c200d7a1398032b0e00ec0cc43e863ed0f71f22513cec629968319f5a2a31981f9977f04725dd8bb625253afac3aa35b425c605cd8ce3423635d7a2d89638de787cd286d4e3e9188f54a771d8700bb84d2050da62c3606a64462b1be36533f0a73bf0f70f1f8b195fde8b55864e9c78bd81802ce9035cd302848115cc59d6c27ad5e2fc6ef043125414fe3546b3efe41f23aec1972b16cb4a760d6b65bba8574da1e97cf9a639cb2f1471a2d15ceb1b59bef2d87b42ef795ef5e1d709625b7cd59470b8fb12cc891c00929a6472b4cb631d7ffb4e800513a728e111bea26bc6260b76e11e7eb0ff9006860af4287b7664d01cf7dbf0b60ca780da3b62e20b056b209201c897f49f64f7fb8e7265390ccb50330f263626f282a2f4ff3bdda52770b96f1f321a87367df6dc59ea359096e6d1d39e638b291469a6e131dab735a9bc061c039e876113da96fe959e6a2dd39a9ec49236352e837896cbaea9e0e44de28f4bf2ed53d18507c42531104dc06d659423231e5f6a829a9d2f7d7174a3812c6236e5d63abd13bfcc818b4d5be9ad6f781be8d14fc2228b60a5e9ce34967d73900484731fc4e51c687b7ded59d56d1fc828c5166d53dba95fa1012d0b8e77cdcc3fa86bb359acf41f0c523f339f89a67a9cb1929a1a3c609a18c78f6f7ed22e0effb562eb4fc1f5fd606a38fde8cd4481730d15639ea237953c6dd616d1b33583b8c8d11cbaac8542be429c87f47e41388d5493f7a2c112eb2125a08919805c2cd115a688af8a7855e8b083971898b62aa912d6b7884902e3cb6e3e90497821019b5a4d7155f5433fbaab000d9e71cd320a522d9b65996e0e8672aef8b58b5aedf3e44417f5e6475670903c2640cd559ea1a2b593fddbbecd60f4e4d0a2bcb1b998e59bfec27bbe54fc3bb69b0ebd4b9a4ce41b64dff1dc24fcf6a1be16240afe6618f7b06bf77db98dfdd13993b544e1b44aae4f0021a585b2e0597e47a364790fde0371e458e9ee5773a0a3fbbfcdafbff8789ef589e9787b57c2016e4b43439a726ef68726e7278faa9dfffbb7367565461634ba724714e81fc59d6453f3c42e6dbf8b9771e56bca2a1d459353d76ab6f197e01476aeacbb40f364039cbb806a9c6a46d5439ae16bf07aeafa3dc71d8977993c8f26f5d681a0c046b631c144332b890b6fb9418cf92dfd6232582d65abd1c5697b5bde72aaa003dd09ab8a14ec766c69cd140bc1bf15642477063d30743144447a698cae775c29ea3318cf8284537b1d8c45ea1a5475113a9508c22b1a6d6ed7c4ac48751fa9f7963d761cca2ce3848e75ac31837d11c2b7bf263e38dc9a493ae4e957c0ef74e87ade0394ba92a66db55d9b581c86f6565a0d7c54888cb8cb574b762234e835fd5ee190de74cf8d629bf6c7969c004367b663ee28607a7ce5fa674dce5ea1e17bec0bfef28d352c57dec6eea5819d9a4067a867debe40bdf5d4174196a7d72a337f221913d032def3d34ca7a62d8b8bbe47af9948acfd4ae78e08a98e59643df1c9e90f0777712a7d30b00400dc4417443afb09e7dce8e9ada8e11592e7d69f3499b03cc59a33ef32d4794e84a5e0e43213ed0a85b2469cf272fab755d9ec577aadde5af3e5caa52b2692a10ce90321b5cbdb49f236e99007be1b73b387bd9408bd396fcea02970d33d4cb7080fbea5f5b3ce7f7ccf8d43b6e9515f6c7500d58f1fd674dbe7de432306b77ba41c891b75068763109dc8c5d528e71a2f26e94ab7b4a96dad5f355725a416f977d5bd1df005fb4f37e31ec531384e4874814800068de2269e62e0c1f9f137d18f7917221faf235ec3af282b73673d1ab1469551408ebc5c2431e01ef850191750ec7abdf00140b9c15526b20361cf185092723dd64ae873aa33f04a994db618afdf7be3001696979fcf6358d2727917bfc34ef71cf5b18467811db287080f4984ecbe2c74189e7b1fc4810339851440df965da34589ffc569d2cdf4448f4addbc83ebaae645eaf6318d4dec54fe884d2e8e04fd0e57e0c89ae3ac87dc08ce53557fd2aa9a79e76fc71138de71a5e647cbfc5dbb7ee8c7c6a371f596dad1a3285e23c555c61a1176e9287362aac26487e86c69115cefd14894b906fe5958fc5d3df1379784080b74494c7a0745e9ceda587ffa8fab0406a94aaee4f53d11085b78bc9cd248d16d6723055210a7b6a017cbbb08b5f5acdd165398b94399e8df75ca96d34b93cee4b46b8f087dc51f4c47643434a2cfe284357947e7f45c9697cbd0f63fa3eb4b2b645d5d5209091759e3685a7b9cf216799a33616864d981c057afb9a98b761c4ba161dc95c1fac4ce644b088989f555e64b2fe70e1d5325587f8e086f78107bf7174cbed69a537c9ee8059a177e0d94d7c94cbb9865e415a7c04e5c1f7f94f59bfe944c82e226e60f8dac4854ad56fd922f826584117d9cff6572bd1cbe9092bf151f5e7891d3466a5627e6af99c4ce4617cb365c405a1c72ac02044c906655e9078fe10f1a846f3d87cc9a036c88c03686d84d7be165f585449c1d0246068d2ace354ae8152fa1ad9d980f84f5baa6977e8ba51b172f7973f90a05eeaf699e45d3d0488721b1341fc342d31c2b42c1b16ba00ab21f7089679b22191c4e654ed0c5e5b1ed5bc313c81b4a9d0bad67b4ce947b289e08d4cd904b0b0a162209bdf6ae70c307b11cb2fbcd74f0c290585c7b02387d271038967ba0c09fb3a0b5d65fa52aeee3b864517a2115250d8d11133d0961da46ac0798e969ab9f9a597514d77fb78688a06191b1b99837dc4cde738c9e73f7e159fc59903c42faaf6a6a48833aed738148872eb2da33b81281e5521724a0cbc2be140dbaa3160f3ee7ee438f969ec09f988ef508e24e9707b5a3d64a9fcedaff0df5aa9ad3d15ab9960f6e2a2d96cab3d6bcd5e03f4421959aad4689832baf1e6883582363306a37b72a9d382d6d746c5e606340e8490bf1716a7af5ad54835e1f5f03a0ddeed372ff98de389f49a2e06f7001d49214a1f497eaac98d953c6fa68d10878f0f4c00c3dfc1d63258f2f1e5ea951cfeb2b7635ce9f71280b2c66bcade3a4ee4f26634c2ced3df3a26efc6ac98e5707c20a01f6d349241c9ea0f9e8d2e5e759941e841d80696df4ca48f3e4d06d55a7e2bb18b37757212fbb0766f3083d20b2169eb9a51015fff838ba5617ae7f8e9e6002dd8ed488383fb1989dd2faf17e34d75c2db7bf5f25b2fdfc2dd8e91baf4251e641aeec44fcb0643568c507ac11ffd39d5d5caceea21c2f669e3cf14fdd60ae72df0f66633a9edaa36749c3e59bdfcfcf4584e2ac7be817a4f17dca7858ad5b943eb757fe2220d29de4f428449b886527e95c1a6e91f2a5cddc155cb7b3e3a4f6e3d3e9dada8c0680f96dae9fafcb8f0e1c75d5cd03fdad6d52a6dd543c275dcbe3b897b7c827f3c16f9ede3e6c4088548b8fa8276524515c9a4cff79ae1ae5b0b49d0f6866534e15e6fb5967815a357586811fd97645c3d0f35161ed965faae6d5908a18f70c137b5bec6a4ea6bcb5e4ed1b7f966bb397ab6751b3cb3cd92bbcdbfceb95e55a94cf4d3aefae2ee39f237ae5be2fc5b8ba329dcbe885d4499f3feb91c9c3bd1df7390067a340773b075a0cea19f176394ebd5aff1983456e6eb0036d8e9442074016835e09b6d1453d1a385dc9673b194cdd71a69c5dd2c6cf8857087ecfa79bc24f44feff1f789f95304ea1aa467cdfb4dfb9b8dcd8ba7e6b3333c1edf4a103a17b2db22ca9d224621144e6c4523f2daac9d66eb0a2d282612d00516313a52d58148d4b609a40b9e652a5518839e6c0588b96df72df450a8034302d7da80bb54832b0e791c56891cc8023ff066e71c5b03164b431e2e4d93178db2aafe54bf47b9b071fb1fa1e36132c818455a62ffb917bf983f703ae0f00fe9a2cb0833d311e0e692107bc82fa09d15b2f189a6fce1ebcb74893b1ffa67f74fba7ce43fed753909e8fcdd871541266a8237da1f8fffd22bcc1d45c8f04fe617eb856c6367d3271a7e117d22f2f914094f650ca21ea366c41733bc61da80db7e8e1537f13dc3e826ae35d45af8406c6f7fd4ca111c735a04b29f02b323cad30dd5912260aaca56799369de55c6340abb29a4b87efc45a237a659d8f5b3373b2adda3dc071b08fa4bb0f013d0344af971319d8dc30b3775e64e3bc4a18da3b282f0bbf9700a90e19d6332804fc5ac8a082811916680caa9077ac0a2f0bdc1c58f3a2fb25641b4ad1cf11942ee576de34df07e916c8c02c3c44e5e3f0e0a3ed18484ee0412d0c798b13d56f2201e6f9437152080ac5335b92e55d5345bb5be3d70f8f5862496228d9c1a2c6e221ab745cdcbc0d78c6aca62ea489d9ea881daeb0f0470fca4bc61c4c833f2b287f23f4f861d17d4373bed9551fa91e97d4e377bc7b0ea2e4be8120fff85045ef012e747e327b3a58fd407ea56349fe64349b5a4d549c5e77813ee304fe21cd055f547bbd148c5f0c695b5cb141453c5eee78f837efd435528931024f17b13af70c42def455ad78e5c390fe337c5cfd97323c258aa79a8d7f702461f3ae8f223f99fb4fff276b144280c63eee38beaa7d673d5da1a3333752207da3662aba4dacb3270a19474b2cb695a74b5d2a2522a89835ce50f6c2d2414c2ccb64146c943dc9a10bf899abb9ecaabaecfcf4cd26af1eec3b69ed641323757df2091b56897aa3697d866926ceb79b046307b52cef0cb715a2a3e61f68907c426b555f4cef096cba94fb538ee7272069876d36b49432cb1493cdcbd7b418a8164d4622991a7fefb428e8902b86f5ede12ce5311f95030d98fce2bce3fec7207a920a8dad2135e35b416d0da3b6f40773850c3140602662f421ad27bf5dc9e3cade7b1e367f645923991e2f7d7aec46699071a472f6b45055a7f48c98777ec68653f8b2cb50d5b76aae0e26341d9e1783615abeca859bd6c8c16fe440f14021e88937e7024289d5630bb8961ba21dfba816f79b116eb4fcb0320ab6cc0be705a5ec183452f0a832d77c3b72542fc4026a19b1c96bf634a62d4ee756c8329955a60e09e69e4f0bd60acaa556afeaab0f31bc776340f81127ead9ab53b724794d058a0e055fb6c345d8b66923134b67acf4979c992c998545220283d8453ab4333147db08c14122b920c78579acb9594c3ef4d203c0495fecb3a5565d6957b3d86f2fb2e77750b2d87017e91abbcfd41331991bc14ffcb5389e45dd34dbc5a508aa2c3ef0dac10418f5f7a20e3831d8352e536bc88294a0c94a2fdb1d8f7db34c133daf87ba002c33bd674e1cb07c74200cd21ac747b4fbae7a3cdee081f78fe1b3756300dddf074ce93ea0f6f92a3c399e0093e6d10b4481c68bba3a7ab95ecd9736f31932a0a895c518c35d481c17998a7e3bb197766b422b5696faca6aba3feb6bd4b2bb64f39edb4f14daa35f63c1c262cbc610d3b2fa0f09bba0a0e4da52a9ec54fd79a9f96107f3fd27148a12ae1e9ad5bca614dbee8e4fd4e39cee4035d147a5bbb5eaae00d85c833b1fa248f22bfdfe3527e9553c35e0e03ce024e294530100ece0257cb410a99e1c2742187429e735930e28ac8808fac0dff85e74fdbda1f468a810eb73f14f9d269bd7915478f31e6aee5579820e4470504603f3f48014953ef8bc4dea39c048cb831780cd42b130716a6b74a83b7675cbd0d929f8a1e5fc966dbca10bff74ebb3fd52aad6aad906997cbdb90116353abf2824d26ed7745cc8e1c67be348eb0a5f9745026c60a8e207aa9da44e0faa17f92afb485f067866448116b1c7f9fb29a951d8284d423223b222b1bdeb9cc9b83e2227918709b100ab16ec973068b42c1a5e07a3c08a8b7a8ce1ec47100c06afa48dd212231eb5a8bf94d4ac3fdf6a87b42fa147ba735f2834d38993fd049e020dcea2393b19f5be05ce43297c23a2edca8c6bcc964a6aba405b090a443b0393f717c9cbbf54690167960e99405ce12e927139697758c38e60c6c896e400a3fbb055adff5c2f0d1701aaa4c1c644ca22e29a3931a044f97a2bc97827cf893d6216deaa6f77dca5c413f901d9c3ffb73f43408df2f23580b6a0d718217712b69781118199f718df8428076c5aca8979b4de7d1627cdbfdf8451a0e1ac32dc7a01557047b68cc8f8547b86681b47584cf9e5653bba78198588a42a6c37cb446d146c8123bbf8d807f62de1457a674eadfd52de15edfdecf6a3b97a78cf59bb055a7c82289a5547131e468a8d2b4cf77f9c1761d949d9790021d17706aac6efbb101e5cf73a305de4d32a5e5ea849ad12ecbc3006c9c49e2513dd689e7c2b586d825a64c5cc8eb5ce645189b9b3c7fac4b15f25ffc08053e93c495c2145529d3b0fd7ef87713152751cbccb53e516e6a2a9110777d051da221576ecfd15216836ce2f48aabd1d11d22267dbf31b155dc8af344d519f99bc20efbbd4fd17c6dd6ae866fe94f0be30ccc173d96332006913a240aca87d8529928643234fcd93015be2b3d6615cc78fb00f559a53c2f0bdfbbd34101ce500df275d6f3806532869e75bc4fe5c5cdd1afaae2d7cbeff72c94683088fb62b1fa099dba43130b3673a2832b9176ce5d2267341fa573cd5a715f310d9fe2354538f713b196403813777fb1b0de7c57203a64c67e1563d655bff29c489ae7ddc704b917347e225457c4f0c3c40c2a04e030520b7885cb3e170eb5d3fbc39f5567cbf90d0287bc3ecf5ab3e7dc4efcbabbb3859210777d4e3559a14f98eb0b5e9f9c22acb4df0877b4fd42b609a62d7ff347188eb3b21b3b65e98f759ed964d79e5133327a365f46ec183b9eb75a3ac9c847479560de60a4418c5f18a55f962241c749919f9ac8be89f9230eda7e29cde33a56e237222021447dead387ac8a274f627248a68a987e6498dc8300441807775bc0992cf3acfd2feb44b88789aef3307d759d190db010a7e7d835a260c6e560c6a5deda47e39e11c3a801a09d139b293174b3e397f91f4821f1fec13bfa5624c6792163b783832c4bbb5d103b4549f82ce7305a8300a76fae0fb1183db1639ad674c0bfccc5dfb3ccb80eb0f887ffdaf51e3c9e13d5636744d1580a8cf40f87b9964e0a7be72949f3ce0a570d748b083e6d3959ad0ffb95987de7a5485b5d0539b06b6657e93da71a121de31691eb27e4186d1812f7ab90eac635a2c34c5a8113c3ddaf10bc1bc6dbf03bfca5b8b0c85612735b352ae6a96c9c7513d5644de5dab5c49d08d4f39b2ca39048daf7ebace46c7e9c1a2dd67abd66469875520d7a7f4b0e819ebf7561cc20d654c65bb1671377492ec586acef682fb8a081ad22c04c78047c067866352eafc5fb35774461d62b4a5bb12b77c46f902ccaf7c5cd6b7bb17d871ddea2f2f919214bf86a62f1ba9fea221a31102d774b65da27f28ae33943746d6d0b8f0a671d68deac60e4e2e0f85034ee1aa385c842e43c59975e3090b519c8aef0abeec6928aebb8e028d5303fbaf62639aac301583d7725f3e156a837b488963ac471d5be1b92fb607ea2ac4dd852418dc719c0b7d33ec8be9712f0a994b871c9893e90c55069cf707464efcbef75a68a2e9dc82d5fe9a5f77c78e604901a4d9a822762457a32c73eeee8eb8e2c2c9778570c628d063c36ebeabceb7d48a832142e825725bc02e834b271f62d719237773bbedfb39036851d1f43197e5f85a877422eeca383ca2809cf172170228fea6de866b5e08db674ae65527f457896e4ca77c929235f7e9db02441a1d663941d3b786b84bfdc3078814e783e7f8942dbdc81e78ba12514bcea2149bd50ba2c41057c6e997648f4c186f7d15cdaa47afee61e35db8f20bf2e714ecc5c7dafcbfdb81dea4b3e11f94f735c1f9f716e771d55df9e1921c7475d1711da8c13a862749ac27f49ff818f722fe83487203316731a08120c4adfc410e52bb80bdcde98ecaa47d3b3929a772884aa95fd5df956d5485bd5481320922a421f145284459bad4ee4f40c5fe79c1c826df8ab2b49f80af8eb7157cd9ee5701abbcf98f7a23fdc53b403a5981d4bbded34c0b28ed660698c67093edb7814389566c31cf2fb4a628d388827f7a1b911d661b706090738ae204b8d74260d031ff1131c75a7c6c0e57aced8cb2eba4226fbe6ddc3b93305411381130af19dc811ee4c05be076f1303eb7b395ddc7fc822c7d39860421d79d5df8fe42f4d1fd16256cc800a2bc676eabd3e8dc792e8141ab2fc952f138e15b123cccee07e12a20b628798ab58b873f57b209ff28531416f44bd3fe1b21fa58c9f648869d3e3c5de9664b4b9b55e07821f3f32e7118e9339dadf46ccf6935210771e6be327ea6498dfa58ecf1b9818c55fe810df03286b6d8e22052e8f2b367c8f77f67aaf9cec114e347a4420e8badfef0de27aabfdd4bf882d8ccb7835bfbfaefdd0f550365a1a306a9a5cc6f4169dda3ef279ab90203ddabc35de0f3afb33ddd9137e0a7f82a33da82ec25297da4db6aa41120fe51b48b10d4d13be03067ca0da5a52ce0071737d2de9d328dbf2c364a8632e73ff30b872de0506e3f45051e657fda5ad5c82c488384d54339161357b555b5662f33c7574e1fb6b6148fc551d0bedec55cddf0673a35b679aedeca5e2fa38498f3f6579b94cc3bb06f03b6f6fc9f7827f7bd5de945cdda0f57f6e7338ec00d161beaff9c7b9603f5a93c6f2813b56cd93dfa0fdf7cb912641fca5991cdbde4ca73b04f8ae385c0b80d5da0db07397fafb6901b57f6e85617f1396d1ac3cbac3076c90c5c29d9201fa05c6d05790a7b871ceb5404802d3f095607faacb9861b8fb9d708456e831c2e198d07a8c9344e19f90a3e5d1ece92fcbe5a2b3294eb2d0ab137cc2fdcb13ea451075f5c42a8e2ac1c7b9e83be574b1b1ed46eecfa0bbc678aafac8fcc94524dea5f0b363f1b39dae2791832cf0a698e3a81e1459a1d029ef35bfbb3a5fbab9e2e8f2365cb58deb5b88297bdf2adb77017b63cc3130af606a8ace3809a9acbcd6f741915a989411ef0499e92a70169f887a22f2aadd4a10e3047a2f851c5db0ae7676bf97c36b2abdc5843b19cff98401beda8c212ef9f9693f7ccbb0acc03a244176b6c294e590b2b5f62562e6ab167867d8ea58e74ec0b3a502012463a442c59edaea312fb194261603073efb9c6d270308a489768305c9e6ceed3e59cfc0e4141103c077650794f13435c71dcd0801e33f563b6777253f11136b14dfed12551a1799d749fc697a303f1830b67bbb46a8c8491849d04eea10b3199938c225697bbe640007cfb51b6b55c7816789c4f66177d958d05383d71bee2f436fa930c4f96de2169229992c7525fac73d00f15270d0967fd834432f3c6d86e090a4746342ab7986971b8cdf672790ae6e6a7396130b717ca4904dc68318c3df3465faa0d697437ff28ac06fa0e1de213afdcc5a892c8df69d8efbe190164a57c879faa84365efe3b544f01bf808aa6c95303ca7d01417d47731b57a1ea353db09c6fddec8eb213ae3c6aef8c4a664e7b5dc522371a362c7a2ece098e06e16c63501c67add610bb940f1dfc9dd53fd30289c943e639df255b5d2bf4ea12308277f08c8641597363165c012ed362a64476d9ef1ba9cbfaa9cd0899e6002ef702c7ef49df838c45424671543c813b887cfb3e4b7107dc6224886bdd482e882d6f40c5e1a096366261c8d47698a60c57f15ca9621824e84036f8e43245e2eef14e8391c91348f172b1893c08a5903f197eaa0013fe1b3607618860b387ef71f16c62445162354ae9997d1088518670547e494aeb7e9ba8cdf0b63a922ce2a77c849331fe3f1f63ae7d4710f027598132db9307d0c2ad1906fb5e639f59d6d4fa9931908ea94bbfe205ac7d97a5a791cfe421d8c61b2db3a317b3242d771ae7059d5a0ce09b5773f70dce0b4687b182cf998a153c800eb0b2267f02ab3f97227ad2c67b23a45aa1d112eff13e7810dce5e8a2fd8210e44fa3e93977c340ba99ce457cce943aec549698f6a2d44bcbd68d3c9435319bada0c8698be7f57520c2c9272d62c9b8a52a1179acf812b27341b392e31169f08ff14472c7d22c60d478f1180b950558569a77e283827f13d48ae1b0f12184b276dbf72e3e6a35f84125a2c16be48f2c170f0ae12e5a6a5b92faff51b0d1a58445c3a0df17ae82930cf086f27a2bb081e7aa695c5c65fac3f4e04b8daca8644a165239db9f1d8d64d0f5dc74115dd89de9f8e7443cdc55c3b1083393ad14f90b6932aad2a7110a144730fbe8fb964549090bbc9c7d08657dc17ad1bd1686ed0affb23ca897f078ee8c54f72f0d9d3da583b7d0571fbb25b1ee473cf7f81c2c9c47e58156ccf0580865525d0e60b42ce459560e35e0d85f1ea4af0e9a665f8b60745127715601f5bbabc243ab6de6477ac61cbaf6439fec1ff584ee741ba77dd04712dacdbb58701e312062908c1d6f525e6cb010b3be8555b88cab93a3054e1c7d2300ca7a20cfccf733a304be3c5e38e0949805a227b0fb241ec325513da1c590970f89c026a7cbf9f35bf2cb7e7ed9ae3f9a4c501f30c295c245fe66a871e5b70370ba20276393575b94d1281dd353c85885d975963f9b5af20276baf9d9bb7dc2d56938d863d1b86e035b551a72f01db096915fb4247553d0390a3b7d870fd030a6f1302361f6e254ea3eb87f6b01c1e415b5874c228fd3bfe70b8d837273aef2d20d83f206b9c351705b1ec51f4ca49d4ec517fe4ddfca261f8a0c5da1c159db7ad0acaf56ca0e64c24449d92d4afd3e8cee56e2d273c9dabcf57a92a6864f2b57b90f435f34230730987859e68108c92fc76cb2164d74981ab6318faa823e3ba80b2456bded349323794e1fdca5c7e73d006d3fb56a1ce34bfbdfdc18a4f4c49009097361843f4bf8a5f5428426b3941e18224bd9af2737816c80bd6b4c5f851eb54ecb295f9fe768454f5c9cff1ca22cc1ba753b1667dcc2eaa418f2212875dcd0886580b21be4efe78d9e711db6f50eaad0eea9f64fad440f2ccaf941756325dcaf9bd988ea86acb2b3a0c152d13b6c963836495962e373bb74a3a81e3f4ccf501ed0ad796b0ecd7c638eea62fbd2e465d408102e3b3f7d8156cbb93f61c7075c2a801bf7607c846a9391d3ac1265a99d672a56fe19f025f7fc7eada0ae6c3d0b533fc0d08e1396a39d48f97c727d751261fc3b9e5f71652cc9d3b7771c97ee2a40fbe8333ce8c15d85d2c8376d12622fbfa304fe6b93fc991e3810cf3b459f7aef6b036d491a9df9ec257ca41836563398c2566745e73da50f051dd7b6b7cc84d495dc86bf48abbefea1b0ffb6aa8bb1772eafca639d47f5e73257ed82c635dc3b973c879c6f339747a2541f631783b5a94f6f56405ab4298b2f84e7500bf51dd628a0ec14de76845b8848d6f7112fffc10e6bbbb8064a864c6a3253823ddeb7a262eeaf4232be1efd0a7ab22ceb8022667d939f7ace83d3c620010fe64bf176acde7a754a1aed0911ca6de05db2e8013da256d59353ac38147fcef2733bde8711f5d113d5afe555c7acf788f05c85463f35c2bdb7793503ae70731202fa5869de97d8420383c02d5da643405ab5bafce2b621c5b5098a5e6111cb73b2bbc247231c88140934fc0daceb9ce33d1afeb5ab7a501a1377adfb7173333c140e3527b0e936b6ab9480a4cdbbcab51bcc175c413712ed23e189df41af2b658d6d347a3c28a9937f2ccc61ace04b6e23bdf6f59a8164b10b53b0bb50482c6bdaeca6c78e99790480997d936114b19c2559517fba6f1fb099582ae65c27b64c9b311579fb1da527ba7e23f2759d1cebb92374e3789cb1b2d2129357c3670f34b4fa6c2f17565e0ffd601cdbded3de3663875c4e7de78b028004e7e6b71d8b712c3d2025dce4fa61236ffcfafd6db60787335777c69299595472d60d8dc8c4f753d456cc5c45c75b6e8b55bb9f6dd2d1c88049755556a74c19264ed01e1d2c0a7012e81f7aa5acd026cdf9685f76d35ac294c16388e63bc68132e30dd8f487462f2a39ceaad24bf66980d7d3a481e061839e3ca99c5b578e14a5239e90eb80292bbf39015481e469884aba2a85ef61c713a81a67ee0dba0e2985cb0c1f39af0b7dd21c073476af04301349a6f2154122040bbc3b27f3255e41e86292d67d26bb5164d600ae65b3393f6713b9eb20359bc5cae276d3fac4481b078a2bda13a3d90f517bb15349c6f80b3b5bbd203fa15412d19945f334e41cc6e7054900daf648113a90a72e66b9af1709831f18bf62f116b2c2cc95d6970254b5218512dcebaae680b0984c85778650731ca924e4c52047ab00e0e7c0886eff2852f34a62de2e4595aa6b6b122e9baf75a62c1b49eac3f517f51e3dec06f3ec1b01cf18409c3da1fa55562ae2a94cf34362dfc320c3c3b8e68736575efc70bd1c38409ced3b3ec972881b2aec4c542d40a67ef0fa6c73565b9cbc9e8e4836132534cdf835c61decccb0a9cf86997c769c1d081e0d59cb9b935c0aca1b1a54d53e38bc0dfcea11d1843571c4ba3f30348020d11feb21de6dd5df38be0b7d98b8d8dfbdacd7dc6ba44f6d884db1d2e46665e4f88c5728cc8f2a99a97d8a21d0856a61e611ce9bca1df8b190ad522225294ddd8e1d0f93d5f9a00390c7112ffe81267710432581aade03441e48c8c27cc1528781878a2f85ec2757637530ab5426883fcc7cb07fc25a21b6e6d61563e0d63cc1274d8824d0a0fbc97725e09384cc962a5c5b6d30b97a05a244d88fc45fd4912f6845b7b612a78c1ff3275df714c9d31d766ec8af9908fb43fa99dfc0c1cbd4de449abeb6026523af469af7087aee855407b31bf3d221710f2967992a96ec999e7aa6cea40a0b30746e0e3b031683c9d2a2e675860dbc086b213d225b5660dc55669db4ae60a181d49b0102ddc4f0198b0f3c78cd80cd93d817e7ed7a3fbc1f2d99fd3622644971398ef5c4202450c7ccc508736d75c25979c3bd497c98eb562ed37c1a3077ee092210927f79e14c07ca25c21a4e5346f78d6d4db3ecbbaee00f9dae1936fa62a048555d04a5d70c121ebb83838c3433e13d30c43912711a5ca9ba5b11fed2b7cd2302e40ecfb760cbf0950e840f20ae972f0eb955b166a0a7d1a08d842d3dc30b4ca63065d80a1a46daa8f82435e1f3723e8072733003c009fc0e342477efd1d55bd672a00c4d9987af053118ef98b14986d7983a597356d3a214c5a214cc3322ff74b7938580e6f1c6d57980f8d6c6726829911cc4ae36704392a6870b13746c4ae08e551edbf6703647664e27bcb6067232ac7389b8476b59568680244da03cb366bb5747d212f26b67368015f4360d81556c2e1e60a301de597acde379998156c0b6ca3f1825b159d2871baf0d20c635a1bac9605d71c0132ae0c458fa8f5bd29b6b99f21ba131e3b9a167cc140d3b027c2f437c590a67ac4f9001646170d06b93de09e7ed01355ad72ee55c8d1a1cdea75a87b4f612e110479a8709a452ba542707fd67f8be51de53a15f687b3c65b5c2d8da42731194455ec3f3c2f2a2c045713bece7352c9d579c5ba1ee1b3623c13be36def7a6bead521bd3689884940247580e376292628f70e5954897bb0c14ad68c6f90fe71cde94b7a2f98f080b86060b201344cfad201328cbe9543e9f09fd560ca66ac862cc53eb62057a2f7912efefbedee1d5010405ef361d85c967681df8be9631654b3bde0f92a3a137ee501c68a51d9e168303f03a84f00fffbf7d518796dd4b4e9eb7b16d0200bd5decc9687ff73804f5b864bf8a2ae4ab4adf2690a691cd1d2dd804245585087b672575d8eac2f24ea0e50a0bc95ad8c8ffc1d3ef81ce6506888b2eb7fcc46e8ada42740ec82002d2f785aadfa91b580cf0aaef5892ca9e264f9d2acfc3dd698915a6e0b23ccc88df5d06b50b72fd6ae8cefc7a6b23e1f485ee899d210d762fb97e65f9053a48bc9a2b30eb42ad9a923180bbb5dc0c96feac875d55677716218c401bb074182620002f5bdbb14c89e1e8845787d9267f58b0341bd78493bed073c20576e3d0be15fff61037da82e0e9320071a82d90b65c5653cf4f8c1a168e8d11ee0a0e7397032d0f80906c6360009b6f9a9bc82858aa00e0c9be1df92b02970c2eb966c44443bd50a996ed4be001df0d16f2f0badaeadec7e459be667984436b93ab4d6e76b844e079d7b291e56c7f45323b076133fb74e53fef43ad43c9baf535d6ff3b172409b11cd4c38b8281d759e679afd0b7adac085c8170ff778e00928e175f5f11a61bb96efeb1f83383d58f36ff8d65c23f5b6547b3d5ba6a70f9d93dcb0482f8bf63f74fbbc53aee52799b46c0b5e6ffbfff65faee3f3fa5ff7c18ad2d91a6dab1850986c21dbaa40700a03eda537d43c5bdc81876e2ae88711a577df89b6f0789d3c3ed10cdee10809cc88d16005fb3a6b4e330e9e9f60353a7e3d04f59731700b75cd14b84c05c3c5dc30d4176f36230fc40f0d76b618a3faacb78081e3a21e49a6905ae279aa2140640fc7175ce00c392509b88d2f3f9d9763073d2ee28c2ffd9de09dc6902361fdf09a715d8f5f3faf2faf7803505e31cd3462a7a932607c66c08468ff576a6ea2c470851d49a06533599426669474302e585f1c34f946fc506f1b44295e8cc356fa78cf4326052127576d9924de2a9a62b188bcf937b858bba6150931106a08883e16f455fd5a5aa1c0d7b090c441ed8910e7f95e90afe387b8b423b53fcc3fb6e3b9e937838b02e7a18d1f8b8b95c21b40d2324571eeaaa1e1ffa599b0a267d9b2b222c2da53a9091f75f6cbe8a69022570a8fefe7a1b7081b1b924b7a3b8db1e022162554a69d77ff742b2b79643e24aedfa2f2ebc46fa2f165a5369458e6fc1ffc440cb5ea95a43267fe7e85075eb8e478c1e13f86a99ccfe8b0992be703789cf06f73a1cdd5a58e9ca8742b5aa2b670cebade91abf64d648711097b8f3af9f9f15ad09d9bf3d8ee3df90733f3fc5497f52115de64c9748ddf0e0b69381503769b1deff629af888bc9c9a13f5a2382ea513f9a25c91b9428f8b5a80cb24dd59a1894bd85e74719ed858455bc14a47b1a5db2b0d6f43e61c823676f49df573a1b8919be1a24f33263291ba5e783c485cf7e64c8d349c94ee43370d599eb73c1ea452b2f28c27393e99fed9a26656962e97010e10a74d206af5a9d65d12bfa8e89bc9405b442ed0f3f76cb997ceccddc611b853b847d2aebc0983e36a7fb8860934e6975bc1695c358f951b3a2689886b3de77163935220c60ca345ca3c86a96e7fdf56444dc80a877f390a1e90af432ef98c7d2ca41078fe4879cce5035e6a2431722053d790bcd196b249c994167b9ea59b7d8ce6b10e3c11061a5744b4cd5a77e8e164a0660d808108769e1e3674cab9c27e8cfd2950235e406d925edd7c9a54620871c411104499382d62a0bbbf47963559650bc856ce496e29ca83f369f60605f0849df0a36b888ec67d346b5a14a473d5ae06bd10b68afb9d944728cb4c5ee13d64bf7e9e6d482df2765db992cab16ad023489d2342d161012908cddc29d33ad025eed0e10b659e4830013f4cbdae07346f09d589fa6de7362c6881c5d776f4682ccd555f98ce7e70c832b023f63e3285d52c6cee5d7f842a54e4a1c595f726698dfe7ec77d811fc1b50016a58952b1c1f73a284204529b7a5e7d3aaaf4ff7aa11c1c4319ba70971a4d6156264998ba488c430daf036d2d9a8ef88e4337241bc3ff7e2c7b2154c773f3f08f8f8f7d3b312866105ad66a2c63d5a76129f8ce304755b285220044a1ddbe02ae0c82b8b645ca551fee525d15d085db2af13931f6c516b02d9d7858d2f217a5e9d6bcdec4ac63734d73dfdfb46708b62b7c0ac2a845035fd1dee965040eb0187e552c87dde56daf38e6cc6b95c11dd264cd83da227e54d720cc634fc28ba3f80f45d981a589c3ac667f7836e97b75a6caec8a0abfec0813226dab6a79d8d558092f864314bcd20efc215e88982e03174ec4e8083cc05cc66860ba613c26877e26fd5d8f138ee872c6faccb72c9104302c9defa1430734d355593306cc3cf93c105b6710da51963ed2d320ffc38e3372263f7fee349fb6731ee5922046ab60229ff12029b5a9b0fba992b1c46561b3ae13485b63661ae62094a00a919c82338299b70a0a1172635c0a96d58ec30b79401cd52cb124ea504d11302da283d7affb7887098677c40a888150db15c8811b912c143b677442c809132bc948ff7a18aa3f555ef3999649fd18731bdf209f4ed9aa4be45dde15419fbb84dfaa0ac85d5c3ccfe9b74df10b3391cb135021beec6de9c2a58acd4272e8fb499a5af12d7d6926d3d455d7650c02c49cd83e60205c57148eb31abd0db69c33aee8f452ea7130abf386028577816122a2e11d755387cbc8b5124f00d5513b5087a6286aa2024a8a65375f2149b860deb2e2120e2e9d4b99b0210e10669724c124af2a4e2f48bd0f5bbd65cd3a1f079c214bc1a0fa3934dded3d632e1d64439a52c13f79810409815eca5751d1d5125a2d6126a25a0be602d457f3fd691ed3d6e89cc98fafb1188fbb1841a5ea23f1f328881d8f3527ccc0cd6c9c917535e7772834f18af77881f8c4f2b8ff3e9bc41bee00eb353447875eab46bbf0d4efcb46c9a6ede8e426faf3aba66f2add97e7c9677b7a88173a056507c29dc3ef4bb35dd76ad0f3ed8dd0b7eb51f1a61bb00def77f92f34d15c410ecd29e5ae60318a633e98836d4964059624220656b7aac2f0e5891dc13c36596489d66a8f2122593a5d4740a649a8a8a1dfa4407f6a171a1199fc99eac5ea62ec107cd19bee55689ea14f7a6a799f87dd1ffde28916ceb2b7a2232550541798772e4769d664f1c2d64746f879353b89a1f5679864cfcfb4b4858c1c1a56e09bc94bff275a1c13d45bb3fb4861e18424a5841b704bb92e03a4c8f565a055e5bc779db9e1ea0530d1ec27b840c0a053bfdde37cbbda4385a1fb5c2a9c3f624bc3e837925571ecd2dc80df3ce04498238491ad9d24bc250e2027fed49eeb1a75e8500799ef4c4a70ade91696c90991aee0e2c24fe2820d892864279197f55cd76ae05c7c5dbf8c0ad4f4e7fa8592e4df58d92ac14b8474265918c569309d4fec835d42b00566aec6dddb46ffd5816bf6ffce553ca9d3ad81dd6472197f188b9cbebb840adf5fc4cd423ade0c7ba64c828b22d8cc5936acb0184fb269c6795c0d95733b8ea69311c636d6cf6bfe12290431b6e76cca82fc09e9ee766e898f6d98098f8deaa945282344fa5994e63272b341e0336d519de56fa83aef39e2c55ad29ffa63ad95386a1b667cdf3273dd53025d2133cc65eb98bd16a7cbe6aa1339090845abb1fe43041cab761ca391995878e67f67d42882311139368fb56a060eb0385d2d8a5b14183b0cb7dfe9d9e25b6b200f38987ef93a9aa91dd730f08dd954a968fae5d16c715da06a7efef9acd841a19e55ff82c6239abf9aefd7602627435df51bd0aff01316137f743cf6d81c49a5340acc6ea00133575c08bedbc7714944c52664208d02e6f4f437ef778f61723755cdbd700597ead4d34190483e7329921d9124977fff663d1ba4c4cbb01fa4c8b39f3cb501c59cf7899231548153654a381e7cd68c086488cd7601aae94e322cded460e7b08bd3ed97f81bb842508b14a4d0906ec3bb7450861766a841f9c484b9f240570c5f3521cece3be665f6bed482e8f4b3c6db0303fbf94aef97a9a2a53c7e50527949050854e36acdb5bf591f17d24c5e81ff8bdc6ad1f0ed7a81196999445b73e64983fc95f5f6f729a5cbc9fa95bf8e79f92ca757507453f0b3e8b6a345616b84ebc26e2241de0db3948ff8e6a6f133a5245ac5be6521464c2e2c334c08514fc11eb2cf096351d4665c3f2c679660af517bce45a16c63db362e182a565d175c1d90c6577064f2990afef5937af7d520cff48d284495dbf82658cee4845e54710204a7089a78d25ecccbebf68308e37e3f5a8148e252f04f4def8d56a3c368a4718db4c18e507215650a0b412c5e0961f8e5d301753a47798015257f7af83396448013f30b9a9a8054d3521783abe2b9d9082fe1dd7606aa52c79b2c129cbf5dd4b389087a1ecbc09c6ac6cded56d4f28accd0c8c40bd2e8731ac8b6c773ba38af6465e150fdef7de577790c980ebda82ebeb0969aac11c25da25903b79696390453aeefac567d13f22ae524e0a8e7c58e3b80d0ef838a88c03d7f5cc4b9ec98d88a06e17595cf0077e5ae93b06f439ab4207c7243bd319bd8fc8cb9107e914c13eeea966f6ecc6772b723da631fd8a47e368ee42b41554102f7aa281c1a22e8d972a1f5ce1e84a5cee092dcf864ce1517fbf9d79e3df32cc187987bd1304f9eda8d4f33705a77e49eff644d462e859e93e7f7064d6b7e3b91db1875f5db3ed645d12cee472e5f7017231a1163da7205fb10806e2b589891b695bf36a0990433d4c4a7bb86c22a5cb450dbb274074efc4ed85f86d226f2079357f33029c4d6d90c147003b69b6c31b85ceacd34b310962665107381882bf33db5b405df12ba4afe8a83949b13d6c8e77ddd35831cdfa2f0a10e1f299ce1440f591311523a9c087626360c5ca83acaba10612cc25051e09f96bafa5ae6e3dcba4d1ffcb8a0f12ba8557f7ca3ff50f0bd03e4f805a6700fe8ff61e2bd75a6659b66d10322c0bb10efbd27c30cde7b38faadfb79bf7f6907938c34237553d45550d58d73e914c0862df08ca999e02d3153383e233582353266af1447c48cc13edfa961fede28357884537496b12b22f4518691a42af09950ffcd1e38acc16c4b562fc1585f1532534c54c9c62d61777cb4baa4033325157aeef388d82692d69c291c42350adc606adab54f20c1c87d0acac37a42ccb8c2bf99e5840deb034bda9fc89513bce1a106f18109c575511f4d31b092e5bc4eb462b918241485cc7e876cf996582db10028c290d5c7d5fcec618ef9f3e5c0f2788aee0cbb57a8ffe6bf912d0665c6e351f1bbb47e5ad50963caecbb6f88ce43b53c89d93799f4960d1959bdfa4452b1c7c65231844b3c17dacdc9a2b2c491b93888b50f81681da9ea39f1f230d702f9c67921a519c9388675c7951265563a01e7fcde23f2c3b45c954d26edddaab619ea080e04ae49bcdb1cacc0671456d14df273454245cef3dad4c2fad1d1e6c48a401929c03034011191d9d8a625dd52024892615090277775bb9a89cf6adcfff8fcbe07bd9d29dad82dfac67e89faff76a8256729b0d877cf1c52caea81f8618caaec6bfedf2cbb4039fe877936111bb442c0413d24a709237a80ed5e17681e9e129e6a3aa31575f26d26bf329960f206580415f01f3f4a3f4e1d16b43be7197411c6153c272dc7baa269aca448ad07094ec343da3bac1073820b4d788055a6f984f4d2cf79eae671d6f4a9825cb465fa3bab5a32d768335e3964eeab86906156eff7e2589fc85a957b12849348bb536af27aee2b77ad9f70de9ce1de73ae8bc8269c037758c56b7067b94ea7e06c74d66d9623a310da0ab84e0dd89f11ccae2fc504d0c045aaf49a29cd27291902d7b99aa5aba9783d18da76ed3614c4bfd9540e65ad339f6ee610dfa5fb152bacbe4e36625caf69ac4e911c00380323ddd895bea88dea28dd3093c1f87eb982c8fd1460b103405a2c3ffeaa7f09c3cc8a3ae1920c1196d337468d5b64074f574a03759656abb4b1a220d2fe8769552f9e9a4c5632cf42e79380fca98cb52bfa02ee9ffe28ab294c38fe2f8ce56c22b1f64ede7b3e4263e5ca17cd8f24973576f345d9f8a8df515f823f2a062a29062a38450c65e999c099b2e391159f36978d6b6f900318ec749ee6bc08cbe6829ce2521ecdffed4b73e041801cb70dbd3501da226ce76684481e128c9f98b3d4dceeaecb346cce290f6de932f69b50727a01e91183eca32445c6187566b83a192b2ae1fcf9e1fccb39ebf82a4c0b49d91a1fca89783324d67352667917afda07110a5765e6c26cc9d251e25ced990fac4080174d0e2923ae6b915eb1cfc083627635065887d0569c03605c1ff43c78862716c79c5f2620db3be8a985837c4c35b4f508288dc6ea2a8fe7ad4665e2b1020573bd5509386a190c0494cc827ddad6715b3e8e9d68427fad1465af54bc01738a85bcd0e32929b3f5e147ab2982a44d579af690f5e1b35b80fcb9b79c6d9ea453dcf6dd3dccc7b0a6cc2500db579bacb8bf3149c36d22ebecadaac234cc9fc83238eca50ce55391f766a0dc41a222a8e111970d152d8df52ec478187760ea386925fca4db3c60dca5ae4ab00fbfa6b7c73f27604a2de29cc6bc54926ba4579f970d02e1d42de952baf5e892858f7738c24742665b59911579c9f505d5138556abe7a1df474b3a486d55964a65d52d3bc75fe4532c816b56ed81a6e9a6cc07fc0a91c9dda2b6a312fc140fdbab86a8aab3e249dbad12d63275b59ab9d12f19927d45805113e1f6cb20ff99bd4b87c62442e5e49ca444cde5be99103061c95e6ce25487860d9ed210e6ed4388617b3e8d82e30a22510b94a25811b34220c16d63241a4e59024c8c8759a2c25806edbe5b99a4188f3478949326ada3b65a3df1427c9e5fb4deb19abf25b71dbe304704b32e0b5c25d919419d2e725ab0094a5c1a4e5b4419dcdd3c3c9863492910afb5e23d31da04af90b23e5755eb0549537675197bd258ea3ab0c1bc893055d9a60f3bdfab40de47e29336d9070ce713b6a4c4c8fe84aa40f7830a6c7cc101922227960eae3a4c3d03fd160002832a966d39ff99eefe884d4c532053c2f66180d78546e709d04747efce583974f77b173da86e7ea1d197669187829cf2aabceac6002ee2d8b1073fd0521e4999f1cb2cdb0790d7bad1d446321f4a445f250fda30472e501351e0da4e0bad2d45373927cc55f0b01be0bc076e68940ce588496a2cc56ecc04435b1570925fae95993857e2cd4d30cb5876c5732ee592d1f320a10aa321479abba6646ea7a49fe6288f403281620fca267607d1cf992ab1cc98a7afa4101480056e02f25bd07a2c3754798cfcc38995b0d41cd10896a37906c528cf95dc36ebb5aba7653439b15307d9efba1be482390afc46a11e0d5fe0e6e8a431395c3308428b58c36b41495074fe24d6858967555f414a3a3935c843d3b25a0e57c6ed36a75da3e7ca2426d7d6613e6673d324da5332b79ef1cba02fbfd6ec7076e1d5a8d87c586cb2a1c99b6120deb86e7b433225a28f4cf1902b1bc787c241b7e7827eb8fc8a06e903a33e8d583fbb02b70d45c7e29769d03804add337bc143249a3f89c200078c908080a2f30ced707c2d99a2690e711827ae80511fd5d2504e115bb39d2b808ec765ce32c3341c8cf2c47f0beab6a75e208b516be83ab665df7ed5a09835fc34fa1394df38fb677fa9bba6522c938fe6d004c135346c2e45695942b23bf13d934d4556bfe91985b52def79605818337332dae93ad19df80a4a2f463b7475809a28c9465b98666d94d993817f1b8a5f8195d1846a22e0cb80571af2a88c7ddc64f5bb271d9abf852c92b807b3a8a022bfe600c4dfb19bf24c21cf9f3c8e772b70c8ef31cab55257bf2cca585adc886525a67fa5933ad8db08e07fa4d43ba63f6fc0a75734ce934d404016b5adf2eace687f4b14a0f31c64f5d73f98dffdb7fd6b28ba11edda8a66c6b783b53cca2d2a462eb27f48fe7e3a89e0815310ff725f95a3872ab19465bf639b65fc4b40b68be8966a46c05b286cc72d9826625602e4b79c570079415809ad04d168d3479db1996324d5c9e7363d9ef4381027c28fd0c2ebd872ed7e4219a904bf43c2d9a04fa026fafb52d4dc733136ace00660377b8e805461ab81c2be087904e84550e2fd0dcd032a8ca1bffe46b1c5c0462af795902fb9ba671c0af0d048c20b4de7a304bd66e2e127bdc0886499a58a57c0231606b90f59c800d9bc3cd6006b166e5ada4c7d5b6f032c9c6431e53353a6da845bbe9f8fb42722036a743191a4ad9789eb7e9db35a331024061c7c1456ed51594fe82e34759aeb3d6ea1e9178278a36a1c012a2c21661fb29999a82906a49158d95bf0232fa1b0db1287bc398b51131768638416a3989c8a0130d4840e00132f802e5134ee7e8021b0d2ba015a4b7097c7b8962e123cf3aa4e9609bcda0713f22082add50796c6018d60c4abb9bb5e768879fe5f5dc1fa3f0fd4d7a25c7fdbe61791a82911edd5785d075c7004602eb4ecce44fdb3f514611993db8a150f1b8229c2e49ae354489fb11257bdd2cfa98a13ef1c83790aaebc20bc9523085f2cf7fe57b3fadfc475473e373f56b1d0f2b3198fbbbc954a0e669434caf5dfb82c431f9e63c6840e5876472c56cbe0fdd8474b63d3a144daa8751d9c124c19925c6dfc8382d06ce25620eca7c2d3af75ee3fdbb243d68fa96ddede1fa1b987f3e5fd1ea453783ba1ec033212b466bf97c219eac54056a81de1a8f3f662f72f7f998c66ffcb2afcf1f66e73e31ddbeaead262634a9f7adb99a95f66720ea5b6027027c38c279b37af9fef3b24d370c9b3583922e081e16821ccc76687a78e1c51cdb73c6f56b1340c970d5b17e674bf187299794c0182d3fbfe5305235a1e44d8d1732c0607eca3f3f5b0c1a494d1efb1c26a444324686e59c5627aa7a3008c336cbd83f82ea53966ba9915ff41bb7b814cf3d21ddcdb5f5f0644adb575583d2782c8b6d058921a78eebdf51f81e2463139f95f8de030d26526e897924371402d1b54ec5b84eb8f7efe7b81f9982a0bc1d9c215011361347efd29a40e826f0c71b56dd58012d7c7e1af53de7c877c030c1d642e4b7846155d3f2591becd219f8faa842fb203fa8b744c8caff5f7d6ccc8bb873dbe72887027388424ecf98c38dcd8a46aa57b3724f3096f778fd6e7a2cc70bb31b1960fda661bdb3e8da0941e66bbfdfdebd9ec6d90d958688a7ce31df0b8e9bae3b9c21cbe1643ffefb498aae0c9d616b8c1530ff7c7f8ddff7230ebf8080861c0cde7c159d280920e1664c91ec8afed6df90e1b43af99d8f73b7b08617ca4b29bbb91d3b3f96a3fa26afd8a22e1c056f9b0c33ebb0db574e501dd150d2e1558cd61683011d560c5ec4f68dff7f58acf518eab8477880bca0496759f4fc87b81e761cda1562ee04d57f5c9132ce10db18b4090c45dce16542da2d15cb14ab44a2b359b295398683a32eee026c3a314acbae77c24c5dcbcc1caaa1c02af37e030772230cb4b4ae5ae81e721f53245ee09e4b077f5b250f59c0758a88f1b43e900902a802683d9334099ec41ac146838f6bba7e7169ae594564c01e96114f4415217cc004e1d4052e9cb113781db7c061f934f328b24aaf1b44255f36cfb3bb40e1330039f5287d14b0b1fd5bc5488b0545bf9bfbd093d1f2a96792e06334ab9cc3e19f8c8a52ace14ab3956b3a4a9d6889b520123187cdbb43547102e3578eeb9f4099fb51e82eb2ccf0e6274cc18f11dd057e74681977cb0ca7702cd924d3b3d3f677624d6a75ae1d9f727ba2d8f6a5454c27f3d55728c9a1b949bce4e30ea4f5e8f45b391f18dc20f481b9c75f040b2a78baf7d48f1ca51c6acf2caec6fcf085914dce8d0c5433882bb268c122a237dc79174336a7aa541a4276ef43cfb984f739285cc82dc83e2b3a5ab697a6f74b729351eb5baa21a561e7cd6cdfa413328c98a2bf82dc016518b7166b29fef0234e066b756739087c1ef47922ab88f2b75775c6cfb1f47ed5dc2b73dfa2a2e97366e4ee0fa3b116f71bf739991ea7bb91cfd11836d1d5448b86a652a52e044d1ae477c661b86f250ddefb95106caed5abab68dc055129e792ed203c31c6d80d062f685ea19f68af841f4ec0cd2f0b6d6286c0c2a71c9b9c8d8fa8bb6317ea17a11541325f6b9947709ac2621026714560e194d6e92a67591a0e41382d7d0ae8e86fb373319d0d8661ddae6f1d9349f8329f2b335d4f6dcb6321dd6c369f4103df7f50f68c96202b05949e0c0fc30497e1bfa371a30494c3d6dac3959694152f8e2729cecbf3902ca99d62f99614dc589ab769485b00deac9c4d9d3d2820a40c8165d2e3f9d0b0a7e9518c9ab0f9bff3329d58c852c1eb7a6a0d2e91dd7fd37687c45653bd4ff784a4ba7455054c10fc76d2a2650faab5a6613bf5d7070eab7bd0a171dffe5948e6b56b5cda024c0cbed750fcaec1bbec9a1edcc5f7a026f03b128920ebf9641369b5a66044f65262af2938e9301bba11880b157abdb549b8bb8ed8609980e6cb50c7095ccbefe698eafc781e229479cda112b86007f0629dbe79860509a4e86534b4fcf1d0af4cda2be042a6df89b1b5187728da38f659f9834c34d0b740b35de57f3f9b4930079e46210e0a08c3b8a1d046d3c726d35047628807f9816b5d615b7391db035c5d758bc77555e1e9ccf598f6cebc947b5581df43d271d64d734da414b4c40e5d5b10632e5551c4e88fb11e0937a6c97ba67fb556eb3f1c17606dd895e4886c4ea5a2b9b3a67409d7bd0bceed6be41082147df0ad7f59e8e41f5c1327be221f8b30c101c58a3527588889c21f77a8400bd260b36f3f914c7dae2bf9432b805e6ee6d931346705010aba623cdb418aad36805ba40d05bcd85e59346213a16e500b434310eefa0bab48746ac45a85258841185c4c651c946b50014aa2071a964dc8389f31d22642af64d39a611486555d410c4abe104df0c3799706b291cc3f9444f6f7c0a603b08c0e53d6cf92c420487fa7a6aa3739e4eb6cbed7628ffbbfbc91f9460540e18358b7ecaece8e9198892a696de94661a3da9bef7a7dd947215e12d92043370851206480700667b43832b690f04c229d55142100dd3dcc1b493bb9d6d250dafcf33c60d5e7adf9c7dd634c8c17948c611a42c0166805c6243c93f237f295e715b2ae9a038eeb8aac62b29ab0f684a6f45e1e405be44250f144b601f229afb21b5dc9658d1ea83e7df2cc2f29b8a655c7d3e3115d69fabfe72276195e3c39886fb4a45b600efa3403964cfb674e675a509ad7f460510d614760fee1e2bfff8117148cb7015e3044829dcb1e00c4f8a74fe289298e1b62ea5dac2110fc588c7534c6a3e31af05fd78ff98ea7ff78f203dd2de8e8ffe932abf19c84514c86317d52733dc6a98da1671a859bd40158066a336cd800e18f8ee600ff970324c412a8596638836c84055ad87c6429a788e679da9781e0eafc7c6c88d18623306cc78d41fd674701bce596557eebb495904eb421e1b05b0a983de3b1f7b6541dcf98f21e5fc02075f16f59c07f3d03fb3cd2a9fd4dc5d1626f59aeba64b50d50aeee95eb755bb368dbfee3315fa7233a3acd7e31ead8fef6f8c5bd340a3e8d2f19c73e584f0497748ca4e09cdb9bab15c91338a922fd6502ce2cc0bfed7754975522cd8bd5f96813473654045d82337055e7146c6cc27d2945b5127323959975b1d540d6d218eae4c2a5171effaeef7ff76d062e1059fedb36b2a8584b13d97195b3e439bdb904fe9a05675d370e0256d4626538efc7e7450e3e8d2043a818468566b006c20449a5b45114336b4cfeeef13533a660fe73001d73bdece965f4b84c767d0a4be6163935ecfff5a1d673c8b87fb3a72660591e51cdbc319426de6c5ebfeb8c9ea90c8afe374cc03200cb066409928d3f1b7a58a0a5699ae0d77549e0cc964db7481233f1697ec1473b2c7e017218b5e83e79a33b01d75b96e851c6422ac52dd445a48bef0f6e81a14a01ef868a8e7f6b21e66a07d936f9e2c97b7df2fd6d024aecf639b86e6cb58452752fb8a7d2b8d61b8ce1a5acbe1c5048b4d1c4a2886b2e94c1d9dae167d6ea7a91ce6eafa8deb5aba6d064011f461336adf54de186063a7c898aed7a288c927604462ae95b06d7e0c3b44ae8ec39d6732a11c11c75100a9ab78894d0b55b834c1af0ea39404020925e9559886e155eeec189c8514e569f5f104c4c21d2bfab747e33c044aca8987000454ad2b04c1801cc2813e3048c1731b76952cd7290e980d8da37ec9e45a657379e4b8bdff19be57d57c737cf8aae0c5fec0660ac573ae85919e822ff6dd06854f10bf507ff6556a205c03cf6652d008955de88966550f02207c6530827e9d7a66be29004896e709cf7da970853ea3e2adda0d2809a4525aaad3c6dc0e1cca2665118d54368fcafef7546f08af303b7bac2b78d0820831e7644ee721c61e163f0f37bdd9c137c5a9a234049fded7172953c08fbef848b41c13e5d85dcea43298a035530ec5aeda431e209e0682231a8588e11b64ef7799d04921b3ecfd3553a27c6631a154e8dcffe0852f4e1b4347f480b51a21a72e1e0cda2c806e37531eccc4f1c5f7b40344505d920bb2b5f1fd9cb80ae9637fffe1cd10d1bd109f77e6e826491c01b72187ee11499117ebbce3e938ded03caf8400661747a30a4883a4b2a789aa2b869d668b2ee09cb22352cc34d4dc570b7224c0e46d4775c9c23caf425ac923f371f301119354ee3c6266197300cc48135e09b712d9665195cf830eb2338be3aeb0f0b7d6e41ca103c3aa9a31e1b021e19029e6b8c5a4eb825efb925579d723490200403760a49a60fac88a0989f085af82076fd81211114ebe30b0f706a03f8364f0c1da6e0bc054e22dcadcbf0aa9d0a6a96546fbca5b109d48dac6f667e81c9146057f3179561e7088da770a759a4a6cf298b363b9c23328b154ea2bbdce43541c349bd42c79345af10ed6ae6d2130c0ca3ac812326331332b576273fe31c1c39338690b36b4a30ec265a9ad4f0daa19702756fef4666560e47a76418de10abb9254453869e5dc68190885f7a2e1455467502c51b1881dfe6cdc2d8aaf30a3842fde77147d210fb1d624f93924fbab7360a389894d7f924b7e53d0586a4b465f163c496d19a56ce789323403676abb2aee3919e9f235f5a94db8a139db94b066f15bdb26255d3254ce4fff5c3faf56e046b29529d591d9cda93dfb2f9c91ae7cc5d5652b8618da18ee1a53318aa92e4dd36a90fa2cd2d53f81c4cac493ee0930940adb4058cba0c3d2b6d206cbc148b4d735512899370bb7cd1459ec3f75e3c94f8a77f2f534a8f8558d69b7a7ac35becd07d6bbccac4d3febd3e51507400ca4f82ff409b5b4f948fb38fdec7e5a89701cc8166347e7de5d5b6e12b34ea5d3327c17b2443f44b35b3cd9a1c920bd0606037238cdd45179e024df27ab0e4f3fbc3f64a19ec14121515b634e434ec06550e899564b6ae5e7a66e64c40a63aef437abfb6aa6cf759a275e9203f44515db3dd4ec680d023922716a4e5b8fb81869b250828a2da07c85b053e17208512b8db066d5d7905196e6b390431cdbb6ffdb461f0e4253776a42a060a63df2ba0808f9a622fcc421139b30228c610d60a025bbe0acb3915c74ec4cbd77958b04a3380c719ca2c996030b8fcadfba4a1429a55c49c33b2654f61f2afe476767ab395bccad12f5cda3618637d79310cb1744cdf7c347c35e94fed3709ce2c09158fcf663e638e2c531f9b6f050f99b24bd89527d13a95bba1e40d27e89a0c763bf811d8162afee12b387833c75e5dce118c26553ba4b896d875288377b0a60505962da07eb24d1e602ba088f8093b8afa5edc378e9664b48a0ee305038d660a95234d528d003e050f274f2d6b0392abf456c35b2f08d1b837a7a4a7b79cf0b7ef3340d929a209158cb7ab405454a0826612a69de09b5fdb795491521466a40db5262662ac0c436dccdd6f4bcc4998beedeacfaef1786cc605119e30396f2fba75b915e547caf80d27a09d2c3bd768169fc11b20ff9d55fbb0112493450b60dd1b50818a27b8a14f0a7b4c4e2c8d0a4efdd57c80caaf16f80d3dc4a131010f4ec7b466413eb230aa1b16b9f36c19ba70503290de10f86d4138fcbff75081fdec022309616763b6068245af2c6ec14fce5707f5119e0db1aea3fa269e7e50905423ce5396e252cd9e187071c3d6fbd0311c31a2db492e99e352f6e6a2256677965d53d3bce537d2ecde9431ad82f1f8e88518f37e68487eef445d6b4c0158d6cb61e83b0f57c083a26a87834a09b8c25b4e3bc346435283ca9537e5118509854cb822021627cf9b4101f110411f603579b8ef35378231df578279824b6e09ba23e8a6360ffce628fc3a719f2fa197f3052bdbb6109eb04af6f6faad757999981e9c2c7729fb71fb10d8816aff19ea6228eae8a94ccd6d0c26dfbb503bbb5007169e8abd5a9beaf07e5da6ce2eff0368ab8278f18aa7494e3f6770fd8301ea2885f16665b2b442c065a80021ed9fa49afa81a49eb3c3c8dabeaa7da44c72a043c0e8ce293e2e5c4b4aff232404bd256f8e66d57317f97e762d36bbea3d8d90b1189dcd1c75827a29e36b0eef67f466f5252a68957fe485e195a06e3ddd08db53d0855ee0b9c42fe599663abc42a2b4fa09128344b338bc116cba9cea3b1b56692c3cac9215e4c0fd20bbe12ef15cf907aa481090bb73f88fef369b2d1cff8b2804cb1489b77ccaac1b45537524abcac7c3989be36d7bc72c24c5d0063b6f49fa551951a0781b88918802962a0ab1898dee6d33c0763fc2e28a8bf6a5c3ec84d676e3720864d2daae7cc593a07ce377e5f672807f3ff38e1ace6912d61a2a6471b9f79ac6caf3791a39b28a4b0ed79100928de7b245e955955698d6ee087b1776f5dec7521fb636347df54dd6e6c61c230c41c32f2fba5f515172de10cfc30acdf141323ed5fa7bbcbd921fb3cdfc59def9b49ec6e9358587e01b2262e03a43dc9faac0288d1fdcfe0c6a01259e5372f38b8651e912e872d61923915dc0690d35b0ee8072d609f3eb375c6d6809ddd7b43f446bc71ef5306da09ef16599a0e1b62c05ee9cac4fb7335773c3ad4367c134870f3441bd474193efe671832565cbfbe7120efa4065ef35b5a03453f5e30d606f93c77101c81c4c5f953c263935bbbb99fad771724b5b2e14de937423168a5bef2ae0b2b7d746bbfb9f64853ee4f073e973fba0f5b5d7414ee3bdf39dce08a7953333abe5aede3ffc9506631f54fd38dcd627cadf59f5da23c7513bc1cc0de641125036e151e109af128a861aa2323f6056dc2506c427721ee95aeb46faa9690ec9f08f61192a399db890fa5ce61e9529987e51fa9511d464e0388cf7d8037151a6d196c8469ad83235a1bb4f117a460374a5844a116b837ee55af873c43849db06c78cfe32e3cd324e9224666e24a68650edb619a56d255904f41112f5f1d473fe8e1911a39a98c178c56667a69f322b5273e29e66c6601cd1218e097441fa49b9effceb811583531da0d58f668498e10aa6922f798a9a6d85f11ab42c72da2320bc299e617102af693b8cd05a135ef0b82210a0819f6faa958737c0b2819179bafa9c8d474108b3d6e326fbb17ea22607bb1ab3a6719803b3bc507b3297de8087bcda266819977092006bf1020d6faf2a7dede8afcfb29aa378e7933ef0d3f7a1587467622b099ca41e6ba300a06b986f0b4463997ffcdcbe80da52fe2a60a8328637c384a1ac8dc3db2073db4ada88d23f644b71a492641ae77edda1bf4a91cbd894d931869298d2d642bd2e82b3f11bd079622b801772a004baf6d2bdf83850dc3a533ae6b64edbc7dd5c6e0e780e57c27bdb1e4f94c5edf35497d3dbc10e3784c2188253d5218272e4f9e557354dd86049e85925d5f3e8927e66efdf5ddbf0956afca403e85b17e6bdc75eee2638dfe0a0480b38b89b99646e44038348dfebd8719b99fff30594e736d2119a08d9bb2df773240c75240cb92df7c6a794be0da6bd101ac5be1d88b7b9266d629d1d661040344d4b8345e159cb7e4ab3642d0473c4255da090384cc6a43622dccc78e5a30fd3e26ffbb5664a319f83bc34453e6988e7897bdb133d7b95bb13cc874739f418e307d8fd45d70a635f580c2366e3c3a5fbb431b2235377842d52f23958c167cee03b12cfc7548190065e3ec5c3445f2b57075bf220930439f7343fc6c468c32268173c648068080d948107f8a107b3f6b4156d7a34f18ecc3c754c703d6d0587325ff42b91117aa7e85eb25358c438fdb25330f1f216fb5310b232b2597a1b5163394655e694c3136e274df60cd9fef466316c0fb5bfec0f0bea62b56eb50e72b05afbc67275b44c841230f06c95019247d4a4e1715916bd6e8f364ac22837614fc7f16919584d0b1238389d156dac4502c7e26b839945f2126184841b32b7f556936344184425401afcebd9a9cf502c14b63fff2e4c389add05a60b85b577224d04a1c40ed2ad5ae29840bba32cec01158aaf0d680946fc6edbf9c06422b42fb46e9fa77d990a69b6d09e4784ac001efc08a20dd7162ca2a03f7b6860067459266f7f2ed05320d63f98874aaff6d9dd584c9083d92e90f2c1628c293c74f222f4518ca11221307b34690e2532170353da6df7e685b7d1aba81a506b7570ee8c8d6e73d66a02040bb8af028ee46c2ae054570385c979e845cd0643f0ba02dfbe1f01805b9c431d59dae802d9164a3afb384d008c566f0e4c3383856960883e5cf34b032c1ded5624facf0b184ab7e2d8acab956b4468b9ec37f8fd91245637facffac5521416ce3a6c958952469e6a98a8a0b365d5c4e6f2fbe81a3c2e68b744c8dba1e8733a823e3146784412420d89589c11dcc436e2708af0192be7921d2835a940d23a0493fe60260408665e5b857d4ed070ce28452a499908c7495a0f08561f111e47b67ebf1da7a981d283f19d71d54c970e587202dd36e84cc0599248fd2860b391bad64c1089649bcaded36cdae6b75efd1ec58be8e35a9c4f22be04790be5a4c46ba131b61ba7295037953eb70c43e7d0c217d6837ecd0821e583d4a7a6944ada80641af464741862c330c87f6d32eb4031b9b30a30e3bb358d19f4d942dace4e40246ee3d6d7f3466a4f458cadb9811508a6b58b4adcbfcaf7c9adcb6ad4df6e94265d049eeef74662eb9a092cf07500a10b50cc8fbce91b5c467e1bf17f998a3bf3262a05386399ab646960808d2ace96f683f0da45589cf6f2dcde72ba2279a49a2d0484bd2d49f8f0ef76729fcb1359210c2ab0cc9afbf4db2aceef00f1d60512a276166f340040ec617086640a4d8164f053cedb0c265312afba67efd78b7939be9c97f9ccdf273c915cd85212cc31c3b211a56134e561b41b40e1045546f3972c704d3195fd7526e194daf7c5168e77093c34c06213f0b6003521234eb221a75f31be4d2b8f93fbe7851904e358550a47b1c90e1a7494322c7a948da73a955b80c7ab74f5631a4a54eab672cab0ad4992e5604454b58959fd3ba8fd6a6139b2b5d6ece1c6cbe5a77e514a955d3a791505b539cca12ebbf959f6dc6629ffddf9ba32cce6725a6e6c2c0865be545c0f79aa40e39710b74f5fd0bea5923f44f24480f02108da6087d80230e393973ff5228933989c0847d8473ede3d504811a654657788522723b5f19fe207d807196ca9ede7c34328b46ce8146a4678ff9ca8386255f594da705512d6f71f9d2a1a774eed59f401fb1f5fcbe04082909637e3c5d4d2fd3544c93ef25cf7b9d64f76dd137ba40b868757c83d3aefaca1665dc93e7e3337183c605a45d34cc3a7a3577ea867dc7256a512bcb7c5d2524df1391f0c8fbd8910dc4fa2efecf3acaee72c8d7bc66d8d27dfc6ffb2288ad7a05677a63ea47aabd6ebaea4d27b5e19cb2caeb1ab42d1e3ee27692560b33edc54e30c8c60acbd050160c6aad11c49947406efe8031450b3e478f74cdc92db4e0a936ff30d53006de63f19cc7868141c5e683dde72f01d9d66881528ea342ba6e6a32d04c4213b23158040fac454dbbea0f7e618eb2341237b92dd7baf6a8993689034c1654f040aaaeb3cb0aa4942feb06d9aab561efb0da76e21b9d45fc964962e4658ca532b58d1b9457a5362dee353babc093a1317f55aea62b0762902083c9791be4222021ffce5ec84f0aabf58252b55407d1ef46344a65bf7ff972d7a8b64e8048088cac79e5fa9663bac53420703c61d7f1c342cef666584270f963d0c3d2f1828de1377c8746536afdd0936234090d6a062ceec5db420649a50d8a3fd41827516371b128e5ce9687d2ce4519af851bb7391113a9a78e98595376aad0ec648db0d4da50e7b3a221e05d82053aa6c0c60b7f317652095ddc7367ab756fe651c51fe28703bd8a03cf708ba410806387085f3035618c0d6079cb4100bcd2501052bdcf7423d3ff3796d93828975f9fc7da98ad104485e32802bc0f582cb8c0f7905543d4150cf093de940453f5f0bec8cc361cbec62d3fad93e796408acf71d61c74b482f1d8c6127423d2b14fbcc19a7345a81f4ca7b2965576b0f67e87511b494c68946a593e2797ee90bf815322903458f5fd948396abf222990cccaa583d8c9b402127b590c20ceed4681af6c42270a2450827f1daf43d217f6a32f5269d3f03bec7321a6b7e3ece46541d7b7ba264002a5af1b212aeb49e81104b1c003cf4b9f357261d4207835bc86e6e7775d8ca57c2dcfd8c460d00c181ce485e2cad0ff40f05b205203d4616349104129f9b5d297d02a8652e45f4ed351d51eba9fe84c9f8ad4d82100418fdc0992b88e6317a0b6eb0d7a366e5a7b5b45367cacc2b8eb5006e1a09542550cb3ade76009ef9853c73d9746f938536ad38e618317346a31b03d307eacb84268aa3d2756b33f41623f6b32081b57e6fb34df344425754e394a6f8a5e567bdbb7b22f67f0298e4a559051403f99f8d65dcae58a65d626696a732555d5c2b87534c3510d317b3614c47381bd53d8421e5ae4368c7654d7917ec6465503e564176bb6fc370ed3bc53f46388ac22320af66dfafe8a3c3a2fac0d5c27247110c68e5618de004f784770961d1766da49a56df38dd0f45e241bebdab5ac2ed35d3edbbbbd4b13c1910c749c84de5ab58d2341a1c6ec8033157a6184d9a5e4ada1d1f6d5a7485783fe1e77181e3d8f1def8fa554d49d97bb46c78449698dede4a1010c6cf634fee176e137d914d8c1c68fa102ccf1657a969dc01c87ba020e94827732ae9dc10ab81e07529e31505cb4fd2d06f7c21c114aeccf385d80884db8c143cf27fb5930606fb1bdfc6436f682cfa483c18f2f22e289b1c2295a2cac6501970d4781a4eb2efe948e1ec1b13f8dc82279c082ab85ae6e6c60a03ebc53d4f98642f8164665ee4ec7a49646fbc8aa9055aec59722db86288fa5f81193ecad37c8b6c429f57ee50639f953d78d335bd24f3cb2a630ef27201d1fdec6d28dd3a7cc4d1a275c6e7c833dcbf52fd71bddc00278cae3e109007e424225989dd785d37b21952772f8e3fd579b27204acc7fdac05ed524f7fe400200e88b776a77f75a979536daa815c9444680605d5ab2d4aba01af1840454c4767bb07d01a2507fbac50765ad03492770c6393b68384ae39c9092ee446bb0b7a762ba46b7d19c92a268fdd99bc23726953c171d7880e7e0811bc80c8e19ed0036e5f2b8ecf5d0adc5bc1f9c2ecf320fb7cc3dc80f5ade269e6bbcf6d40a85524892388cf7860eeb9b567d50d9a15ffbe8eeaf078cfa432b7bf35f9c2fa4596250a81269e0d74ea264f5dc8ed981e6f0a53db23f3af145cf606eff88da8502e8f746fc35ab7c73860d96064963fff5eaaa6a544221bcfa5a2702c4e46ffb59494781bbeaf17490eb1775f3100fe7b3ea992e88fff6c9a8a655f93504d4546b2ee7f4e16f20b40f1fa1930ad253884ee73d50fe886aca6918202991da049f65a7b81cf9a119cde651b44cda8a649038e1f4174724584c5a98ba690345f732ed23fce0ee70b5ad1516b35cdacc715dae74018a222c8ec8c4f7d27dc708ce9fcb9cc1655b8791ab604a9a126f12d815108140482af53251bcfc229b81e0c1e7fb65f735aa45ce4f0856463e0ac0324c969c293f603941c13dc7782ee30e1b1532c1282cd23d044cc3021f51162a9c04408146434f6e4f0c60f4a3c1eb49416b8ff539026f8ba13ca8930690b4c7872d57477cce3b1f316c64d960ca5b61417594a45714bdb66cda758438c11ae97a8265859fee0ac8949be401ccf636d95466ce88401939c3a43824f9290a4ae2167e0409727a8081585c73d09c4ca0f7592a35bafe5321e912d37e7ace219b36bee36bfc239a4edcaf0e1330375db6e4349f9af9deccce6d7242e60236a96d311a6d320792933a3900d2e258f922a7051cc378aedbec43fdaa3def481892a0742177a75d5368bda610c0f60a63dc68296110e6901fcbf2810b0ce423ca6809f02695b271558488b9bd1408c0274084100d961e3fb25aeccb6808b0358954f6e11cbf7b05d46370e25a81028d617456c229279b5ce3aae45a0547f9632b26ed89623961a805cfa9314911532e6032e77d62ae766a685aa0f107043baceb5030a918f107d89c07e8ed27489570ecabb7eaa02e06c45378a4b9b1c04c5e83fd3c61879e2d89772048949cf787d505d60fea030f0eb04b0327a878588c62627f83ae01da0451f3473857242929090df0efd7244edc461edde760f4a9acca257a9a61d1b3d504ef3b11752736d1ba31072d6ba2f377c051fa5486b18088e2287d002c692a4fd0b4ef22f386b74fcae61fcb40d654e7d2c923d12caea9d173be30b5ca83dfbfbe337c49353ed024ae4f404c9088fd72876a66965364bb9edce2615ee05986356b9b29ab05a98c9390e5e49c933dafd509d0bc1aac9aed5dcb91100ee8141c8a06b9cc2ad3f3ce1086058f66f2d0becdea493d25dd685fa85b48b657a6400be0cea9f3dc46b107cc245a474edc4de3cfa7bff4277265770c411fa3ade1da283a2dd5e78de06fb3d7ed57615f5100869c6bc0f59beceacda079a14b101817fa1ae3540c3fbf879ac68cbde122825ff776c7ea1c99386b96b3ce61ad95b7c3e99f93007ef1989f362693da301acb32b7f5e3d7c1033969c724e58e033ecabea8bbc839ffe3b51f2a798f2351d07d82b0536fb17d400057d47775c1702bee6763eba0ce384c2181a66922ef013d02862ed1d66ce0331b42ab65e8d2da823a840712eead5b388cf02b61604a0cc4a3f593fc0d97836aa40b8a40750083ae5e6fe038f5476dfa5ae22f00556ebe30c9b74f89d100529e92e8cee9d8e823c4480f49988139908081d282e8f811102c2d144ea910302cbc395cb9bd7dd33d1e8454c515baae65857fa29b1ee5a484e20823451344799c43a813f4d66f32e3c183e353c0f826cbf978e9e86611ea1e935d64df7821ed90666cb2d18046d218d393c5e5299b044499591d8c3932b0fad69f4b12d24d89a527547dfe973b54e209c1b9c67b4ed523ae51c30de9d4bdd115de239c1e2ecd9a7c2ece2a8ceb3ef76200312281a5898c12bf8ca8e20a4cd71407d8d97673b633d3aecb9049f94feb75103cc4891aad90c23aeaed43e8a06bdc6a3e5b9349971ee55e38bb9056ceec697a5011154c1cae012684e34009f3abee660bedfbf6a6ac3eda7875b3c7b32253e39942893393ff81ee04358033b86eb987bf26b5e649d1ca2a3ff81d984c9707673f2bf3ef43987bac813f8c9d1e96a0611837453ed5633a2eed330667d6aedc0e1a3c7f9f219c93c966163c933a0d6343269de8c593033d44fc3cf22a35aac7c4276f063752b41e267e5130ec3dad5d37f60638202243b22ec9db8815125a3638ec2b1cec33d204eada6a60b20ec2476be2e4b55ff6813b8fb9c2880cc9413d756bf89f5f00eecf2827861040e58469c4116486106eb723260548a61f330a0f1c851190b30c97741a8b88e6a489a7611b37b1ed936d8f7c5444f5b3ec1972307534f2034f01d7c44bb5ab7313d9f1337bd60f5cf63aab88afdfa381ebe086aeb9af94a1ca9bbccb2e1720bf72877d878384c1363604bc9269584102ba0bf35ec884236b52b6ff421eb5d7da4f505b11e780ee273b544220ae6aeeabef9204440803980618f84ca6e105827da4898d830dde0e25f6a30b8a711da8bebff27e0fc2fed1c5f38de84916c71065b68683e877c9fd87a1f7d54f17c3fd448adf76b8447338531486e31849f7f04b496acf702c46d3b7295906e4541db9566c86d2a76751b63bc0bada96997c1540f2d4e696afe9445484f6c6873d0fe55411d8844fd31ec93f45661965796abe7e9bf6b14d3364384588e9b73c7a38d0ca5bb4e903f1781e959da09d9be6c10a9f36429892bee51b5aaa75f7fc96b53411c042e3a2a4121658a18834beafe5a34e60eab9087b299259bfac00e55fc4fa2dd1330c5333c22038a14380441960eac8d493f991b572094c73ee4e37b7472a79b2a26568073005af140fdb33d364f1b9723814c2e5662d3019f6ff31f6de4ad30249b7ee0561145a9868ada11bf0d0b2d1faea4f7cefccfc71cced764741a9cc5a10f92c88f3b53991eb08823bc27257cef019a13b05b5124804148a1987bde1d45b519d3b261231c78bee1328599d573e10c80f4e34a9bdf491f9fb2a63754d34e8e03afeae763a9106c34ee817ee9215302f41535c22185cbc4a7de4aec1a3b70bbf82aa43d98b97b912ee288b2c193d3896daaa608338dee07792a55d9d3fb206248b851f0231cdad0d38f3858589e9cd9e0e69029c4eccf901b79e23d15b70e6e4b1ebac3e15e580f6b60926c83e2bec76904a12e36ab8ec9f31f44035f737852b546ba12d01523be99aa973822272d4029f4e22de1ca7d9548d9a5ffba4193afb28fcdb97dc2fc648a76e76a9a25686083a705eb3e770880ec0461bac0ef8b6c54fe4eb3c174c0752a56ca3f2a1ce57c1d9863b605159498f9d6691fa105b7d996c046af0311ca1e66311fb0e3be7bd5d5c080f6e0726cba42c4040043844e113f20a474a1922a6a7bd0c181c280f8c14f6ae5b00182b86eb9648c06be8e4bb1f10c03c547c6f0058a81ffba9977ccd1dccd8eae744aee3448883ca3d87ff4f6d2a1dc18813f0ce4c87f2e2a2ebe2d3c2e5f44ca88f0105002c9bc452e277c2d2fb85ea4777dc2acfb29c533275c5563de2509cf71998a9c7679fd04d519de096fa4c6cc034b2ca364233f88d144a5aedcf2d102aec8aae08392fc343b37a4ec9da70327fd7fd5712997227ed191d8d813429eac5938d10c69af06cb641d6c076e75daadaca01f95cdb0e87f0effcbc18d17b742ea43ca31444cfee2fc5d0681cbad8dc7d5e4ee49b9762107ce28d9aed386e845900b35c5db7500dd3dc659f019387acce5547c7a779fa422ba0c72ad0523baba178173feb87262307442716e05862d800050cbcf181b3fea015a3e59a91c2455ff490e7d95ce0c21431977a51a77fdaced45ad360b75c85094f68b804b2f313f66c80d6b78455d4778b7bce682f92a0184450650ff8c3eec26bef575da0c61368f80182c00a052a73b12e5b7d338b246a53c6e5b873c3c6ebef8b5505435035986e183b325469818dedf8162ac3ce78a6d62700faa7dd394f984e028cf56542f278c858cca2dcb73eecf243ec5a459d3648f7eff28baec502db4c7336c08749f80e0e5361d5d7a2d866166ad0f3e965cde2787a5ac99b0dceacd043320c4c944b221087341ae6d0d0a6ceacf58000e8d82a825f4fba3af828670e7dd5cae1dced0d782b359eb77c54572ac09f1b4f286d0cd0ed7b6da23bf3fa93777ada137a302e7779664bddb1fcb2d478ee28f54505601dfc3d5a8f1ccf16df0567766208086e1e311dc6aa7ce5f8ab7bd50092ed2839b08e30638d95a0385aa4e433b8115aa01fa700a347c785315269553efb0a31c16894c751582996f04c6e1b59dd9395058c6a3fbfc5a1045df185c3ac03f6831c0b4ed32da38d08a03e769a14b57029c1fcd97aec731b739bcd5e600e156358bd464ac3fb293234a19340bccf8f092cb2a49cc3010399cfef6ffb3a8afe1b6c2c93fac753d9cb240b9bd808fa2bd9bfce49bbfff9dafef9dd0a7e68a882c7f785a10b4436519db9244b17e089e1fb1f928a797488c57906b341b2044c03d8223ed07fbd72d33e518359a3891c4a07eaa00ecc657f3c2fd783aabb68c9d5fed21b9749ab6ae8500c0401867e1213f9ad6902ff6be3ad0b7984a1a6b69cd0b23b1f1d1ae3db7552b7924e829ce7588ade918af0e3eabff5e66129900738a99b166b732bd0a4c08feab1f62f55a6f950be506815982adf5454d2c8988d17f5ad6e45f5ec34db8eb34a204685c687a4e265a3bed159983dfaf9976de71901b83e5ff0af0a10f40b3e89ea587becc3028215bfc8e5095c7d47e3872110d37e5e672da9443b963647bdef7161620e6682ff5ae66bbe43e08fd4afd9bbe802420393bbeec8f3ee86410a40fcb2b1c0c3417b29644b9001acd8c945fc52dd4dd40f3b4079a013e2b6143303c9f537b6d91ff49375f6c22bc105f647432f7c835c3f34b87efc3dbddc4a85b80a2b5a113a4a55502c4c134443886bd2214bfb86440e8cee17e78f21697e203fe537702456ff3d9d65771ca1a3670520889855b696c47627cadc142556aa4d51ddb8b86363c1861fb61646b746be64068be2eadb9e44e36926848fb62e3dc9219ab3f046d4a2de9e84e3e5ef2adecd0e52b8c66ccb5aafacbee34b9b9a2dcaf39994f807c582b7101053d57beac9f3ec492fabb4a6549b4660e82c9f0bdabc6461f5f12d969447acf9091285accd4feda53552208342038bd27b94ffcc239300ffde2da08ac31a29c09d137888d921c5c1b44b074cb3e2e87efb964e3f0eaff0ac5cd38aab6b6a2fb80a0b61b8819d2652e10acb60f7efb421076339b5efdc1006d0f78e7fd86b8d1d372fdc4e5ff6297fb546af07f207b053cdd102d823fb235e05b629e285d9a79a286ca83cb0923e5334bfc94118cec34eef7e6acf41b9251c718d72942b0805b08d2f2fde16db77df8caab2383311009bee8f86e5e3451f47487f517e8423eb737f6cac8a69786e2b58853120c4d914a625f5225d2fe1027c1253c7fdf10194dddd5eca35497e513d6d3482c0f1810e188f7ebd2f6e52ea128a350849b1771de3b23912d94b734c94e208a715480ad1277cb96d7a25b15d828bae0f08d8fe90327eda376a82f0fc56edf0beb1bb6bad5b2c0cefaa65c748cc2d7eda8b92c7ec0a812f47fc25b0e405cf74c85b63ed71c2543536967fa9c6b9bf7a1c824c4ec4cfeffb7bf0d2e758d1e62e7b374aec7e63cba91451044cea13e7c286b0e2fe7976c7c2e144df2f44ff1ab96b5b9f1f0ae7ecba174067eeedff617cdc1764df6749961e26990fca5e6e4d4b75aadd4273914c798ec300819f6de2ea64802f33f86a1c7ebcfdc0d2bd3ce62338e2bffcf2adc83d9dab856213f57f3ee2ec2327e768262ff9fa1c2fbeb1efb22e7f7f7fb8b067f664a8b75851d334fbf663d80c446ffdd831953f290bff3fbef86f1022f290248a617daecae98328c5970dbb15876cdb49a9894debf7f7fff728b7cceabb7eb68a33d882153dcf13d8b8d135b1ef3f6a3f073b6ccccacc981cabb465430f7d3de05580e2cfae38e33bee4b32b4c5c55df5ffdd5f4d7fa8a10d0fb6d8381cb3978da0eaedea48d1b6cf4f85e5435693ad093ed1a74648a23098289eb595276bf696ffe7872eb8d28d2919cbb3b120a40c7d99ecf461d5ebcbba977ea386eff4d9ac8282b961c45d6c5a24743214786212cdb7b2f950d0743e0a39568a66dd2cde752a4bd05b45b920ffc7f47cf7c8f9eb6f1eb32cebf2b5b7646100f3b5cb995ae3b810db97fe92f65ac4631825a497b7c3bfa7bf585585d84e73b5f578dc5e525c41926b4e1c0c1b83e0caa152441f5ae8bf2cd000ad50d39bdc627b683623faf56537b472907d0997ddf8206ec8a2c6f0ce6426c4eaf4ade84a5e50ba69d8cb73cfb94571edab842aaa7cdef77524f4ece22e51b05b87d03c41632687f69017d393b34e38933e877ff4f11c05944a31d09e2ec49b0cd07f992f6499c1df593a66bdddf3afd1cb2131d66ec3ac9687473d0406d3e5d8864f2c31ca71b695f8087a7b64dd71dd8d7de10930e879af3572d89dcedc54cc9c29f7885216a5a41edd952bfd94a55aebf829af677068988de7844630a5bf332ee909f8ac504fbcfcfd9a0a9c223e40160618e53cbe8a4109e9569c625f19c413efcee34d352d5669040730974b8a0b63bf0f2cfb2e9bb353020106808446d672e0f428ac8f48e85dd3a0cea35d25e962bdc6843a38c5d89c8dd538f858b21806a92a48ec25cb2fb57e1f02b61bc4d41aebad7535341cd290492699150d1658ce8eac94df3e61d1b3088dba98c13df236d9a5957eee2be654f6d27ffdca1840d43ae85b21e3d0ad175597e44f212d72af48266524f059c76e3e8e6f2dbbac6bb49ade3a472b87f04fd249be510e4bd26fbc909404e73ced29af70b13fae20b04a2cc8bc2c6ef6b7ee8c47b343760ce647d560b14e9c14b51d895161750ea2e1bf9c9ac4b3e6c5e95dadd5bd11baaeea9fd5b8ae936795be9995eab35393e0e9a85286411e4e35ce1f3aeac7afc16c60ad94b9a99e547aaed37f4902cc2b4941857443b846215b55885e40ab85abb29f5214fd642a7435bedd8e95595d10bd6c4cd05810969bdcbe9daeda2849423f827fa29887bb021ba9d3514670526f22587d06adf92c2b2771f8f0d8c903d2c8d2e36931c3fb6a32c463a2cdb263b2175b37f2d5a92c2b9d2b37535e3d146a2b13b6f4dc53e2f90723cf52a24754821a73b13a1767063004c4eb92ab58622fe7946594ced95870aa927821ccb636942aec63df7706414597cb8b7d181efafe6d34361726f97ef9f76573612e74c2ce991625d9238407917571d7b59a537fb85deb7b564da356491ee21d83414f0bc7c99b0f8f7fc67a1a1c3226cfec4111038e9f3ddb4a7a42c9f0d8afda203ae8dcdb98718dff9c1d45e49da043d677da3edc552b36acdc41ca32a25cc0782383e3f7a53e44cbb4e2cf37b5f843dd9070b1243769cd326b9ec3c8ce6954bf03fd166cd5b5f76ffbeac94506222bbbbc6677d9e9082384716545da3787cadb4444ec522ace66ad1d4b7a3410cc435662fa12d43480c5846dd29ddb4fc8fe2d6f0ce8ed5e1b784a464427794d49860867432e90ec57bccd527b8e1ccf6d2cdbc6f437eb05b3ae14824287ac282f113c5d7d4723452405300c8ace73c27decfec5300c806d210f63d8b32fb1234fa20ed2fb2bde712cb002161c1360ecddfe0616e751f152f5b007eebd4d6daddb29201753f5dd666256bdcd251b66c559027e7e7f2a12c155f3732c7dfcd56ac71dbba1774c07311d5a804d807ee09bb92b5317385bda55d34d724c655d1633e8aa08c39a45f05572873086863f148d85ca9fc38b9e50508a26bc2dcbaa0a5acbbc2b6ebcb11de703f61a50ed57be149572a9a0dc8aa5ce153fac837d5463eca939a8226d928d7bc0ecc8a001541ed936164b9665ab7e1789b22119f529bbae6101bd7d2d04b8d1f15ccfb1dfbb857390f791698302345d3b15b063cc430808fa7eaa61b36938611a9657592ee436b45db33c9070568f09f4bad878a071f912f21282980c55507084032bb558b6c30206d6ef7e65dff57362d6fb3acc25c653e2da265faa7dbcff7e60d49a398589458fed9eb3c7a0c3fa0f03a1ac1bac3384897b81adb8572430951284c8a3c27e8bc6ee00b9f0aa4fee2594a8982796223bdd9b0e9ce28c2a5414ca7f68435075bf5a1a17365449e7679fe5f91a2eae7c70cd30176acfb9b4135185a9bc7916e7c312fda2038e5bffda63fbfb89c0d7d22d0305a1705917b4ae56a954af78b694e1c9a336b415b40cdca5fd101a12e60d72782c2fead1adf14fa8612cd22484c30bacb332d549530ee83801cbfee6c8238d2c8a6bdcf2d5b69b705e95ba5e562cafb7a7498bc8d266acdecbddd95c014d6f074eadeb3aae2300e7e154f66044d4eac4ab947332ab5eca67d826cc9be54ed30bde478627dc3d6eb1baf83cd9048b22d790e6fa356e94880b7cc18c19672902a2f9024731bbfd2ec987fbe350a6a413939e2ef7aaa8b8a5543ddfe63e9828cf6c202dfe6734437e0bb3c0dd6dd624370ea531e6b4177b35702605a8146c4161579682d44ebba14f9fe719fa90dc6a97cdc1cdc24982a0134a348a55c4bdf2a6d102c87ecb1dfbcc53b30913bc567bbb68b8485c9581735d2bd43313abce76e1be80721a62c5450446fd73534d19916cb82ea568ea3af79a71c7fbcb7634f711c10db5621b8c0c67f5b372caee248184489e3e779edceb6ff4a9a1f5bb8fb4347df80b76f4565f06bb6444054198d102c8354dce2c3d9a092dbab1ec9670c596542c919bb1eae530a2d0f2dc6c9db4eb756219dd93ecbe6104ef0dcc760d0dc0188493dbd6607eaac36f55946b718621a8eebe4075be33e9242d23fceb6f3ae235e556929915237555a4bb95f92ab3a349811f3510281b102d2e65dd13573f6c4d5e3695a779a717d7f9f0f38146e63e69aa5e16bae26bbaac4efd6f101acc0bb6f45341844eaace2837de1328245b0a1538b06a9c06c89930b218895657bbf8f7eb28d744b73860e3223c2525e529635d86493b2b99cba97254ce24818e5a8c3e4d3946fb88cb1321790e2f8d51ce9089aac9990ff95f433736be8b18f276b45f17163527cdad7172382f1e1a232f0be8b859db8c37fcd8b8c3a5a2ba28b50583ec6ef107ea6e9cfa6543e5980e96221d1da9e95383ac8eb0b0f4a927ff0d5d7f1d79f3dd483d456263ce9e97497b76fb22202a4820e65bc147d6070d8b95596194a9a9aad0280ec37f5c72b7a757fcf204eadb56acc2f7992aac20984a8055d9b50716ad53b153055f2c0ecb4129bdd4a3e8fc7d8ae0e9315770260df74d51d7edc8f95811e22c09e3565ab0b39e590e92f2a8335f5ab909af80427b308e59a6c1d2b2b475be693e27ceebd9f91c599428e8b504ecda5c70b7f3ba5996b7294be3075dda8e4c4c04139f53162890b6ddd0fdaa008bce6c1e0587fb50e1c95d7ccef76f0bba02faac5317aa642293d89a10be495125962161275711a91e79efdef1d1d6327b394e86d2391e5b02d97b34e2054af4c1aa781f349dfb0db0fe4dbf86ec0a8362c4165a572aeedad18856fb33c226b4445303d7c0d514bb44265bfbe30c2350ca7c870c74c967fbfb85ccee3021c626b34b39a29df0daeff09fd8726e66abeb1e9cf672402ac29ced75ed90ad7c18541af30ac51588813253ff7e0a62d7898a046d79a0b6e41fcde1ef9cc28a1ccce64ad027d032584f0c5f12a9a17c2a901ac60c528dfe1bdb95137e9764007abc66b93bc65e30833fa6e7a06558ccd5d862b9a23595faa2eae3eb8ebe24ce5763d47cdaab3e540b8fc3436eb5d57812581f0c0418219072f57168a2849240581a8bc4f207ea5eb2b026da8053b8005b5081f67b175b2d5507f08224f397f2d7142793dda0cad035f1bb09f76b8154decbfa45b53a8e6c5564a29f4f9540115270156850dd95eeeda470a9ae070652efce9d2ace35fb96ee38200d0aafbac0e8bcb39ae5a44954f07c0b769d36c5be7e6f130b7c302741719306f77e2d758e25911228bbac3901ae2bbd5b21f2b2921bf27e0eabf0ac3cc18b6818fd18c91f86c6f0e117513be980d6cbcab070cc2a860e5f285a9ad6a6e72b79c5bff578fed6c39434af9645455ade150ef3c878b4e882f2e9a50c976535af439da852da7a89bf8d54822419b89097d95410ee453e6bbc927a05dc417c70930e091e9eefcc5aba8258911987875e2cf67ec2f67ca66e0833e5efc780c5ae1574fcb96082a7622fbc0e76aeba41b7cd6480a872c2a0d6175763e1bbfd876d69d1ee839bfd873d346f8cf1e535dcd0414741f900ca72d2d276db53e1ef6c243e220916a4b321c246d2691466dee5553bb07c4d8fd7788b0963528ca5fdf7ff952d5530e60b033dab2ae81dce89a346db25109d423694f12c97da5c9cd399f607665f43cd8d86b65ca40ce224fcf9b1e0a61bfd514c7f66f14f3e7665301c074f6c9191859d777fd1fa934ff10dc20bd4ea6607d2875e94474369033601825c3a6a3c58f50a17878e5e5f5115527fab63d3a4355ad1709a2f3c10f9828ed15f31129c6a96926f2a3104459fc5eb2535d06ce43ae12fce6d021b497ed0a0c7a1727e580d8d28d445321b5fc81fc20a2f8b9ab64c9655c78f42e27c3d2d6ddbeaa1147ee83b3fef79caac9f6009de7ec1e5fa6179dd16d3287da4f7e91988d91cb262a087a10880510676c26559ad4cefdfb836d67cdb1cd7a056cc9999a0ab7183c2991f380fcedab322343a85a111ca8919686d8e6457b29569f6d2525e083a515123e719ccda2330a39f0ba823f42511a6f3d0c6d59282e95c5f228bb49d3e530cbb831219a9e5f5528464a4ca0014b0e070e6a047211a6d5c1af7a34eda7ecd986a9ad65ab7387bfdc5d5c15d65e7fcc45f6cc76e6c5f2c271e80c2caea647612c721f081c5c4cbacb301c8c13a30a91c0daa091b98cbee2c46566db88fca9f2b4665427556311420d8f2e2e1d40e6cc8450c04dd48bc298d06afb8839dc158da3ac53c662e6c566d0a0112587c57120408c30faf8f9e4edb6d7986a68e183865fd9e893ca1a36e9eda40ef14ba6b0e0d120cc24221256792be36f6f726e50f9b84c0e00b13aa51966a6bf1673e20bc817d2208cb380e23156e45cf57fa316af1469df30680c117f3663633e44329d4ac735adae923fb74243785f398c990187bc30bed66097d92f6e33cb822bc61d7cb8b7538df8ffee9fc5fc4addef251c71b8b5cfdd3dbb840162c3bed93c9462e7e68f99ee9d54c748b605ca5d2efdc944c0885248ff203d28bb52190478fd1245e32c4fefaf2d7f513519200ee8e937deeb8b727c9ec8b881fc2cd1a8ac10a4a61d98b7daa89b38541f71a2ad9d0b2fef5848bd5942bbde95ec597bbfdb0bb6f88bc327bc9e43a18b2238721b5d1f57334cb2e742951ad47ab9b46c0c6b91c0d78e8b869bd5c17ddf4a17b84e964ddbef79c6177f52536e1df1cb76801803b41cca9aca24b1a906565146433f153517fef06bea6e350e7b92faafcba42f0027f8411faef19b022239887e02fcb0a8dedf10d53f729ddf6818f84ba3e478a5ef064d52750e96434e2a3e2d44225d92d1c9f947e7e5a27d2d8f0b7b5ec6bf51ded801d9f90ba6386b5e43d52673ea731424b27629016c38409663e418f3594b537071eb2951b17b1900f150cf4a9600efc27b8de47eb29666f0e34daab8224e119b23cbb18a172425896af501cb974d66579b5e037a38427058300ef8ebe0e228431b6ed74260ac4103769bbabac8c6256487e7b9f22361e68da21824c91efd537283351a3d55c38326d47c4366593a053167d6a1fc9ac4ddaac364500f87fe4f776f9ddd8dd3086471582f2f0a2431171dfcda2e2f80e075ac41fd361ec2a1821e68fe94b22ffbd7b3167d3c6f851108d7f2e4d26b0e5fb6f7f5b8e6ef526ebf08ef685edd60b02b0fb4987128ac6a13096fb83c1e5422df7208851adadc242268f72b26a931589779b93879c00a678e82ae6dd00589e55b7f37acd9bfd7cbef04b408e43ed887e7b52edb7fa1ee814257bffb66d0f62dfad1aa22fa8687b4efbb4f7de6639263def49c6c93b82ceef8925194a56943741d68cdd85ee9cc87682eda5ab5c9e6ebcfaae34f994d97339ae10384c0eaa508669007fe018d4b4a272b542b22ed7d293b3ea1994aee3e077e2ee9f2284c6a4c5dc74b9a857a33a171b17f9903e4b0f90b1d856651a15281fbd450d3659b8c5e15f8c327ce8f9e2c5444e2eedc7d6f5c0f40c8445ddfa18fc033f8291d5145542a923a4212200c47a7dae0cef52df3c49ef3f5e77c1b2c2655c2d71e52a2362deada988def91c55085d3fb1a5c13244da76da3459b07c5ba1e0879cdb3c0302c72eecda61c41b8ca718417df321c5d2fb67cd2d7874eae1f3f176526beb8a780ab96f2c71d3e134183889383f9434fabf2a012a9af749a7d1640ba420c1941fc6b9a1567d3437a09859380ea4131874172aec9037b4a8ef4c498c374f7ed0faad476a5a1c912b2ebc84a3d4bbb06c4690a3cbf6794ac3a6fea9e688fe0649d7a5f86d1469fe64f4f680ae0e826aa4aa57f4ed5cd4c3b903ad069d7275d6a0279d575e2837180897f3fc2d6771dbd73678cc347f641c3cdfbf6fc62582c12682563f4462e9d559280df3c3245c747fa2e8fe64fc8c08f35d02d93123928d79a7028fbccbb644609d627a7b67cdb9f0effc0ca08b6a36c985a08fcb743d768917802f280c5e6880e31515dc5a527caa41399125b98b19496b009e8e9d594786bcc9d216270874f4959d9c5b647279212de16ad6711c817d0bc551a9f06d5249ca78783f6c232d5ce139c1cf318b5cfb8e96509687fe892d858188c25385dd9547d2cd847d04d576a68c5f1c71a485fd25c8c2c53c60bf1186066a86db63a30d8b12cc517a6f7a6e08e0651c7fcc6632cb6d275054a2ab7ed4122666b6dfc9a16074e4ff4bd887a699e0ebb60a67dc1051a11a33e7cadc417120307ab500a07f9c2b883a2f60e37b973bfcadfda0685c3f9abe856c48edef131fab1b69b3c9655fd8ee341ae270614ec06aac005c43e860ab42dd2d4c051fb9c9f0331e5aecd4e10849ec2a2b9faaa1a2c19083f6ddf56eb2db81356b94abe9c851585780a89fa76c0c85c808d634b2f129f456a5a69813a49b24d32f76822c4d19725dd775992f3e67e5b98c9f49f9d4020faaaa3ad1f7b30c5fcca3720ba1a7b85bbcdef0c1848a89b280b90ba6b3221f6c223a48fe8f4f09dacca9297c18f262bf00f2d0c95f8c59be7c4e2d2b2f883981ed56236b85ab98d4b242b3aa7b7bb842859bc0f6081006a0b9663f22f5289b0feac92e1754b559d8a32b76e0c5878b678b728fe2f2bb10e148751442b11b0d814065719572aaeb5a3fed6b2c082375fec3d9e3d81a4e46670d442b1dc39ad57a6de0d4997aefa079840a6715627f3c561ec9d0e98c23abf426c854b95ec5f3d1cd359891b29e1ce7fd89c78d3ab14deacd851dd11f932d97650c206dd71ce5c6fa5be881e46413edb0d69f06b2013129510306b1898dd5d851ed4b128ca53dc58746957365be9838d6aa6e9f5560e696889117cd1c2818c7a06062c166df401c7531ee489511cc6a7537b0a3d23e45ead71de7c87d6b137b091a3814cedc4aa17c906fd495052ec79aeb86ab005d8bd8b1dc8f9d7c7316b6a6551d5e6e4f9325b62cb82cde33a58471fdbe5063d7844c137e4ae221cf428971755a03c0e4c400dcd40d7fcfadd99ff9d78cbcd563fddf574c41793a360cfdc426564add8250fbbc339cfa9c4c0bd3c512ca9d30db8a063b0af5f2177b9e98cb1a1403abb243791845fbe84b507c42c73636d8d509817053882551b10515ef2f4c08cfe5fecb2723798263121148f4d339fadd651f11f6280fb439ad137c019880f0a57c493eac69d41c264b168a638e06ca20364ce9ba6ff7c2b4c918d4911b1689321decf9429a8bdfb7ccabc980fe74ee8bab4dc3405a078d3f4923159cc7abb9320a3f25975beeb66a9be79216643e153fb63b9b35e7c6eac5d616e9112c4bbc765fb092fc83d9ad071d5e08a799737077b62af311e6d378b99a6d74afe7c836877acb3f2d247e8ae4fbb37f1aff690b2313e2cc11cc5715fc6b46d89cf458ddc20be7d31cf43339536ab2a47f28d5e05d729495c69e3ae30ece4f6fb4563d91b2b407151f3884473a14b6a0b16941603a471e9d1ddd83852be78417bccdf43264358264867e7f3eb8eb49c53040c8da1e46683b7640a28c83bcf07c97d04991eb4685e7de703e584596e559d60f3dee6363b2cc94ed1e8488c4bbb1602bf1e69978715c62bd29fcf0bebd3a036878eea415b7b3fb788975f7d6db410ced60c80d623a1c84fc698ae33b6e15ebf5a29b012bdb647edcc8dee36c6b1a981396252e782a986b60558d60317158c32231eb4862883c2f933d6aa7a1ed31f2a9089ccef812c0ce3f3533b9028b66d678fa64945250ab4b8488cfb93c31d3c2f695c6b302bbf3dc5c923e9ba02b9526b4eea51ff7a3ce73844eed88b992f7fd9f6669b55b1a889aeeb1e3884205da286b88199a99df17164dce57a6c2e0b15de5dd6bcfde6f79a3a48ac88108e5d7f7749c4fdee8cfa67a542ce4da771911955c1a1e89dbb0e09725944e4c390e079591d8a1c394d1ef4ea52e86d12abcb99d4e383793ad1d6060f3a13c1fa5282850e5e4b20ccce8f9f2e3c72e6b3c415abb5fd5db3bb18bbd3e73bd66c3d0b345c59415720d8d6725e67d9f3060abc4989608064a6e3d84bd8df8fa69552f37b5c6c654d2ec35b785adf9951ff3cbc67dedc5fd5bcc8651561818a4c2c990b0f814c80714d3bc81b12c536f5e8ff53d079b099f2a03e8f7c279e87b1338b4fd52c1f5e7085ace40a1f7be5a86aae57290311425564a8db66c9dcd9cc097f9c10a14d3603cfa2d8bba0999846ad0687f2e3c963668ffde374d092302b039e07ff879d21d2be739f242437c5e200271ad34c1ce9d22377e0f1c2b1db06dae6c9f0ced8c55659d3bc65251a404e87e377184ee99f99024c4d97101b91eab72e338e2da8bde449a66f30d5590f16ee411814afdde106ed097d3654941235282837c3f7f89422d961b0615bbf351b054d5598dbf1fc530d70563a7945dfd29b95ef1953555b86594c4f12cccb2b56be45a880f45e94aafc87353aa3cddf19c564de3f821c805929389e4f254f5a4f98cd8142e4c3d3bdce8aebb49c5186420f68055b4ab573406a54a4cfa2984ad2035251293c4aa0f4a57cd2e41fd57eb6a265eb2d46b44bcd013ec61ce58733146d71e0f66ebbaf37f2fbd5956f598c1c42b24f22ff59fdfd852571147271cbfc6bc9f8ddf2e6412aeb01576aa45951535eb041833be1607952b415080e9e9bca21ed9e0868f45928de8dca2c58731839077fac96cf2a239add8219cd61d85266a94b51acc55aa031dce43745e84f9142d1387d54d6b66dad7b3d4e2b4b06c27103a083aa7f5a10894204926c446a4733effb9d000d0895f3c6889a0c999d7a6704f262e7780234af6db14d8655754de3ba8f88df8a99f91ea4d9e68d2522fc253af0492deeaf55162c45e96a7731e55f762187f7e823632721a62c23180b262873ab8113dff5926bf2fbde0fa7a81df4e694874bfe300eddbd2e990ea79e60aa9eeb764b21addbcf26e7576b08f388ed3999a83bceff9f0d20dd37eceb2dc43036631def777e6ecd57e6bbdc6287da35de38d2f35662b566139bb69d3695f2f0997a8a4311f88203475cc288a207ecc381edb38b50683e4da77f598a1b3464b9b28f5251e7361af51597eb5f5c920636cd65721f7fe26bf5a64d9f750832f15419bbc8b799e2132fb119c4bd8e41c05aec7cbe0b808b95a9dc321af8c76395668f10267f658b9e5ba69ba5446ce1128bf1b9297c13f30138f904bce7b5d2a80935f0ecbfabacff495b84d75ad52deda651b4990cac4386c737cab7ad712ec221364923c6022eb8b0a2bc1b7b6622d0e738837cbd5dea6cc070ade8d191653afc22a3a5700b6ef484be0db58413539eb00bd802601a06e40497eace8fd7c9b781504a77d24d980000321e83cacca982c4ba89768269dcbc954ed507d6e4aad06688428e1811846594b9a2c8bbde1d61590390124803aa2bd381d1aed90f29e4c80bc8b9b3e344960387b7ef3d3b93fe3485855f93619231c9ec1b2c28ede10b41b3981775355f3cc4abdcf73ce41d00d83f37d3bfa4a7edd31de6e2d5d7c2d20445254e739750a1855c4656559b6a3a1eb0fd30954a4c43acf34cd95b5bbf3b2e40aa9e0df2c69752289dabc782e628b536a6b53ea244442c0105e724c0d092e6fef9d12165d1dabac4601675ff68fb55db2b0fef6c830d3e7fc86d30881afc943742169e7f422cdf7c7662b4d6f9bc65a048ee3e8150ca338eab67d6fab61f49671dfe225e426226e6aec329be7c1c2c7121f5331f5df1785f6a5ea09d277a94f95da36444e650a31e3fb4210a73ea9cb9a5144c1f5cb6d6621152930054e7cb8987695cbbe1c405451c5d5ecc6021600ea5353dd5555450dcf30734386b4aea9ae8d554a732de4d86d599daf1ba9b262bcf5f712debfbd15be192d1c9810dfa809b13c2b3e53bab1f70175347270e75901da4ed933b7a025a7aa6524c1941d206b2031dc51aba0e2de2a019c450603c09e8d1027ad0a7f90869d60c729d63f18fe3af347252d21726f7c1b978f77531996fd8c818e4dd943abac0e19362d8d22a0dc2f4bed667424f75a1c1d80d5c2c9d9dbede8ad395deea2b228c2668c005d14935ff755dc03ed3f273cdd80215529cbd67148d76e5def054d4f6cba15a63c633cb61681b97096e0c3f79720086a2916cc593fea70061cbd580e3783ef16d2c578c4caa65beccbdc2279f1685c4178ada7f563b12212d0cf2f10016b7213163e1a38bc27b7e9323356e433dee4ebada65d2df134fa38667ec1d8af2d1d9dc7af90bd11d68fef3a3c108a3a8e6558ad8b2ebf3d29fc0e62c5347db0dffe45768a6659d10cf9181cdb61ea55c724d5805d18715d998467188f91788594d589e13b88aada0fb62f5b711b3c8b18200145f3551ae01d800a27afda75a6e87873aa088b9ff76800fb5d75725d7fd36a032289c6881f4a68c8add3657472e7304a71996ccd560e9a01f060c84a02390ab077cbf20e1d840823258fc94fec84e17d1d99e37e4b5098c47c5f6474d36a7b3af6bb8efa993b09ce6f9a0917e08b1d068d003305508c9d98d83aabc301a1315993df778622b116c530560f6189de0dafdc4ed4d91004acf1975b61e5f7c3b295c41dfb337e5f5b0e3943ba382a64c09747c9d37dcf93ec3aea3e456a3f7d84e90419592f4aedf85984e26a4a5c00ab51d13c8fa9adfabebef946dd1ba09c0f35a54580264b6d87795533a92db46ec0bb1cbabf8e029c7bb9ae38c78c7dfcfdf2ccd9cbeddb7d48f4b11d4611f8499f67ec4b916c7c5d4df5c9f7481b8240c531872b583a60cb5aa9d790c1ae7c254c8111489a4dbe755e4658ef8355c138f115e91d6c1e0e26c82ab28896b5f2d6210d8bdab10e20a648a25c09b8063062608a809949f646ca72e0b0e88a97b9b4cf208a8657f04c0c84a535abe7ef6b361b528390010b9517cb152555c65b55d58599ff2f2eef2258a1b9689f6f6f5401f6330532b9b8fda4e874cda847f8300ccea1107d06073522624c9034b6211e48d2f37b113699fe460ab191a89623df970eb236c611156113e83a82d245ffb2740ced135454db27cea1f7d3bea74cd1fc2f0852d8f51ae2120dbd4080f16bf41d67ee9785d873bb88e4f56d3e5870e245a6deed52bb5b5d64176f92469debfdae9f09d6bd0e112dc99f14c6aaab8965459f9591da64b93bcbee545cd5d1b98b0735e03c798353d27049c0d84f8b3917f732887b02dd520aeb7ec867c771a8c6a9e349babba4bed379b4423a06da853a2a7e0a1025fbd1a1672f39d350d9d0e816e137188b23669c3631810a691f208ba0c36d43c0791e737e729b1cd00fc41998753a2177e8d80990a13835b0d96b76f93702ccf9ad4350190c05533142d02a8b5fcd0bb343dff62c561fb816eef67dea6f407fbf09f4d885793acefe798527104c4729cfda746d1f6d04cbe1b1da564415914575fb7a28cf8f06945b073d9aae2f535d5162f82f70ce963bf5e2d7021c8b36464c036bc7d82d66779ec94b47a61ff24f0bb1fec58686b662f5ddb25f0eabe99da6c8187ca4395a3366065651c98f57d7426451b010543c7fa330a9f910ac2c322ff87acc6dd1803ec3112805fc83d95a28873dc23e5440cd2982db5776a304c91c4ccaf5e355576258c4cf6818812c5b4b266b4b27b0ab135036c2c9e213788d055b3ee4c6ae77f7d1f9ad982edeb299813567a684791ff61ba9bfd53d95d178992c2a3834b2dc4609cc36648a173a50c751e2977d8d7dfd8e2ebb873be5a3d1258efaa77fa5766b72c6280bfc5c693132a9f883307a955587414d47e2a09a25612fb41d1e118dadbe9d850fc3d9612ed0bedb57b6dc0310456c0113262a2a12303769d0c227ee6ceb440ec45ec9f29600f30824fae208494240c2d136a22880b92305b5470eb5371f305c78e80c5d06df6ff2c687b30ee24e57cd5832dc6178bc14eb574933d0a80474a5951dd04075058279dab466e9c605ecd82e73fa957f5c8de5cc56babd2135d7c7e6a223b7ca2a38061bb8b428de7b2e343d0b2b1804954555952441a014f37e198865bab546a59ed9d3a4f6db8bb984c576aa3c474edb01805e5edbc9c84ec175b43a4e442b8f6059eb1fe774fce6320ebd1afdcc933218321bbaa8a8a93d60430d1d042f05aac8d73ee25699197138e2f7f8913a79851d4c338d88c1cf06558ab00140e8ef4b8daf755504e442d6b2d0242de41602bfb40564e83523df1c9252a55e8fdea0424b0bd15d0a9a3a2d8576fd767d3d0630c250209cc5553c9afa609cb19b556d0360c1f57dffb4c8ec0e0620f54f456545570bdee6d8719c4c4337772da146cae153760151698b9c11861f67051c6a13d78d45e5585a29e82d414734a0a4b255dbf68a38731ce15cf86d7642d201304d0ae167f586d466862a40ab5607aee8aeb331335013c1006ad7e2df6f5803c0d5aaa9d6f8449d462e983841c831eb01e92e8f814322c501e21835c20773f92f1bd49aab9e461ee7dd7d59313b9e87f23da3b5437e309958800972ccb8d47d51b25087b44d8a560fd9ceaf0215cbd014c530e543d515332f5e8cfed391e2435ed5ba26ab41e441d795d3532241718f693645ae40fa27b16f3358e1db6748f281da72ff2de0e729028577177b0bac3351238cd5676fc4c7a7a4e27ee6c5d17087b567f0c5c927129f7a6118a268f89140849dfde094e34734d3119ef4353ba40f35f6af8983a15a2d78edf7c57fc632a0cea87add999fd7109f03144d29400afae9dc2f4c202a0e8801060104d31b5456b6eda1d48c8c07aea0da4c9bb76c8ed989e26b86306884e9cc429319b82617aa6a657cbbadde9fad3cc668bb1953ad5896a58af5115026ab5a9a01d46ce209f8cdeb51213cae90d363a405a32f293c1df31e83638ce0df74dae4efe3726375a27b71330f5dde6d657ff1d8a9204f7ce58031d09b92fee27020d19a67c5afde5a94dd6437696e1fb125ebf0422dc5dbca5b71c0ea611f805f4d05a5f9579792ed35a09e15d9eaca8730583ab460ed98c4d0373f1c9946d23d629e9f0ed733e59056790f5d2131d94d63e0b18ecb6e24a2e0e51cf1018d3241eaa643c7f49b196057aabaa269ae4a9978d41945ea5d3425a264796c8990592a4f4a6e02777c7fcd47cabce52ecca738b03733a275b36fa6abf3377f60ea8959112e196982daf3ae22ecc28275c7dbbabd260cb41d4bbeb7478d1884a617a073bcb4382442ebc4da3152b9859d5a4e9c089c0a79ef56d65fad9a6c99932ee11b55cb5429d9814e27a43064815006f106058ae73056cc21feb46912d88e7ddcb858abb37959afcd74d13b8cf8105ae584be3138009c4918097f5f36852d4b66481b6093a654142cb18a20309f32cce2a2789460a9a08ec3d0646d6a966d78c95e9df2db1014b2da7bd3c9f038fd7096c597b6702a98715c2ff468b6b2d560843a6d363f12ab05e7db49cccd2a35ac4bc28e7de0827f8d32bad4fdfc2546f4b1c4e9d7fbe37312750fb39b10bd4749faa086e2cf52b60c93df3d6cf049151d09fa8a7271bcc32b686a0359f7ac550f7a9c77be291b0655d48ae8374929fcf6e18c2fddb57d3e210a1c80a701c63c1c674849268158c30d54a509c91ca794e7a1221826aa713c27222c1e6bb8b4b862205d1eb1951aaeb4703484ad28b5e6585ba8eb92e36a680cab870d72576b78d15bb371bcc8c202c0e28224823459b64e934eec86c1249d3c01ca0068ff99bf744f78bbf5537ec52b76e565334a494ba0fdd677a3ff8f49af589a1ec8957d202fccb43433b37766666c3ffd8def9f732766db516d4b2a552a33cb2859675665f28badd42ca7b9cea5a97b3cd953a23cd9ddebcb933141610aad6d51a5d60b027cb83678041497df346bcef9fabd9045311730c638448b2997085885bf0c02a4405c2e3dec24fab47a90a49bf60d5fad1cd3e5733605a573767783383a71bf99550f6ceb43f322712817d7db26188835b09035d9dd683e12809b06722338089527000d3c785fd5a7aa37933b10539dba17572500097b5afb142a2edd4d1d9225712a26e80ffde9564ae5cc1c3ce047e7bfd443ec5a39d0c90e639de6219e059d816741159cc526384a3effac1d009bb277089e22676e44f471d7d1fc2c174a607f52ea5b162c5720b6cff09bb020b6100be1d2085115a7c9480451de016e62668b6664093c2a1cafe705fac7cf24c4abaaf915fa1de9f8898880717967b513639d96754d83eb70d56bc9a53c9eacdae8832b53bf74c0ba21e8348a85586c4d691741637286b040525c43dee38764acfe0ae87264d05a8ff532e30d231f13962a9eeaacf9f9280a8be732b0605fbc5da6fcdecae4aa62a84d2e04bfb975f1c411f55c1a0c13e8506116750fea9c69566d70e084140c5c6faf952e8be0fb23f2df0f35fde750438036312d82e5ae52678446b0bf3d4b619097ac54327d67e73aad500a25b53a2bcb2858b2d2356053c8d5e3ab4a879845b5468a5b86b5d80fb20010645f258a7bcba5c108e2ef6f541c13eddfabc2d1ac0f740a3116d9b5909a0140d06a09c9f44517062baa32412e6e9f87da337bc1c1993175141c5cc3a404d3b79514f3676f9316f506dab1e236a7319c86e902ef11f7d8df7c2ae7326f75db34349886a1e2bed0c286983993911d3e2051f4ae30dc975352ba8266180ea3409f7c791f8aa6fdc4cd1d8e9969105eac4376665dff411d3899e06446638af87797393c3f4b52fe1b11b0ed277ef8d86618a163866ee22d2b1715d5a2c14765b5f9cfd7314888d0470016bb5b4f202f2417b1915600e5d201427f6969457052781a63827be61e68c0ad654a6a32e25b3eb577430e60344824cb5100ef665c2e042c413a7b539bbc8fd8cb54d4c53f9c892db45de11f15ab77586bba55d68e248c7bc4b620f72c7aca655320317718405110fde036996d6e1736902af516fcd326f3466fd7055a91dc91cbfc834a95b84130a5d29a1218d123be8544403986614085dd1290ff74511b7e4cf77518282c7e7f9e4a98d6802c531f7e5d6e543bfbbc19b453900f7b7b5f203095a43f40c04c19bfc336280bd4c330ec95d1242e826c23372f6bdaa0a5e1384ee64c7f8ba4dd385f7b207ad11f34688f3a0ae2fd67d96d26d6ca2a1b41a38f69d70bc0e3f5680b91e83561649d9387aecfbcca448ef3182f485ebce748e79b9403a91fb282c9c1e2cebca0c74316918a83de102a21efccbe3d278e5ce185a07ecd0b5ad0bc64c7f6fe91586a39cc22a4568bd5b589a940422daf7d7119b3711348d4a47a33ab013eef4ecd5c71671e4d99f334130f4902d103e21b08a4aac5cdd8934164469b1b48e6687bf3dbea8905a28d3b7097d893559e38630fff3b5fed502cb0c82356f429238e820df8961f01caa7fcaed11cc9804fd7e095345168aecedafdaeabce2a1a644930ae812bb9a13e2df50f5cbc853694f82684754ebf338ac1febd8dc292d9aedc066aad6da4a02f765a9f264b8d1a65a71508941dee11ac8ce3dba50641824f1b7cc8fb85bfb220ea3b214a1e44161c3d0ec866750309e5e097ea37cf3bfa52d57ddb22f49e45332ed06d095f5964cf2a0158a35d93eb01de1f2e221580fa5c59e0a2a22a05da14e7b793f3b2739101306cbf330733cfd01d0956f2c666700a01ce1a0ea1c2653958fd84d9709f2562cc83db2d3a127360c42bd2ae600aaf419b040284b37dbb24e7585b0c6c21b0e5b44b97b6e557352bdc306b517a28b70b9b80f09257eb5c507a19d4dfe6fff03d4434377198927b8baafde3a5f7c7c895b8f99ee63eadc57f80fdaa38ce1beb20091182d185708698fbe69dbab5386cc1bdf033761d86c7f306b3e2dfddd8e9f396ece6f33c9fd7ef87a0a8ad8b6f72c4202a4d781d48efd82c40177d2375dc37fe309802a1e8da5f5be9850384119cc33eef0ba3a19edf3fee690b39bef2f4aad1988455bc84355254c214912ccbe4cfef7b68d5600a0b15f11feb0c49a32b130c5d007c0b7ceaddb473d3c14df7d90242f0af599c5d873f80286b3feb28706523b179311cb576120553daa4715773062777cd3511e91c2fee4491ae7e21c41601eb38465194a6cb4a2eeb353b1891c9ec65148ef95835d5cc7da53e3983317450ac31f17671aff572c34c11a2dd3b7667193e5bdad7dc669aaf06659a939769c018a3e4caf9db37cf863dfb5dfdfa67fe7e8f69dbc834d21ce600adf428815f2acca32af17ad731af8b9104ca6fdbfb8fbe300dde83a14982e1f3cae9557a9de74d41385a10c631a640187adad6dbc5f56dcf7bcaf2accd35e889560554d61a16a74842b9b792580548f63a566feba547e7b5f5217475c53bc11a793e8df290f15b20f05b7c1418ba03792929cff2e8086123d997820bf4c263f5f6c3e6feebc110dc72d8a7f9a09742f11703d182195bd62575a8abaa9eba618cd77459547632ceb6617226930510775c08fa191f0d550879bac579a74c0f253ec6b523368f995ffdd5e8df9e66264b07c7201ca3f16e035b5dd779fe3841b3eb5fe4c8b3cc5545c1284ce4184177b77bf803c05961aa776cee984d78965d0688e1c68f8101e7b6e37dc401cb978ec3ab956a7c2ba3e1ba263c2a9455b313a7b7c1dec67a1b6b62a203d0d5549757fcabfe7e3f9c6220dbae2a806a87a03568d4df9cd1f4050cc3f1b66124fdebfbfe8c7cbba4d6d592ca99f2bac0e9867f3338efa9e3b8ef7766ae5d7a8deaab6f142056d888f103c95b3243cc865f6ec0b2ac7721e63cead4a6f9efffffe5ee7346ddb04b869c7c86dc7b290033be7bc8d9ef934a5cf450cbf53334ff59bf8c82b20ee26dfb9e47d8255a4225a326a8f9153c237cfe2cc7b0a36ddbb223b41cdb737a1737b983fbbedf40edef73c9374d0030fff71c515119540d49f23746d4fbb64ba70caa40a02440e100695eea6a4a8ee0719aeb718ed705be37485f7ddf481a40145d26ec2737b66397e8b7b942ae1b67560055592244412e43a11f8d32087f79b6cc0cc3b8adf60b1b37b6c3424da948ef3e6ee689c003cf70cfa9596738188c6b988dc4e409c7319213ff18b4ae3184c0ee3db781fbfb66a5e11910ac217c5c4c21e8c1fb4311c4969e8d2d0cdb6108477b745e44e0e9dcd83b3e58468565398c76c5e139836d4ce66dd8c4653409ec37a0aa4862068feb77d92a7443cdfc2f368360d4242490a99cd8827d58e81cd2cd741c46ead261ef29bf81be96016a902c7d3826bff777bd52f2fb758d10512436f96ac0aa0640931a12079989fb8500b13df49e51348d7ccaa64eb26429cc7652dbe5d19d56b76505a40df494df769497dbcf0ff55fc3c72481477aecfdfaa181c4f9a9185f301922c1e9d88e4ea609ccba89e64151086b0cd0c99cefb9ace91b7c3b459b36bb86cd1fc21e89a3e20fca4b2ceb66342d3dbaa38c6b9ff1101d4aac53a1faa2286d3b813b4e400dabaaacaa369055c7f1d04bf6be59515af22a93b73c8ea08081e23e04f1f5be270b5f14a60c0e50515db8e8c4200c1b0173191a7f6f633ce3c008925a169a98beed3a75b14723b63bf9e5a47783806686af923c92b24da20a18c1c539cab4be79834f1959dabcb1181e449a84940b4a1ae24d7b4f86d6beba7fd06f7d5b4c64149f879ef6f2130bed67f01832f5210b850df5c17cd8cccec6159bb1124b160ad05e600e3a4e3107d9f141231550be14c6c07baf8d4e0dd394d79aa2aa2017a774db7ec5642998f32b1a44a3ad919d3e13975551b279fbacfe9d175549791946f0026e22a660302253197261edd0106c498ceeb3595e4a82ee94fdf5227366a27c5af8e27ea7904225b76d0dcbcb288ca44e4592a0bc37d6ec931f5f02130de2ba9473790e94bca6be8623f8a605400430072331efeb1a18d30c46944d873fe5051e82deb675c9a69135594c666204b5413579e9329a63aa64f82f3a41751a34b43698d86b172e8c627ed2d79c4bbbd7ad40bbd1d9247c5b3eb4782f64300f78c1352ad7af4e611abc814466d87676c4cf74170d5b4777f4ec9f81533f9315035a1c07eed97dc620a938317e28e008ccdeb54b16a1fcab76f49c9ee33406e9302bca8fda32994a133795ea9875c5b6084e4e9241999da9e90519b3634f3f5a1061725447835b25819cd6fc6a47057d5af05b5e509c59e1bc9ef9fffda9c57bdfd83303718fcc745d621264686632fbf0265766d7130a4a6c74d2be65d89da0cdbc3089be432868a5faa330fa7c63e4be9951d683295d03f6fb070d7203d924ed09af014bd0d53792f656262c91d6ba3aa1fca987cc58bc50ed1b020a80736820e89aaa70ad7ab035ebcb8b6c1e90ee954069d8af7faf6da759de8023feda4504bfe1b9d7f1864596bbdaf1031ae7d63a1b4bd4252cef73d67a4706281a31c2295903631121fc86f3dfaf73e6011f2d5a59bb66398319810cbed0686376fd9c34fd1faea281d2e521a04743c696a2813d7eb2c86c6c9e2e337387d32810f30fb72f9e43d886b108792d4510f4df402e1dedda16def07ed1224b63050ef0f3b37cbc0a41e38b3d67525b4e7d4bbe409a858d069b8bf76ee4b5ebc2a78af0d483db26a15dbba179ca3448668cb96042d1197162fcdfe66cbe493585d80a55001a6d101902a6128a17fe2e6b33fccef71993c1d3f53979dfcc06f168f6ac48cad1189b893c650f6e7078d9f55dc6a8051220162275b3d53229c75823a3760a51bc1125b2d34b837c09964118e63e194a96dec2bd7c6bb4904c4d034305ac1ab631a617d9e2568ec821b3e0779f696d06d1e945442dfb255cd40947b61ef27e25a8e634fc3aab8fefc198aebe8546245b63acf40ac23854fa0573b0973ef10b96251f775c5c52f9a09f6b4721fca6349d1de594360818b3e4cedfe6fa02c8e75ed610bc3701c4b22296df266c31bb347ff33f0b9b5f5c105e9b52c88177477864c42a13e40f3eafa4760f95b8423f0e710e395fd3b512e8d888ad1f6cecf91c920b0618771cded9646c55a2dfd7ed75f359a43e6eb047d357a60eca07446915c2d9764849383bb1f3844218d06dcb70e314b4c2060164a8b7bc2e1b1f2c3a951dfeda04d28a6d44784144c080820c00c8d682a50943dafbaed44b454c7c38987f9733dfe02adbe8f16ba539e4b3093ceae046675fac1cc6696762a22cb9de4142cd803b7ade68bd4919de7603b11886511856750531f07e9e8738749f6b2b1fc29ec866dbe1e6019fc2f6e4021112b8525ac08b208f62c33962908c4dba1ed8a9a96e6988a36521b96f18ac13671617db56ef669ae26a86f32c5ae51fc6cf4f3b8929b0b01071160d536b35d6ab19599a8cc22b5ab191537eedd2e621bf68d327d0019eb49bcc262eb4e4ca79d8936c31e1ca989edf9e47f73499d3bb8cfae1bf32bf2253de924487183a65d53893075215e97e08f8107b9ef75eebbbf266130ff064dc1ca03df39bb2bb3eac2b4ff077477bfa91353c069f84d731639e318f816f4c49164fca8043022a792b0615cf46cc3da4bac177ac510406c68b36a7e6b5a98d07b32b9bc0098ddfd7046cc465716c092b338cdb216aec4227da289c19dc2ba268cffba36c86a4494b602841287dcdf94dac2d4ff8fa2394067133e6372511fb4bfa372f5e6f50974e59ac7b25edd8830039d0ddb0aa69a3134451fc9cf5a77ca06938d1fbd9b1cb180ec426c96aa81894a49dd0b1dba12e8b4637ac263dcaeb6703c056e4c0127871cc7df35b6951b81245f912a6ed2318e17f965126ec2281efa3062007de05ecf1b049e640ccab1f41dd86197e47a906706fb68c2a4996ce12183515b38df2e370f2f2e668fffaebaf9fd8c31891c380cd983505d1fc3e81364a3f987d4b6cfc3c538348cd16fbda6d717788f3da8c510b979cb55894337c9dcb14fd4a9b19b9d6358d42d4eab276cd107a650ffde6879a41eb9fc62dd589a75d88ded2eec431218d4d5b09b8bbd77fe4eacc295cd356d9424da96d9887703a0a08c43453b52ba3103d51afce584c4829aadbda31d73163f4a0c4f6e09ef5d43f5649dbaf929fc703ffd5c0197000339691ae31bc50a4f6dffa1b68598222ee89ecabeeaf5629d005ce8ffa7546c2b8ec2a7cf637bd454071bd7dd71194ba47d66eba36711b4d6a41e12451f8d9eed2f48d42c35a178d3e009283c30dfeb36dd41ea75d72e87e2b76d19ce7538a8b9734e4916744f9049d524f76dda24fa3e9deae1085c620c100748e740dea93fd8d6e58a1b96bead027b890d4d9183481a38b629d5a6d9f68908e3496a780349eabce9ff00a46077fb3950a3fed3399ee2bcb22c59e97657b424307405adb65c174dead06f0c823924aa12f195e9a59795e0a777f4bd42d07de3f2d39ac83bc7afbae73a9b74e37a4bed5a59dd3d25fe5ae8cb6abb4a95b6bd1682471ee1e93caaac1e3e433c9e9c36bf96255f60f2ab4869f6606555eb0b56d8e6ad82e74ee226bb2243aa6d994b57bf71508c2157baa129d531c00cf59b6fadb0870fe902f67cdc01ad57ee2d4fdb914f7f5e35f807553d1fbf2687c2fdae58a17df9fb4120cc6a40b5524bbde54ef936e43957a6d98a0fd67e0a2a5dd1f6ee1440f400ded5748dd8116d1ff7080fa2c376a4382acd6f931fb9db105b62defdbd27f5703544eb5deb90d795de448cad120c687b5cad20d41995316cf890e8258e21cdec96f93a1d661cb37a33e3e65ea49ccd635800f087682d259128c489e418a1a3540122e8649b5787203e58e463f6b28e41259a53eb9d2a45768e760e74c05e944dd0d8c46d8054cec66ce887250a17ba881d80eee4a54f7a1df98a437068b823a7c6ed9f3ea12399ee37d31b6761344e881947012c7b1ac2c0d4b7edbb955780aec4b80db70f9f536ae9562cebb74f70682bd683abefb7d0ee2779d9a3ebd001ebc318649c8fec0f167cb52e31aa8381118c6530ec2f2c3c41be4112bae6e83cc1bcb7bf1929a8d26f34e7c90b9e5204ba77587bf20b9011f48a2e07ca332f8dda0543c351058657a30886ec2d06bb35049113ef09ec93d04ed240f8e90369b728a03922688e23053bf34b03a668d36f22bc886082de90509f1e2546258df67096215c85128e1e5b4bd4884c010d1a3c42759e5ce9aa7946411cb0a25347a9f10169021f4cf70207ebde4f2d937cf0f70dc9f7cdd90bd649d4414cf8cf520a44d1abdd362765974820d4980d977a4c838854243519a754549c5104dba5993aceebe9e5911980d9292628f60bbafa55a44bd1c631b552a6f30a90d6d469f194e82f4ac370314857bc805fb6919eecd229a3898e99e006b752dea34bd60b9a63b4506e936402159a41b06537c825f371b36c104e802ef577c8c77ad48f939cccba1b6c83b1e2bacd6e4521eab0ac8d18742cf1b0b5571e5472a0a330e1d64654328b107c9e0b7152129f4cb155d447b0143a5c53a57c21a02518c5f5f10fc7189542a970e20963a6d7cdde30048b2447b111153834179387bb125abbf973a607be14a6dab36f42afb9e933aa36d26250a144dd9c7295127ae5013e504a98cd9f5a9655a52dc726f1784c767c493d5e1188b063479cfaff5a84fa1f884fa814caefb1adcc5243d898e45c9f6b5c18086ee36c3ad372dc300c1ed9422defabe08d76bdf09c2cf0118e56c6496dd5756453ded23f81dfdbd98dcc06fe46fa5122e5fd02ff9b10d93ccc31795e2364abccd2395440917897f6cc1321c7ce671505fbff82381e4de359444ecca40ca92264f8266148e07c03aae1dcc7242cd793a3d4ab5223f13347fab9a077edbce3a13dfbcb67f38e3b98190cd84b97648e70add390ac97d25c7c34f175922db85b5b71404fe394a78ae0f5af0814eb879228b2087702e3d910cc3b01172f3219ec06f3f3410db23a533ab3f61f0048f73b471b7ed7ec52d251d9554ace36e5322d3db9bae51cd8e6c91b636756b8da13ce1bc013746059632f19660f9157b5e5db71517cdb57b9d74b51d54869160c944d7d8b52edb9acd80609cccd24dca7a68735bc04f99e137df6cf363e65a9fd28fea5034d33b3382311b35be21c6d06b8c2b1f2dc3a25a19952724180179722fe4fbab88b06effb0b84c61e5cee8b0ed08942562a03d857fe75e1373ade199739008566d1025b5d2dd39a8cf86f3acc2a8da6822213e629337ceda76f5559c584c04f15c8bc1e9c584b7fdabcf81852baba8bd825889a41e226bf6cbdbd015c3abbf623a805080bfdcd42742db54cfb8709f311fba361bd1f9313e5252f35b7560f3d90f817091def04237e8c96b486c6b222225bf739c4b5258655019a212c23f3792f21d65403045079d7a2a55f8828e0ce24da1048c1990185ec7dffc0e6050ecddebd588bdef5876cf76af5e67c6fb6289da1d3080871983b466fd3aa3666913f1efabd02e92080703c6be8b183f82960a3650b947f16f7f3996fae6e7f79044e7bb371b54911b641bcef13a879b7f56786a9674c43d2da17460a1e41c04750a9209d1d173f45845e2d1cdea49b00915bcdc0e028c41e03a0fdae0fbbc44f20e347e379e8eaa7c398a93840d9312f8b9a270cb2d5dc055b8db431a91970af5d2a5595176d0f0bcb40841871409db0690c848ae115c53d264eb2a081a76ae28d210d23ca40d338d6b16abb4bde2aaa292484fa74bf4f3138c63d49ed7362eb70ce6843a2b4f554d7acef0882e47bb906bf6f3f38bf01cbbb06cd8373155e65da67c8ae1311332063bd60701aea8544c7a64ec13ca04090207ad39dfefcb661827e1ac5bb7e11a410e5c24fe34564852a978d6242134dfc28ceabb57d04d43c869d517afa9195d82a69581e3ef1f57d2715149dbd2b2c6b7d513ecf9747d52c1334373370fd332d15563a496470bc03e98586cdad40fa7d21bc197c0e762758f3368c9c6f9c30876954e7d859a4c31d2740e15ef392e64bb83d7b1d6e0a8329bcbe935dffc0127624e0ce1bdd5a41390454f74fc52bfe122a1d623e1d4750b01786c568d68034f9d91d3a11e1b3305aed838db39f7f332244727d576a2514e7e76fbe49e831ce40f3e63ea2cc995654496b12c142720ae619c04a12c2535b1aa027effe1cbb18093e01f052c7dfd91d7cf7e79edf38bdffda177854b08f971aee6b03a2244d3942980b1fd34eb428764043e4a950909be4097d35234efd245e0a5e6fae2eb0b0345909e60de5f942c0d5ce85444bd8aa7751ccf3349be570e986883f2c0c3a9a564fdb5068d11b352362c37947e7a46e8df483b09e2f62e8619768a96b7ecfe62c9cb8dab5f4363b88a33ca66fac3af74686e20972da79cd1516a3a632d3e5ee215454b25bf2aaf9a787dce79d37079bcb5dbbce3b1059d31cf914e5e93344764d660b108af4402dec6dfd9922591dbde81868f4040494d1078d6c68aa6e50e6ce0866630a7ca92e2321661f436e534f27760b5adc43313bde67007b8470170b8af3629c11de07aec262bad3dd06c8d70e1c0693ccb3c3babc14fa3365b949763417c0da73b628e4f2bf77c0ac04fcfb0f5cb14b4fb912842e82ed2e27e5493e0ef9ba9ea023b5598a5df25a43156a0c2eb40f6f9cebca53087ad4d111cd876f0b17df0e9130f6660bb0ca2b5028e48491b1b83403bfb3fb2aab4782f28bc1ba902aa08215f14b8cc23b664ec856df58419ef258f504002b7ad4f18b1991509275598591b2615076f824c730fc21f7f47dc7fcf8ed4347b1e702af29cc8de4d8fb67527e36bf90a6c938d1bdd4f224d32d5241c5c7f0f50e20ca00ad9a9a569634d49e80611603730e13fb386436d346279d769d2b6e345cc1a836a6be938066162f0288ca692600bca83da7c12f5d73ce37517bb06dbef492f84b1683349bb628d0a93c4b3bb01835a5a1da0e78db5869994c03cf0cfe89079cacbebb3816d44b041930949227aeb8c9ccf906f39f2d1c2320d87c7322baf4c9e253742117d0cfc54b314f4a812936c3d8bdb9bac39ba45145fd5dc2415caaba9ce014c6b355572c5fe8ed04e49394fdafa10017741054cf8d1b287e847635a881abc25aedb57d66a09db5a09e4b652215fe2230421e6b7e54737f3cfde905cd26b0949b21f26c7a553b3475346e4678856b85f13f80034a33cfea0c74e7a0027a7b6fcc9a307f41f9d073c9940e91e41f10ca743b802e925631ec8b45c95e0b04ab30429a7086b1f1474dbfe9b5f23d40cbd538fb9c86ba61b732217e58acf890d5486869151040a53c181096119fe905f59040583b72fc38d53c80959942b61174d5109a0d649ff26135d932b3205393ccd0fe180c2f0cd567a5985c161ef271f8d0754b97c97c46ccbf3f4036c7ac15227bc24908f534376b09ea6e17628352edc70a29ea9a307c4248dd6181db329d9c5ada4c2264eeb407f8bb1a1fdbf146adb326ab7918bd19e1175af63c866256661e3cf990c88766a1cad4ed1c4c20045c9178b541b6efcf9a4630636f045d7db4020a2ff3c6b198561ec95e1d1bd2313345130c353a50f08b8b48a737dcb97859df7dc333663fd350466d06431da44217495c43b9192f73bc1edf7e524a08f6da63937fd3e9e5e4a789d885c0be5f73aa234662383fdf0257b7eae10eafd7e3e6d84afcb8348c8a77d02c88f838fab8225febc0d57c29bf93c95d017bf45b3db8b832004904d736db37ab50afd0149fdf3012ca1c0180883f5adfef93d2c25bcd9386c97287f21089223ddc1150c220035f513c24f27139ca089b5e6aa4b138d7ef013d486ffbfb351c0ddc57cd92e9af074c8df76c2201232a5daf2b511a048bc2ea2cd39ec6bed9f4e45634ee3e6811c27f76c239f3595f75a8b504fb671a6708c2823ec1eccadcc82aff312578364d618584308025cca0ce3b5cbebc7459bb7bec4019809c3b0576f1a20ded28aa67fdbb80cbc45f81bb95c86f11f4fa3ac728374ac9ea16dd2437dfb7ac1854ae51bdde38c43a75000fe9b0bdf1a5ab89e0616f29d501e8991e631568656f5dc366836f39cade2d4601af6d0422b3b045b6078c735b7d55a13504c954114911617169da98faaa8a7c7b69f0f51fa0f7c305ccbf92a2036e75a3d48177f6f3a551f8016a75b82548ae4541dde754bdb393aadf269ad16ac820970db78359c3a20ef28f800e0a5902662cffb8e54fbef860dfc56ffeb6f16cf814aeb234936826f01657f6bb70606625304ce16a2e61341686071bc7bd35c98b3cd925c2ebb4e267890b70723d47687164ad036269ce27041a62a9e4a090dbc2b355e540c756347a1b88f2f893b7833f1d753fe6c83b43aebdf73596fbf0330c67e27722bb6f5cdb98da1384e22a6a24ef00c002c7f5301fe8328338356d8fde7afc04055732dfa28cc700c503dcf7742f65704d3501dc2fff7dd52fbeae28bc5a66cd36a05d6bc73dbd132297fb83d13aacb6b3c26277ace530aeaeda9a289d94f7719eda032a3c5dde33d35f8d9482091407fb6b6750d8f4164ce6d5bd9484c10b8c01e5ef03ab9bdd536711af2814d0e16308e74db6c6483b7c40eb816e875881dd8da250e08b7b732dd58b6611c54808d911b5c89e5a48e44f947e3a4829524e28335c8379ff11583fd372f29a7e8e3299bcb9e4e82ba0491349e6d187f391b06133b2690ff78db90d34dfeb0083188fe84c1f9691fed1e70e45f05ba9f2982421249c711013267eb52fe06a5a10967b9c728d193d873a2e9aed731dd4494a0b30dfbe9a01a9e3c2d775e23b73a2e41e3bc0cb67a1cdb083ecc359e3ee73f5cb7e16f21a54f36c312ccf76d3622580113e48c7f9a0bb35815c6f0e9360b83f3a616f411a7c89a31b67ad8b01cd1e4634be8fb6d72b745d096dbc75665e2694b7031ca6d8b04811a2a796393436dc67f6d5fa5757e325dd78d1e9670e3c31b0673354badefaf2e25beef8f9722c260c114acb5800acf3b14c524699b894b6da1398cbc812db7de5abf9db99bf4a937459d1525b6b4a570c77875233c309433b496a615c1fd7b9931f519a640ac600aec95891850d876952fa674bf2b29aa44070df6487d848613d17bc6b4e04edb7b0e2a112c2674cd97915153405297e84ceef10e31e485c2e06bb38a8fe5eeb303357664c9c4087c7e996855c97d5d19712fe3ef933f67a9ff96fc26e3e1f87e5db31dac78c7f0460db7b79b7eb2ee65c5c902cd9f24f8a2e0ba11555cce479d4c13be657380b542424813fa4e1367f7fd2e99b175e43f6ee58a7df6c2d5a44d8020a2189cbdecf8a50954d8f60de23eb850dd1d3108bb24ee089aea98ccab8da5fb4c51879309bcc37fa7c322a641a41046dcb534c2806db5a001b719460628dd13aaababbba2caa249c3f683030a14c10d2f91665c2619caf2308ac6a0df26f0ed57aca9fb5bc965cada46816df7a8d7b571db4c61e9044ad4f8ad2034f55b428f851fa134bfd568fabc09d217727f727f3e09ebe731fa987ff8a1c2477323771c779f01ab2e9518a24b3797ba3be2e306011f5249b3da44f53445eec9d4eb5a5771930f5902a8d83c202df67ff86388933f4b182c15b7cd603a3f9fa9ec22e7650f6d51b7314a437519f3d51c1b0a94f4614a519ca883bd998127676238671cfc9410ee81a8708fa377cbca34adcde66325dde287c2af264a73e64bbaa473a1067d3a07788769c6a00baa79343d2e3e37c938e40d24111596911ca8e70cb6fc205e3ba13925e14e66043efd3aba71fcf66687f6adbc896ebe8802b22bbb31b3db9cd7e79894261d56333c382f0ef577a337045612043e3c9b9e81c7fd76a1e03ca06b89bd973bb8f9099e884802e39838737a6de5b5b01a17eaa61b39fab949140ad41f45e9ab430c169ac794799e343803e74fd70192d94794349a73921ab107c3c09618d5ab1f7b4f9187efe48581673b07eeebe75604ce56d87def0d7f931f6a84df771674f74bf58d861ca29098e0eff7a02e66ae3043fe6584ffde2d8c132bd7f31bb79aa7f037bc49fc2d3fc5b82c19c8c24ccbaba4ab4525a8746719f95bf1b7d6ec0103f1908a036ba0f019534ec545b739ccc9182faea6c20f37d8d39d4e41d26cd93e5c2b1a40fbba4ad0a69f061ff826b8b13595e0d2f16ba18880b77dcdf305558fe838925552d8f8ffbe6bb5d90ae49bc2ee27fd84410018d118960fc9ba016fda9ac26ccc7f1cb24c8cb22e5a7b2746e4a5af1344e1ebea68f83c694086a915026f8d0f1e0f04add7efc88b3deae56ab4f8bf3eb7ddffbab177c0f0d898b1f43e105f55395050ea2bd8d06f9341df5a6ee4355739d28ff07936fa1989d5afc395ca1de4387d11b85d91fdc037456ad7e41ededdb3873117d4a347c4e29a3e950ce3f3f0a10c254dc95cc81f3b6929a67503758ca2cf09e1b2656e31f0271139f53a1ba34046b47f2f8cd4432602b0253c0578d7261f136f3ba30e83b4fd86f1ffbcfab4ee8e5ff1bbf5cf7aa9c3bba7bd55adcf06c110a991885118be21cb0b1d7518488ceedf9dcb320a466fb750a9983cf183186f2d64e7fa71a06872d01afce829774901e53498d5da9f0d58a786d38ed0e56e6a5b14478a795c1d93c4e24bd464faa0e3f1994f4fd16dfa5046cac73b5651c4cc5873e66222f99322dfe189fc7b3347bb0ccf6c5660bc09db8b5492d17827427f353f9d5bfebe8af05d3fd3a36cc950c299d666ec74dcd029258a7bc6a1e1c9cfc5a9a40927128d2aff17fae6d742d23fcffe01f8686a28abac8c6d7e8418a45942b284b6fc3f6d70cc6df3eabeb72eda925e4db082782a54f26c3bf8dbb658a85821af179f7c9685d41b0b2fbcfeba2e75c4f85eeed9fdc4317b9e7ac58de3d6e8fdaa100ecceeda685fcafeb4a48967b12d2599c569c0256e85037d9c169c74e017d70ef8a2c90063a0ad579f5e0db118e2070eee603ed4626424d751b0ad2fcfeb8a5e347ef81c298ec291c537afa70ac19e3469ebe0518ad1e1bcb7674fa5befb68c654c74d1983289a7ed2f6f3703c532d4a81e31578dfb896cbf3b4e60f393591c1b04da0456696f5ffe1d5f1b3b9c6bd8591e6e4e56a29fda1cf73d2a7d46eecd99bb7fcbb0edc8face2c360edfff18eaad4f9d988a37286599b9fd7463f86d544019eb0240a7bc809def8791a380d1d2c0a6b25469c856913c90b88f1f04687df0a0529fa61cf3432c57fef58f7fd1cf021c8871be431be91f28ca99017bbb599da4e0ae79bc6e26da8af8cb230e13b534c2b1d0a08bf4d5b32b35d6d0c92c49f06b448939b8b9ed6495caa0e9faf492320d9167865d356305c80a67d70305d262c986a74d086dc0cb4cd0af4a61e15b5b4c9fa9e98f8ef8aaf43f3fb690b779cd29af487db001127750cc3b0e79743c9f43e5c249663b17feb1f3f239a03e4f77a3af4eb146dbc03a5804a817547fbaf97a16546e8c2fb87b3f1f580a7742ebf0200e172ff46a77f4030a89a7dd2c8e06cbb9fd49e72446a4758fcfe977b22ce6230523b3cbad875b97014bdb6b8551c9fcb3a2b0b14e9df83d897075753c171d75ffceb37728b2ca4cd9ba8f00adba11f3c87f46ab3342f4d41bb9d1465860277146d07d0a513d518fb90a967ed32bf47f6a84aa72f55bf3f88bada146e94112236ba6eb1d996549e76fdb4442fc78c4c40dd17d00c00d75983cc6c483ce8972a8236954737d65df73091fa850b7a81952236c0ea167412d86789224ef112b52cb10aab9fc0c7ba306892b44a80a0d653a6df29d466014ff931973994bbf3444dd920cb5ea3b55f53d7dc5356eefa5e6447e0b59728efcd971a2da4c9d63f79449bdad45cbb96e746d7bd717fafe1769d53dfc18bdee2924a2a17d745bd04217ea44e31e601d9d3e3fb93e7732b9cfa8a5102c23fd9d45c93a6c9b7a2f50ea701be09e21ac449277b3e1e62282677bfba850720de3b8a2c028a83417cd386051bc0d07e599f4a004a4d637a4635827c00a9f9e3ed1f830bd7ae2f7c3a70cd7a37d3ba9a5176072c3fba53ff6a134bc9e66b04a280e821ebd181e884acba6a51710a4db7b3a602337857a2fc124c1ad7654fb7fb1f3c204fa72bfb79bbb0b1660d7a8af5fe193d666da27a341f5d4873e9b3fe0fdf7d8c36f75396d5dba8403444551c0fd485f1eeaf0241acd380974ca6ba4e2affaedfd8a255805d65ec6c18866da3b666da7a434b32eeec6fb3d9638570b09f078ca941299da1caf8e2986af30be0ff74fdacc9691488a0c1158fdcd800e07952fe80c72ccb8487e8365f9903364f3d2d27074ebe046715e6cd04f498d21d6557f584dd6a7014db63e513cb6370a9f1cfe407b24d8e079abef20991b5d23702e8f0cff7d279cbd6ba6cd3948a72929128e3430f252a55073883d6a4d2d13a652604da926763f8ad01e403faffbc33e3c5db490c60c3e508b6c870d9893688f1d3b81ab46cc6207ec412bed618cd7d1768e0544c48ada0f4b6a9ed96ad495bce69db27578ec2348e2828038e95595359d0040cfbbafd6d4620683b41a84db63e9caee4e2e876cdfe6406e540fd95fcf0be44f90b35a521993da2c6593f6588a837cc02edde8ca4f83afcd9d4f39500f54aa1fdc29666dd5ceb78d4caa11f72632039ba784413c8cd5b3df1230b20be751daa2158dfe7ab05ef2b96b108f643043bb76107e82e316c4987399252f641c7101fc4da1aa8d977ba6e9c93afa99a4018d460fb9dabc4c656352902b7a7b15df47414f2488fa24ac2802e5074c99c065c45d1b69d2e24fbdc69fcede3873af3a6979e58016b885d674266ec8bc6f5c4f9c65f6efdb6b426236129a99646ab71cc666c001b0e14c68329770c56813d7a75fe92417c091fdee46c788530823dabf3321a388df03e6b68c0b04782de1710ea117464a42cbc21af8bfcc9811ce7da01628615052d7ed3ec04b09311a614fa207e546c11a6ae6ab4371a50ab28c46ab9261f6f42d765976fdc2adcb562d736405a3213bf82e81d568df7d94ae431378f1fb034ead1ed46600674440332eb5bbf21e398f668987d2febfe252de041016c5762fb556b46b6605b8f99059851aab0d51194e98d8c96dec118bd857e305150c0d2a266f0d908e59249d26d4ccd9cdc8f29c0e8ab58379e73a4f3a4230f51f10cdeda82f8fd814ba18d81aaecea5da7e2e2ec48e1476cf878b71a5f9fc25cd89c0c3e78835f3daaf4d63c6c4f56b5742d9d3f7381ea62c1e2fc82d75757c0d6a2a0bab4117c774f520ac085444a107e9676616fff6418cb21a9dc24ab32b87d9275f8d89ce3f6ca0df2e1f38be5e213b4b274b3c1a4fb08b6132a1ae2a277a1652d141f14dcb5db0a7ef33fae791bed81ee1ff3308ba782dad4f17c6b96dbc84dc8cf1bb53b54132477d00f19045f297d51ad5fed5d3010be7d536006925fda1e8235bd3421b42a6538f719fc28da0cd325900b248dff156f138923ddbc4eb4a930bfea837500ceb673bf2da3ab4cd8640d7ec5c8a8e08fc67897ffd00be80c56f37ed4bec4dceb45200ffe91418a7ee51ee831fa6073c0ce99086db7c2a9903fecfb322bef3663fe4ca084c7cdd950d03a7f241f78846e60c5773a38b582130f18f2407cbdbbd6c43ca8d38462486f2c1799a0b301810fe6323b22a48b0af4700b33b206520190c78da102b89a18f37369f7aa233e6844287682e9f53d3a01eea95f0867270960ebd6c53aea03627a81003ad84c0e73f3171ba5a2134f6e99fc2f162c41c9c56049fcdec1715e6e924331910b877cc6ce5e954c2cdcaa2f54cf0e6718a66184517042ff1eaf225847eb1fdf03120faa133a2fbfe9d22266d3e9ad6e89a188c580b268ed6d54a13ec9c9791ba907d9a44af2f012c7ec1d72e7b07ed155c840950b0572fe1a33c11ad40c0b5884c250486669d16a3a3704679889581456b6b1889f8d512329e0673e5134bb71a373dbfba20d8591403d1eebf4f69d1f7f5cc6a5d5789176f7ab73c8e6dc8c86c4364d8bb10b2dd7b460aab9a34e9782b410357adf7de4d581a20830f12f443158e41eb962ec1284ab9d5d0919a3a18f8a344f9abf0023d2f5a6c01cfd01c46c1d79b56cc8871ec8965cab0295614285e6242f25d807907d27a525ab80a8524e39c7597359aefd1dfd8fc77d7b02b53c187c1b1177a5375e8ff62e92dd081bc97a4f723dce1387f4d6be45ee80153d880a8a901c77af14d5c20b094c261e9e8771c31cb4156774431df03b42de0d8bb9b99f86d67100bb920df02a7b3edb4e56ae15a2631f9ee6d3db112e842cae7923762c7b932dc69e1964ffd42ff8c048fa1c097879bcc1aeb95dd2c8114bd3ceeb2211f10ad89f96f09eb6fe43fb0d066b36ff09d45ccf140adac4f5856ec4e788dd2639587d265bb7856d821fc5efee4fcc0ab307e2d082864118e6a41020761bdf473be52d439ae2e919d029f03e7233613330e1caa2377496fb0868ffb06dcc80258e11c516ff1af9212a9c0a0cf873db1fe5abee12444cbff639519d30bc48efa16485782442993be2cdfd3f26de62f95b26481abd2016b82d717767873bfc70b9fa13ffe79d6fe66c21a2a1e9accaca2cbadb5a9eef58f7c872eb7e63fb4a84df83346d99756549e20119e41a8014a183cf7cd40f7e1420a73e0930beea9d41e11709045d161e4131c9aef31481110982cdfc5ac6319155757ddd96833a076ce8784223de7ef1e31d57ac7e3b64be3fb38069f71aa46032335b321c5a0c7a3edd79f73a387aae941a2d51f95e53150f5153f36099349953199f626818f40c0e1da371e397c3aaefc14f3e973bb8ebd9b9d0f487ffd1637d1ee9f1c8e60b5e00fe15426bf8a64b7f62e75f112c226447472c5a9928848a376eb57ad9d003b0e19f9dfac1bd118c2f7babf7868e9a32cd17ba10fb0600a92d59f9afcf85b1046cadc1ed67e6cae0216aef06837552f9bc95e4956c804bd04bcc67f1b138934462c2347d0bb4e7047c815a2b815a18b01ef41b5d27ac618f1219aa62b64f5fba4fd411e71b04712741300943601ca866adf293f982a2acf63b237cd423a9f808aab4666fc28b2382b318a10111182e77d8b4bb36cf6a5869352f29b60eca274219d0576aa03a79b4a4ec8b84d723cad33daa2fef171c17febb6d1baf5e787171f2b42a194b15c018d3f9fa95b5b93b6870c67a8f40b939bd3916e0e20b7290065e883820dce652c883d726ccafffd3b301f6695c9d0a000a38a3b2688223d6572eb96519ffca896a0c12ceec7314cf901a57e3ad3d5a7151d7c8c7a5cb2d78d5e05c4c11c40acc831799dc949c54a7517b60af2f66e00cc66b56367af7e8a89144af64f0c1149444b10e636e6658ef3736b5855853d5189e26e365d8c15f3c39cee7616cc430f267e070ca095495c6d96ae5145aa2b0e6b331c8e9da5a24658a465667d547d427f9424822a81464873b39396298e383c6d7c63fe358ce9aeb31013d4d0fede90fafa8f85bb7928a7b068e8af3e8b777ba8b5217a2376159346fef0fac3a8ba6305cf39ea723e979a04db7c59831645c1b31763f66603e50c9f2534d23bf6af73d66f8fa012ec6e5a6bdc03640fd98fd7efa85a1393a3fa66720e8ec302b44117f3940c57b4d6337d7058ef646355526d2e6daa0d7ef9b491bb2912ee3e70c0fbe9635d69fc4e8239464b081ca618a6fe7db8ee53b2f3fbb0427df45d8a08a6c14cb688e77bba9c2a8ac868c321ff1af10d14e18182836074599d6604eac8e5f41a570b1ad8ea8e096074898c76eaa97916113712e174902cb8d10eedb9d422c769b6bc588bcc7aa2bd41d76eb9a8b501cd62b49cf17be69e841c0dcb54b542958db1ad4c450523ab3ad9cc107f9e421f316a31af1a35bee096e59c548e00e83dc4e6876dfed4c7e16d21547659e4a4fd9818d6bf3a65e1aa2b630a57096c2438032ec93080c532791ad9215159b8a41dba8ee4e77355bbf0254152b177e09601205271026bb9d772ace7ce310cbbc7e6df5fd462c390fba030940876dc5968570fde3c967ed34fe4298b18749d2f91c8a210d049fd4418c34c86cb40b0846e6ed53396769fe394a8262b3a7e756841fb392f3171bd87fecc8c7277c807ef7cb3d51bac9e9f701146db788cfac0d0901b41fb26f079edcc4cced069484c7777afdf2e0b4b822f51be341de9ef28fb70777c09cfa9d7b1321130bcf60b00280de04395d9e4e4015f014200026714f9a5a8d7effc38d4216366063793081b4b08c43bb9aef623865d94593937651eea9da22b85cd68207e305c6220ebacde4bf702451f349dd9b537d02820e6189da0aa46933764a712bc8039d4c3f70c60f1660976d6a090af30a2b670e81ae601f85b99774d30f5ae9771289cb970f2e9c450d9bddcb0863f4d47ee0dc19e2028cbe3f58d50d4d82457acc6671a71eb81f8099b2ac9d551620c54884d69b178d02e4b9dabc1b21311fa224f557c1573d189cf51b13ca451e17ab9e35a260f2dcf65c055e6540654005f532fdadbd658a33d8df2eed822424da7e785dcf388c18f4f029102ef28330a52cd8c4532b383049ffe2fcba3e311ca1f84a62c223e40b5126de266ff9f32eab31f5949decd8eebc0a11d71048c7130cb102a0593f270112986cbad745b81e49f0d7c9371ad8e75902394b963df15efc29b7a2f9e2d9eef8b23c4d71b9b402b79aa8ed3c5f894a80dd0aa65bc75062059a36290a7d601f2215ae3910d9a93938ca3a8ab7662d49b7a939a2af1f5882bcc4af13f5c440011433a3b2df7b47c4a4c79d4a37bf3c2db5cc739c01b0445bc33fff4562319f897fe13e044686ad89faf1de76c56c3e9eb1b83286f038e4a0e505c829c2de3b81a1dd0a2400fd3b074fa6689120a2e79a45e2eafade3e282bcb466abb047ab6e84aa72f44ea921cb017a21089436bb382ebdcb187f7cdb8e5c88ce48becac5decbeeb967ce8f11a1eef8013a18cdba0bbbae77be851d759081e615b0f472cfe1467b36d38719835d62d2f5bae7202b9605bc59f82c32485ff3a362a6c6803486722bab0594c46f8853425d717408d14c388603250dec0a388cf9a379d9fec85e38e8d3daa5bbf6f49acc7f28d41d35aeddcdbe1000dd78d0606015e99d249a02fb649ae426861a724e821661a8ec0f110b07491236943f955f55d50056f5c6fa000ad1310f28e64bff942ce528f5ba05c8187bcd71838ef3e01a28df7391f4613160d7daf9231ad70c54685865b8c365d94d6c1afebf5a6b6e862225933e087c20066ca56c6a5557a22fbe013b1d7a3418ce4022bf5364a734409d5e5f52f71053161b830629c2f5f6b812b0c8e8ba42b61c70f251b887d356e8c391cf28a3dd2f2f1fd1e73f65e9f6f8b4b46a06e213fbc487a099b3843f1073c0a35791e703670dd3b6f7794d965e7fdaabb30889ac30a2e2c030e765757509b44864c3d838f5420bc3940a7e9db512a55015d931863e21e3f45d684353065e68d1bd172c1ffaead1397c2aeb4dbfcfad0af24b21818c750931b2ab361ec4356f3ec1ad6a75426588ec13df556bf93aebfdf0fa701f92c29c802f16375c4fe982984de449fb886d62dab4ad7c8133dcd9deed3f491015246edc1aa1b7885f310763ef4d0460fa187de8ec2ea81dcdc6d32e9f8255fd80299ecd3f53e2483b5bff11ba6c7f4a624bdd7c3ed16a77cbe3816c4f1dcbdd335895fc93b90918d1e5e9e85a8b36a336175f13164648b259929ab04e6c704a1a16f57fb14eb73c33b0180c3ced2c00e64f2664da766fefbb7afcb4279c3f1069fe57b12009b2df2581d31d1c19bb891b73985d8c62b60258e145ac7b703c8ffcb976b458f3e03f20b866b52c435da6cfa36ca48822d5c9d9815e3c3fbe00efc4866ef15c05cb66914936dbde6c7f60772fae54868f494d5f7c392628ac014ea7cf605bfe85332c377a72d65cb1d94c9be302ba91004b1bf78897f05484dc194d6083e8540c3f228932d927269a05fdf90e13bd0210a0a14c0c24d1bf4787fdb31a179b8d5d908e5b1fc251fe6a3574bdd30a5c648889c4a6678ef66e2c474aa12fc02ae2725b26f9c574f0c5212fec62f0091235fd4c52997360554561fe0f4617e3bd94f0f51e1dde0f69d920790627ebfecc38b92e982ac5bc13bf1fcce578a6300145e7886a804964bc4a2daee05d37e09c45a9bdc7ef1bd6439b24f57d7508d113941c87951f013149a9c58a27399622b5697840074054bd7f63f67bf2e9d2b8d322db50d113a6d3d3d5718bb635233b151a01c86093aa9c3feb002f657d67823d40665cb13e9cef08be4074adca83e9da603d3c1f9a39fc4cd23ce280354c636d0fd9f5e3417cc50d7f7bc6642f06d51a9c62217ea0b83af4c1353ca3b6564426ca28eb2729522d42217b8aeb01c224307c8fa1b649f999edb5f3c750f45a35fa8760ceec6f227150a0cb9c3978735a3be8d793aaf6dbe01c658321d26792c064a3c75cf3cd635372e3536e18e257ad544def221b8bbd903cd7f701b85e91578d05dc1a7f908a6008b60da0b64422e615e224419fbf931a131e7498471ac52816315a55a449810ed9249a6abbaa8dce999a2f80b92a707720fd765f35cb9d07c06113d7103fa169e28fa1353d32e78897b0a5baa578b4f93f108f33cf8b576dcb7ad5b21397d22a4e3b42e43b979d1d2fdd213f7d825298de748bae69f09387ca029db84e6c7883c6574d019c1be59ecd3ffce4969ee2de29d99fd5431610da1c316e20aa0b95b55a683fd481c61178e9889b313e9078c03b49044ab0f7c960418be169ec89206cb88481caadef19d7498e9c0ae21e4bff35c86f1580ae0501916aba2bba876d624a57cb507c6fd66568bfe4a4f3c5e666226192b5e4b306191cdfc7ec8a1f9de0cf8e875c95d2c872548d6575d85657c4d2921c7bf64ef1dc4730b56b933c0f52aed49c3a6f03e4279c8ad15768eb3d424185e366ad4e31be6c089629eb3d29714f82956904f49f68ef748ed1e1cc07b3d15e0b9a3790b7feaa75b7966ed568d2bba6975892f648141b2b48975fb3d60889671097ba859ca68046dda7c92bf152457e2bc2ab4de401af4d0c81e582ec8f937dbcb08c4d7ba6604a690e68ad221eb8ea2876ee48e675bc56aedc306b7838f62db8c3561850a125b8be037734aa3e3be36219706cecdebc1630d941fe2ebf321a58b0bbe4132ee91af5bcf9928b5b209c9b289d85755e8cc6db61df9707156503ab532a8ba9d07aae37672f2c425ce469b138efd12e6713ca155bca29f1acee194a36c87fc935e813bccd616482a3bb202ad2af133f4bdfcd3b14acca43ea0b379119968127b6b09e12b9bb393023052e1ab0c143d870ce7982101ee17635995c33365185963aa13bd26a1f376c887105222d72a8b4e4f09f25c43548fa93bee9ce9f29cb9a155ae9df5c4fbddde83ca39831ac58e33dd1e83a77235394c9cbc018254d723e01f36a0303da2a61217913916cc147932308954bc771123117b6ec18c63df5081493fd6d5319307ef8e8f00bf81b7e46c866d796a10f1073e14fadf50e209cb8ec4b50a7ccca394d492d8935d7360a247e8a06ea47f984f1b11dcb1e1b9abf8e0d747dbe07799801704f20c0862d8744ca5d2369dd6818006a3e440cdb138482dc32c2ae7ccbfb84e7847f8b986d696f3a82179af2d6bd01c88519f2e06ca1fd9f8f5eb44cef7a6977cf3c4a33fa9ae2fb41160f4603ed85884fdd030b87fc4562b893aeefcc4488bcf237a014660a49cc78a5cfed2dd90168ce9ebb5ed28ff508b969885560c14f2745fc4cf848fce0a2c91eb4bccfdb55aa35f309a2bb53cd4311a07ffa1c774f826befae0ab2446681331492f4c1be19e2e5b89b58ecade6cc017411bd839d0df322954e25060e16a42ed30b7b0d06decdf9a9ef544f3ee4aef5b7b07158fd001bbf8ca7b72536091eb3146d22841673e2f9014439d91e24354ac5be02ba992c66cde4d1949a4270663704c5d97271aff5e02ff7b8e6e743e950638a7743bafe5043658b24d2c9edbb7b1adef12b8cd1ffdec87829ce334646ef0e929d2ccbfbd10fc299d876d3e004de7282a3686714014dc2c9b293c0ed95416874357f98d8a34b96935f0932bb8440cc7b12e48b5fc45bcf7a5407344603f73fb4ac714322118cf30fd540c7854e8ef63fea4998bae865ce933f9f0a806df105b93ea2fdc0493b1681ac3af661267830071a47676c6620fa2387cfb885fca997bd212e6775e95c3d5c1944c77f6e629fab5e4a93f004777a95a01b10b94f9073f2d10d0f0e2e698c50db6ffbd6fe8d8558145d4e620065e27c0e54e6a3fdf37e8f052faa396d23b06f67d0416388e82bdf2005bd8015ce30d85eb85cb009b1cbe8adfa67d2710774ea73652d8cbaa9ee625e0bab5b2ae91096121af336af9d0a0074648d72887d0c873bf718fce5ad2b698c311728aaba98d9f7aeb9168d5878ed3bdb90a2c56a9ccca2c641b3a803086d3f4c9b120793c9f4b369fe82cdc41ab9a3f01f57d7a7eb723617594fc9cec6ea030921b7bc14d97670e6dc60d297de0424fcdc449fe826cf89965cb7fe013044de660b349b7faa0709e190624d0f83c50d1004e675681b02de37d2d60ed9dda879126df7c729e80da7faa95909ef07d14cb3ed36c843555714a1289ee33ad8a465e9fcf8add7ef66f909be3433906938a51ac0f73497530c028f47bb6cb9960ebaafc44c3490e61c99a551443d16453d93822f91d82df6c5b56f780ccebabe132ad19f9855cafcb6fbab6427f5a7d4bb49e98a4812f888118323a9eea4f27b075aca1dad87352bfec35a362a93cc498f0d18c929a51d0e032c37db910b8ba4e17e53fc01d0ad5fd3ae1e0b2e29d5aad2199b4d7c22d8f09dce869534ee9456a5e9f517709679cc486c50f79f3fe82df7426320b857c4add8c99cd05d56034c63418c40241189fd5b1fe2d14d8bbcc7073939376ce32675f32eefe00bda8ba74054501cc17f0078502b8d183a69c021397c4df073802556ef385e3a3691946c74d4dccb3560ec00d81a43edbcc220c84a6fa984102a8c5f769198789c3da805f415af15502d63aadd5b236d5d6746b08e0f1f7a959a164c0a9e8e96606e617eef1f605601fb3c2601ae86a7d8fc214d1bf6fb2e9d8625766ede8027d4439c55129675fe90dd1d47ebeadbc80a0f4d114c99ab774422750372a62533249b79978d1fdfc5c24cb59c886934d14e83b4a6eb65553b90c0e4953c82ad034351e7a9e630e22741bdb8296e7c884da4b57439287e35040428b3567aadba77d61916110aea7a5d41623feb12038924e1da7fc8404872ec6db7f4d6804ea7b85da961eb7ef4455f395a595b605930d49b3043250d7e3e2a5bd38044e092d8d6f6ad3ef45a0ee854763a91c4260b86650b96a6c091d466b332d390957bafc0d76ce67557ddb4bf0ca5a4774a4c4e624df97040f055ee3a4494d52b79b6d4119a43f8c06c7e627715adade260b475aa222a44a9d315bbe3e2f29ac2376eb8f0bc3708102e527987255d1348ec30583d9b28dd6620adf3d7f708ee1511ac4c5892c8225caa115dd504a1a1a52bb1624aebe398a5e73da65f45e5b6eff483af028948ee45ac245bf78e03dae7012b7f8d432e41938638b2a289850a5cd8786ae58c1e735a336ec2693fd33408b91da7ae05d0ee913e37ea4346fb3392ec496e0d7a134c8f48b8aca1dc16206db678f9ef4633c58839a4cf162566785dd719a13c27591d8c79b0f59e5af70ea21fdba22abb4c0a9363cd898dcec3d16ef61b91c6c44fbca5da6cda0c3889ee494948884291f61269942d19ea2e2e804d4a99e0a79ff05979292d6edcb54f48805e132be6eaab29df6026a60f6af7b0afdfbfac70649dca2331c9b0a20452404535658341c7bd7f94a8968edb382c397aa82a2280d27e99472ace6e43085113b1978cb270fa4646061a7a01d0f26dbe971c066e23c2c3cd7082ee7edd195689f90a283b8570fd4d4926ab36b2926605c7913993585ae35b7ef1126e4e6ce6ebc18d14d3214d8e1fe224aa23f1e635e73f72c0788172292dcc43352cf6a3b562450baa115e5662ff702637a4f98db326a1627ae39203123e2f27056525f535457e951b4dff7f8ba319022d8be1dcd79392c20185b0e52adc2947cd6e87b143e491503b7bec653e731f088d3348d0453e8b7355d4d62d7341d0b7d4526e915d5bb0fafd079299b326046c513e2c76977ef78ef05f68db94db59312c5047a08fcec8dac137aaba73ca2c98419ac0785bf023928d23b7e9ff1c759c7f4d9d2339d46ecbddb036ab5857efad7f2be68384110baa6e066a27e5fb38a9fd9759a19bc6eae0145b9691e5ed1afe2997eeafe6a74a49f48f5ea1a0ef98e5dd765b5131c984ecf314e6ef798bdfa23d97b757ec27002ba3354e0ca3a1f458b9783595090d400285dc8457ee98e09c8f87b3fbfcf65630350be3670055a03d6a8afd8fd41986417b8251419d859ea6c32ca9d321671924b0269a1c0340a83dea244f82cd08e375291930cfb811107c53e388c62dd5d904ebc52e69c41401bbf736e37359c42121dcc27d48299b2083f6df208a7a1a48a9bc88386ef81f129bbde0744aa349c0853305514426b1d7efdc3ad80eb7f62e84aaa1bc943e9fee9d5e540c8ac81cfa16619962e1c98a68a63a30e41a38a96f3b529e9daf82771a481cf625b9ef2d477902a24212ff837c6c43e18e7d5b9931ddd60f291486c2f34fe191eddc39671aa2bf7d7308cd59468ee0e94b380902dcb1ba1419269bd3d07c83b59377c72d448e47f6c573d73c2311d907b0bade5cfdcf3a622d8e98ede0ae7bbfcb270ba787b872b28fea3533ac67966e358114d6227d184f347f53bc026b2248519c9070c3b59581978aa3cdbe90b1a0ed3ae2bf9d8d6b753cd2584c22032017f53b678d10889731c82d470b8ae5f371543f2e497da3db06870df167f3d584ea2c93a268efebe367023ca502508bf945b475cc63d650b189fedd7549d4effb0e5560d2c6d497c74b7071ac5598617eef05b567503d1fa39ccc918f8797d8c54376bc3fa6032d5a47d53e99a4ddd9eb067f04ef879ad7bbc0dd201e6de71491b46c0e54aa1e3d03085f4bec05dc842404d4b10f485ae51d6ea05bb04e550dc75784f12c2f3f3da388c472085671d091c12bf1b8421207ac93631e0f722c9c59c19b1629e3fd28566d9e7a4b80cc4b0c105c3648c456fafaec192af0107cc189ace6982be0773b70ec87240aa0664e8456b4949d7d4301e82779d4274ceb96b19eb22c78516c302301d6b93b7a46faf6beb4ba73c45e4866d411d2aed9b2f4aa030414f5b7bc758d0512243a5213bbbeb8b37ed2824c705cc551b3ba92df98322042a1586e700b6bc40db03acbb61be731b6de49e71d20c9789790a087941fedd7bd5afd1ea0b019b26689c6223a95989597f507d18772757d2b81fdf4d0363e73a32947c5987d1fd202cbf91d4e2894031884dcdb005c011d2491f2448071efbcf669554b33aa3a36b568e9e32994035c6c4e18f12c71f645002455b27cf05ce89240aa371f5dce4ca99cd9ad2a88658f7fffc04e4b3a545eb2c803f3ea275271d86b4947d003665b76a64713b4f3594629870854beee6956f87fa9142988e65755563c5e568dff5fa6685d0b465ec01dcbb7c8b3f4b0e718aba891c9d778e395dec6784efd45f2513468fd1b44a9ba7e398b1e28ef909612966ecd90116022e382cc23a6a44c29ab0b7e960bfb1168b4818da6a0c8bcae1e9bd8d4bf6d8ea9e1033144718874528a52364883fda112b55e5050fc0ba7e3c17c90e7f53c72915f5723424bb02d6847ab36f39f6cf107763c67f0c3060e1fa1a1023d1c0571707c09c485cc53688bb9a90d48fae83c468dae3d586845dbf933ac3f902d76c59dcf677cf4f83ed35ba7bfbbe2e3673fe3a764a7e8c1ce09c94f4bc3e7ccb9b6a15dbe0273ce353d62d375559b1b75caba27674c9cc4fcaea7483d94dd7b5158c65e7f895b25e71d1a022f8c8c006687723b42a0467e0168eb3563636b02014ebaf0dc441c235578cd8df0c8c258280556a38c4204b085330ae55480ad8c35f713d397cc5987de7402f80228352cf43313f4379ea30e080d4d352af9e6fb7cf6bd3844388a22840ff6dbf9c6b6d192daf3724f5917c11c8c8798a016d0d09fd96065d6e10b6175fdac09d5840c3ae3b076671a53efed5dcd8b55ccb9d59784c24b3e8e4ba2c4c3874719aa6cab0c95b145795072c8a0a24bfea449c82a19e704bb4c48490eb738b93097be2f1c664c74f32046c0094504a76e4f0e7a32970f5fb7f9e88a97f2426c6601330036f6b1f4853f9349520eceda0fd92bc4783bb442ca42c54c5b38af80800910455109350c14557166c440f03e8dac670b3fce868a221b6150502b98f31e33106f9c0f984a6c7a03eb3995b73bfc201b7a48c8cfe9a7cb3113c1121d497c401980cf9f8691bdb4f58c6d236734b562dee63e60983b2447c0d52a47a40d8a058528961b503f50c6fd7403c6fb8ea51a9a569bf5e65e79ac61d285fb1c213d4afbdd7d78f5245d0d41b1e6f611df9d36cbe61f43bfa88124157889961cf55de9fcb8b6c9b1245d5b79676e10dce3ee778376cb2ea0f4a57da5f9fa84dde5bfcde9e240cf127a924c827d14a62f7926c3e18ded21d75a623ef5b646a6eeb3721619bd42f2dc7b4e2197dd77a5e78a6efe334a910362d53fa0d2b8eb2e633e98036faeaabda9806361109b5188612742289cb64e9fd98c9b57ee8e4b45437346e6e6282b722defbb34dea8de532127bb1f7662dc239840ff1ae3cf94c170b42698823628443e8907377a462babd29c88fa0430d362c8d2ceacbfc374ed08961a33032305f5600e1d76bfe03d2e90e7b5e5b222d3cb6f52c3f83817803367d35a1f03df4326b723e8a644627f58a9a2ebceac3ebfa2024e0d5144dff8c546280940e61fff48d280623c326bc793cd555e1a6627dea303eaa2c5cbc1d0b8050dbb85bb8900ddf115dddef08d4f132b25dad57010a9df5fa019a38ae4b6ac26eb3c130ba42edcbaeb26da08138582669b24b6b4a921a01e5f8dea50788b609b7eba0238c9fcd1b1541fdb67e6013c022c96d1bd11222eab60573aaa885567b2b4da2aef7958d96bdbceb6618b1146e5c41b66ed0b1a1f0af6bd18c422897c24b7790f698acbedb639a825eff0a924088872ab261a318850bcce6a663b22a60de8796ab531f49abd2680a356dd5224e6195456bf157d4497bef21b7f36a9f3cce64b847d044c1a6f0a2d1ee589485d6680ec9c71ca266a70f44cbf69798856ce386a489bc3e389aee4b6bc8093dc1a7e718e03e285b689a86896fb300bf616671ae7b6a3e88efe7e187a78caccefb811d27050213e441a03c0fa00b01ca8dab3114976574ddffce2fe01781613886f102970d35791b449d3a714868ddc09dcc17e0a73ab2609a9a6dfb010080284308ed5b5442e9eb69314fd02565b0225d52217f4845971a87e2b9336150c6986ef09e532a253bf9ab4d9d6bebd1ef085f38897c5366c5702a915f8bdf5f6239d68bd9cc92e7e7af18004ff84d3892e4dd8f6c68a176a96dfdb5eb04a7af55bd3b738e07f67cfffedd8c2dc863bd15ffb5a1246f55494ed56f889acc15142c6984e8d1a73a599a9b177e9381a4d77c4c82c3f3c5bee5efa9c5ac89044fe594e97d8c534c286ba3b9773fa07aad97a7d884bcf66b0a1675ea601827ea78d85f061cdc6a895476523daa2c7afb23f895c28115c53e78436f8ca974f94ae9ce96d1fa002499ba5c049441d28f3e82e1cb0ae41dfebe4203bcebb2029c19a02bed3afb55fa1bd399fdea31b83437b7a9683f862c21a2fcffe116e098ce100e8e1873fe6306b7c9b5c9208e436845b7913732879909af07ef1bd8fb3d52c165a25f287bd72e55f404febc24538db681d3c82e47a9ffbd529845582d025be11724f32e6d92abe33473c6745e193206ea99883a30f6a9ba48bab10b09f19ccf1e1c3460720e27cc1651e642f75c66f1161ea71ba9f06130fb1b11556f68ce86eb59d4a9b41cc0eecb85703260ff119068c724c69b9f766ae2f2b8d0dd679e866fdaf2884c1f55ca6d06cf0b502e7426536cf551582fa89f69097b5e23a080ded36e90f04c96d767221fc0030448f63054f7e86949669f6c2784e6c00651858e55df050c2ae73cc9591a1087b0c2adae87618c9387039ca8eeb2216dca6d6984cd7f5bfc8bbb5c9582c20f15222eaa2c78e8f057883f55651531b95f695eaab6c2f6e93686604c96fa691b484fd44557f04567886350e09f18cd79e9449e48c4a69f885c39e01a7a1356cf8b4b7758ad11815ab252b58e3ca91ee3c1edb2aee5296bfb6e1941b4bd87e9e9cf9a38b2172be502899997a388611e6cded91fe8fc32e9eaddf3c58031a5407d5b8b2b6bf122522ba3d049cac6aaef0064c263f75e0881ba85492ab356043c88897fb50c4eb833aace1bf39f05a8fa9e1a54c97b9c852bcdfb929838100265c6862c1f39adf4a85b8e078d2b17b7837b1850610d898c510a0d8368c5c0752e2f9b7f952a734ce466da67d88aa0336178e42831e02044a240f04e69f20669703f2b13b92233c5e045eb4f0036fd69bac2fe19eafd6912a22de25d3261aaaa13008fcab43e0a1dc88dedd04e7c99375cb624487d2588d612beb861f10991c94832f59794f468b2899706a9babca15284e30756df6dcf88b2b86ff0202cfb7586d4163b1c8295151404d8cc8ee41c133572554f334c02e973145d0663fa185a2529e191f75a36b99e1724dd55441c8da385f55e6d5957e788a836c11d916b3502450cddb4a1c3c57e3375455f9887d2481d312b0b04d0c421b22bdff2b38a3450be7d29f074130ffb3dfe329a7929f0d703cc6f7193fb0b995e17dcc5de7d4ca18b8aa82eb24e77145218476354863539ea922efbfa05890eb7f7d5a79cc991545abe208ca0db66e12833361f07e9b461cadb867c2b70b0a86c8ff376369c655f64ddf2cea045fa02ed2b81144d90a16816fe18fc65b517c95471f08c096061b8b39d252d4dd818f8ecfa05fdda288c92714c29ab666c4b5eb772dd30fe6caffebc5e346577c0898b0cb77901dc0f8a2dd818b2dfea26169f4e9cb194c26f25bd821e14f76bd721dc8cefec227656c2e1e34f6996c498aeded596b14f59bfd322e399ec10b44938fcaef35582cfd6a627b9c66172adf2cf784d9a6138f7c0196a82c2d6e995c9aad0fc8a606856fb02a4b8767ef02dbd9f7f1af5a2ce93f4cb2bd97dd41b12728ebce7063fcdb23e4e44237aaf9319c094c41d6d830f32673ab40707b0e378500cfc4948d54522559708c809ad9223a6a92112b656d816097886caabaffee5cc90fb45dfe137900b0b3d85e85b1a5e76549d46e84cc3528f4f102661f5c33fc70eb35fa0ca9e7efc52f851d9aac7e35cf2d73abc4d671a2b50f5acd5cdb88590b122148406914390b8ef51dd138f53d0374c7dd60a0c891e0812393faca96fc251e2ce0a1e8796fb399bacf6db059d5ee2b05f1dd4be3a9058c10b47c73e53a418ef398c39e35dd2dfd2e7a5b2d794393f1194bcf14e186f4906af67a337804272cbf0060ba0e8031dd25f9f5889b4f58968f77098c5156891257d29c3c21c73104dadfb4b8c134108fce4f76eb031003c00c1e49c41cb84361f720b0c21ac18ee02cbe192bedae27521d695b97fb82b221a9226beece0ebf173d107cc55babba801252b711c37b9b6fd2c34bc99766fb8f1c9b37d4810058381cac91f53923906c29e08b5998fee474b001b808e21168d195bcc3469216a06ec3008efab38322b0cc59b756dee06e8a6b6702b865bd2d4b75630599b4d56095393b744e016ba12d0c96b82f093b9609d584bf0c9ad0b52de70297d89e1d8ccd64dcb5d238af28044e1db1d4b090c8ce57132c7ee58b2fe5df9bcb76b57bb92c55b0b4f0c44b9b4ba99c809ff8b294f30c124d81471e7444755a639a7cd40f312768f5cc185f166479aea93324821f418631a75a5142aa059957198655de1e2850a8368b39fd471d26f982bd4cb6a8137650aec67438a3c31b1624bf076149312ab5bb816f295a301cef682b50d63dc4551f863f695756917e13b4af324272f76e1705ecef353c268864cc3bdecf714a28243a5c4acd97f3589ad6b6f5fd9639957c07a56e6cb9eb96b61c2fe107880ef0831203a3a9b039e90da5124fed3df1c2da1b4e2098d12def12e1e79c4302199eef6abc28a461f4bb75eebbbe3d9f48108d24551a667c851d4c216cc3d3b16a44391e4be29a1637538c0181cb27a08cf9054d7fa86b4791c072acd799772745a3fe06e718a348794310d4650e1af36f1345444c5cd98dd83d41c7568387275cd616f0adfa83c274a75d6e023a51be33f1d07cc343d68be2537861b6b0efbac987b2f2fae38abd3264b9467765830bfe69a7709fde785823fce194c42e41ce204bc9bc78837c1bc547dd21dfe2a362be88acfc5d91f3c7c997505aa49c49a450db9e7f717d87ad40b6d5a68d166e3f46b0c79990530c7706fcd8c9d07e17ee046d0ebc016844fe42deca903e1b2a5dfa8cd2dc224956dd274e71d12de3909d3f98a873273a8eddb5e3d310113cb31d4db0693c400f9dc734f0228e6948ddd6ef025d5147af1f91ab108c936f739a5ba48a905bf3523233cec6d349edaf3f6ac863c598220ffbf736519261683460fe7985704956f71f766bbed2a495945f36a537735db8ff7c4e307613361930029318f68acbb5f7c82be6454e1d74df2f0c50498bbfd5942dad138d88fdcb6267a527d262818dca1b617a4d0e1a62be2ac4019bc5bd7b4a7cf93e46f6d8d239b98e3a6e6b3f4385d1cd31f1853517f0a3fa14381795234b33b2d8d4a8a1a24b4da796eaab07b45a5f7327dfeb05c8298cdb93e40a6d7140a6fb993f04014db761d20f803c1d661284581fb6fcc005905be82eccd7c6e908f9af5fad3e615c4cb6fbf5bcf8aca843ce6ed40fe8f73552e165ca14dd1ef5bdc3b4cd8ad2d5e5b4f51699590ec2c1dcff1bcdfc68adbf131e65536d8e1eaba2989e9f75bcb72f7ecee3db9cbd85a4932c73eecc8361a5cd38873a3205e2ecedb99e79ac1087f71e6046ec89945df57bc2308cb6236b951c020ea708ccaa311e3eda1f99a1bf8905fb58d8004823878e5f2f48590fa553a111a9602ea0d24a68a523f34da530c8315d6a555ffaf562f1fa792ba83be3665eeb92be836f75e801c046600ec68eb9a3b1098c3216de1c84bb39e42de80d80a830e5f8640ecd75dff1fee2095731a76ce334d59a7b86b674122b7155d3c1698a6478a1a3a375fb113ab84b58f5c2e19a56b56797e29cf3c243819c7d980f566cc2dd80047b850b2303679cc4c0ee048c645380247f082e9c6056d529bfec1002db809590bfb73a1d85d5b184bee517cfbc0e4c0a0ad7aae950769d27322ac404ade239eed390253ce0150e9875df9c76722a435fb128629b5d5057e3ced3298c4eff7a9704270683f5e057c6332ef925408cbfdffe5032db4e9219e7fcbb5444407acc60b1886d026bf9fcfed653215d616804934dbed53369b727116078a0a514f4cbb0db9f17d7dbd13872cee4fef5157c6088540c44b35cb86138423a20efd13d0f78cd31cc4310faf3f076f079bccbf9f00274decf787c810bb94ecb1aced57c6295893142f6973d04255a61735786c2e0d312a581d29dd36acd84ee87d4fff8ed5be6a8d886d6c8068508a418cf2c0ee35e5f9fca4626bed7f3ec088ea0361dbd88dd1a010832fe181fd58da7336e131f9c1f9a7f80f8de694e2096d779f97e9778691957896cd98122baff3208c6ac89148adda54b1cc882b0db45a350f70891c3a9a10467ac939e48d45ccd21181d032ee6ae4d9a271ae192ce6f98a40cc3797092b8dc9666cddb43b5e11195c5c0f78d575e7a01bfc247505ca98e6de60ddfc780784e018accbf6c165fd2c1684b26cb06d7377a5ec6c684b3579c103b0d11710e5fabe99d70aa1daaeda0794551ffd9c823cdb25c6f71338d15d266964dd505abfea3a7b775b5a1ea1f8d3ab92dc2aceaef40d4f23ed422c0144ea8bc455303736b05789925304d0cffcf9a728aada876553dc752b7270f8e642819b44101969bf399a3288d3595a8bb636f3333e803621bd61d8e7e0c8f55b5338e032a14701f6c6ab32c4624bbbdd6911b4ae80bda557c6eceeba4310b40ef332a449d0ee7ed845dbbe1fcf88fe9b11a0a1fd1c691ccc7f6884a44e8020f012357391ca1264dee3e92509b2a050da2c6912b2f7b09d2fb0a7fed3e4ffcd314efc897c30dc73d474ceabe118ce02257b0fc909bebf22189d5bde62a727dfba57ab7e9c94718139dd751fa8799bb44d5463e2e629c48c2b9b9d2483284071d4458e4f012740e331fde2e083b07b6d80885e821bb8c83a57d9d757f7686e5e6f5ae038fc54197fa370429734095c4892fd4fdcfed5a1bfe4b4351dcb8644632ce7f36805193a566fd54c590586c927b69a94bc3da957a3000cdb67d97a317c9c86d49c8007d9a307fc8286fdbde2cf0438634ce1f37d73c51cb6966947040f512d9dfb2da28b18444193382320de6c547840a1fd00884f2c1e74b83b016b042a00c45618e68f23aeba45f307a475b6479e49717b137fed38a7eff8a0af0c839e434f26222ae9ef56eeab252d90f8a39491a38ba0d022d58b21d42f8795bc563db399eeb5261083c1a02cad70cb75e33bf8af49f606c25533ec4d4407498da657286a4bf66a1c4748e125ab2800307ace65f3ab0681f21a892fa9e12c6727ba5e25aadbb8cfa1ccaf6c41a926541f8031838a0fcfb2f2452feb5e79c0aa1d901ce4e7f2f4058bbff2bf6b19bccd6d597385e5140a76978465ffbd3b33726525906800ea33f6b929c21845955944839fd2905c474d9f668b94f8f98693be00056b08de4b46b5e8236fd74678131d91a7611ac80c802034aa98f90f8503f3a77774dd535e03804f49be2d4156b2203d384e0a1e55d97058283248d0fcb4ebc4fd3be3cde41a9e190f79a3f2122c9fced8d35fcf61ce0db6f682d70d5d28744a1c39fc9ee367d9dfd2a3320570d3ae25f7232e4773832b8cd87ec4bb4d46ec954447a7cefb76be64623fcfb6650d49623f3b5bf5f53f1feae794bea5f7d9c51791407fea3dbbbd72be6e1e498615c01942facdd1219bea8bbcabb25e7938425289093ae8f26a5a03ec01886a3607c1755c82f78ee75a02b6892ba7a21a38b800a5cf1cefd5eb5a5d977d3989b93eb54435c420d972bc3b7c8c820945cef3dc9f1644d436069ae0eed469cee2b6df7f3e7d89d47f794b8eb1aaf7bfa72acf16c7284bee94abf6d88ab4edb82850145d8aea231ab026bed60f304017a5f84397a25ef3dd662e05bd9837336a64966a087ad19dd4c50fa1e50bbcdf5f145777178f61c45f710dccc56393ded07ab146f5ef418064a3f8ce04b03033f27cf405080e2ae38e468fdaf967361f57db7eba886de747638896b29babc09615de8108c7d65ca82bb28ad3a876e284e45c0b48da553674f672e19a6fd36b2efc7a96d2df98119bbf72e433d9d28dcdde0cf08526bc0b553b3fd52f50d94ff015d3f5d27ec0dbe48dc1c05eebddc7e1320f08346697f747c5c727188c05d379c2df340546f20e168e8cc00488790467a31b54b90f49ee6137ae77c00c0852a6bcf45f8ff06e154fd4590dfb95c55591faad6a3fa82ceee4b4d466ede6ba982ab6e9ce6e91f05781e5324be8fbd792f6f7254303d1fc366ec86f6a92565c733d187e0a82a5e314457694e669e01718f4f9ab7d029ac2415e1e2c82196edf82f5afddbf1e276ad14932aee569cb80e0775ac04e86a07ecbf4af9a3049f218cd0f214b3d8e5652a05c5606bf03ab72e487330276dfe8395938f29fbf2dde8525c7b33d4f484f3f3cef3709825f255c8316da1287068ebfd601651a85f3fdb81dec178a7ef0190d520e6ac1fe9fbf6d5a9c0b2c897c8c585b37bcdc0768d64821ef9a9e87f61f97b97cd6840ab65de3f78bed9815e6726d71944dc8f008e59812d8f0a5021bd3d7a7709af6fd7d896e1a42fa3c056b511afe341fa01d76f1a92c5c5967ab349a3fadf0410c810df16c1f40183901d35572f98327c2f7fba4ff8f95f758825e47d2c5dee56cd931f4ae77f4de16fd8ede7b4fc5bcbbe23fdd339226425ae8de5a258b09972090c8f890dfe28b5db8a923bd0e448829adcf47e76fbad5ff8e99a77a8736eefa7d579f5fc3187dbadbe5c325c9f4f5c080ce60a55d8d174a1f82cb482610d126d73168e273aad43d30290406f3eaccc874b4c38632f57b2b40094256affb040188750e0c3476989fd3f22d95da3101ca287f931c2447fd4e0f8cb53e55ebf192a0a0b29d1c32bde781c4c64df67e07f580812b8e493fb76be187a2c9bd4e10563fcfc7919743aa187b5917f6e2b35a9376cc9a75ce9f1a61eb75a86f9b64d4846fb8a709d863244339031a1c3800b409c42660570f37129c665a28d35fab30a0d99611f854fd7819c6204e34bf085fd303c2c4d76d1cdbe1744d1a865bd66e0114d9b6186bcf834bdca8c150e29a48effd5270e84d665d6c269b03dca59d0ddff7fd01fb25c535c1e913be6e16491ee401acc9f1dd07913b7a658432791b8e556525636b8c27bb4d4e3608c55d47d295d9175f343b2b77c0e0f8e75b85c1f9136e198cbf6c4f765acdc28fd329cad21fffeb68621a19c39eea98a210180e11372515409117c326476cc8f1865ad984b7c3033a9568a62b3ff0e7755e30631af10655139ff9c9cacc628b5e591511615010934bf97c1fee81e0bc02c078648b24f12ac7b4bfa94fc286ed02c6c512df0c9bd8145dbbefc39b7178f5f7cd2ab580e0a2a365678499724d75e108e22ff5db0040f5e0babe05d9acef817f7604b348b78e432dcee630eebeb4ae73c8f98e10aa49128cdb24730017f2b7150cfab8acf52a0a81984c98255240a9171770f648c630fc679046ad1c6dc8e6fbbb529ebd8cbbc438ac6d4fc048da62c0901211c38ec042bcd8779da7ae752bb1628f045e40f1bf36377eddbc7e8617f84ce0f106fe7bf289a32e8e046960f0999a2d2cefbb1e91da50d03c6843b979c4ae98fa8fc705c1e5f9b61f20abf3ca821979cd47a94efc356e13954e5f0f75340a529c1a027c6b24ea41c5bc0c39c77d7532945f0f8cf12fdc35226c20c69ec06120f1a787621988d878b2dee1144046984f62fb99186648f2c40b0f71ace9caed4fd5f0d28946f9e2eaa00e54631f2481ae1cb3af6f3144685928b858e4e577962c984f2cd47c801b95d0201d0402860a3d00ce84bfe19ffa630f09b4be9d6aa61d6e81728a929c361201df38a108b0007f77bd2e7f9c3cf0f7c6f4d03cd530313a496e48a27fb5a79630d28926ce67890ef45b1f07b0cb9e2efec4449b9d42413ff8090870ce20fa86ceb6bfe419a48183e9199fc227de7778a3607d71e7b45efb7504b45aeeff6c12c8b3d8b6ebb1b7d83675b83476c2a74b3b7218b4447a44fccc9c78d689879ec45f99f2d78e40943826c511d22977137ed264e64ed998db97be8b54f1c4943c1e894bcb68dd199d0f8c0c7f4fab39e5e5aee35e755c5f49303d7dc8528381229d5078e3e9c14d9ff5b2a910c1d75174e82dedd820c693f0aa4704b093cffa01386d610e3cad1f4dfd5e19f8350bcd372acee11401f0daaf8c2ef711019d7d68b21833fb68518fb1f994e0566a3eb3e7a46d1be3d7814a503f8401acb1f3dc0e2a04118d0c02bb32952079e9bdf2dec8eb123d18d0ade97ba508824441257104b0ba699c912e88354bbe79a00afd3c428f08fc7ddd11961f629b3687e68b06e860a29bcf21cc0e95d3325b917237ee69d499254e23035a35dfd17b163c092309a23ce48c6361957295f1ec7d2667ce4f2d48e120c0310cc6182020675137f43af4b1b55467e3b29d516080535e734caa7d005a06672c1cdcb771aef1ad68ad033f24fdbeb03cf502c17fe8882afdc2e7cc90471e1ba4a22f9ec39a1ad6a9cb78a4232aab694cad1c837607462f1db1388c3ea04d05f3461c2526466eb46f30a2f3bb1e534ca76342a7d85e8869a953004a4ce4767dcd444a88500bb7787cecfc751beed020659b0ed8ba2353ad090f8340188b3c0a80a01ca257a5a4e014c9a6d7b5f1a3188c0698dbeea56d61d41083315172cda80c465bb9f733b1611d86f49442f31a264864f380b493d53f107c7f47d4b51f0afcb61b60bf724d8b775e6ac16f84db07b41d35e46fc5b853572f9c10a45a662873634be1759fb434cac356f594ca991195a33b71379262c112bc462a1ea3f51273aef913f34cfa13d3179b31d5cdd40619bf328ca2075f79911027f6cd5062715d37699b51471b90cd74650c990b1233305382208ca2d976ae1805149936c4e25aa35507ae2d0bf8fdbd706b317dd6f4568583912f11197fc76f8c2322531cab3719a64ce826dca4e1a876ffcecf09f2ba5d550e69d41fb2b18744bfefd82883cb78fa269112d15754c4f473dd5bbf18ee9cb66ed724bf9dc0eb7f7cee5e1062c3e72b7b032f3088d876e885e07d46bc1774809f4c0f5f6859baf46bb9854c478ed49936facc2ba1f137a032d4156ff204188995c5fcbd3acec4cde30a6474d6e931b6a2260269288962b9a058f96a152a929335142f0aa89e039cbcd1b226e604a90b7ec2f27b0250b747acd4419c6eb98e284ace2ee514b552fd210028eb27903afb8b06924116a2fb882483b83ac346ca483ec1b5f0a665b4972f1d5b7864a0739743bd9518abfc70de77d1ba95e29747c309e981b04d55aac3227fbd06d7f528f97cccaaa5097683a838a265aa2d6fbb90f4c717059d71ae3b200d8c3b2b8e4c3b0f049b21c2700bcd6cff1ae57dac9e247ff527b2818fbee4155b6a85d785bf718b46510bed5624256578e7a8bbd794124f54223c6f58564cbec933d55ba491adf3f842e572db59e978d4ddecc108f50eb6df9ef5b1007bead8721ddb3e402d39b2808431b38a115670e45199cd0563d95392027c6ac3fdd0e0c05510772d2ecee585e40fc190ffbddfdad36e85e20f6cc9bd08861ea9afa4af889e7f33b29ced2ba8289dcd5892a6df120ef72af8d8e9aa956cf0bc53aea4e9b98e787e4121025c54a949e5209e8d4d5cc93c4016362bc0c9990025a923e2442cbccd3ab834eb8d2f4c30bc0dd187432e11ca6c146ea81e8d278a52b327804c50fa2c30686b9ccb8cd73ca74d2c7860d7614429a3a208c0c91f1dc1acc392586b63307cfd6205a0987683ee6034a80080c1a8ace1a0be688b9c7feb2fae7bd0028b2f0a395cfe175e260681f676da3cd710a41cc8278cb22a7a47c496bb920c199ce7e24f8c87ba6f89f4f6d643aa1e32debf0f035b5aca175bf1cf5757c25fd557ae5d0c830b65ed210ae9d320c1b999ba1d190e1b87bf9cf7d55ed5cad07a79eef4711dbbd7751957bab242b4f6efa27ad6dcbae67732d7e4c9b89f181180112081bd19ba08195055d689cca89e6483adca239cf8009e81ff59b53e35a1737d2501c91d2eb955ef9264b648eea9729fd9a9b9c8a9c94f79dc3d7afe66021457ec3ef817984d9a889b2cfa73b880b01ef768b17acb73032ece048f5927ec0abd1c5fdedbea1655f2ab4c0c376f07839125826b41943250ffe3c7003bd2acbfe7cc9a0059c27f3eca838623e7b6a4778d45482448862524917952950eece77dd14b6231cc5363e2f315052d74bae09ff8556886a83d6e405a19d1c482882a9789bdddc2cd4fca08fd3293dd6886822c3896f777ee1d6d43896cbe189119b9d7b63e661d3f53e9a884eb6b9e9f3fc0d69d11214354fe1ca6b7de0660e8ebe3c3745faee6097f488bb050c5549cde5c2749c7d1322e96244372eb5290bdff2065e008f8c4d0821ad339c563b43211391eaf9b2627afb48a3e86afc95f490890aea7db7b1db02af3269b627d69409aa4e12900858700aa3df6f1ebbc726708127b0d13cd6de411ca7aa4aacceab189e35460400b2bda79f01a77de6edc0c42d9acc8e1f79170dc40555b47df49d05a243d67c7c993ea2dd1d4b031a07ab66bd4bf3a052f5d88fe8587320ad794176201f0afb131fa121932acd3349de25fbf892be863cb22f17cb3dd0b17648188538b12e8c0b38e0199a02b05b15eb9abc362ddee38802218d9cef912433fdf34ba70a7090004352794abe187df72c93e9b65d69ea0fa830da738c9d2beb604938bbc1b2aea281a28aef1cbf6245d94807dab6c19593ea57827203b6514b958728bc2142d0ed9be2fee5b7914c04895839b0bd828d447fbc318f5e54f9b4ad45674bd72ad09da66c2b7dc8113f196ec5760d06713807f27d2737eeb8895fcfc128091db70cf7cedc25eab58e116681cb43a99c2be00f6ca76fe862ce0d88cebab13f8d5883b052f73845cdfba25e973b835b3278ab22415c67928d78f552ca0966053d6f032eeb6b303ed32e2cb5924ea858d8a40c47a693c9cf0a85f60ec58fda7f06c203bf5ff1ae30f2d0ee9a22686ee64c93dbe4f703df55220db23bf90b64e6d8210e9fb69eb54b430582666ed206bbdf22d8d1ae66589bbb800478cc907b4ff1bf36a97a6f0b7245c252890b02716fb71619ef3537d04117322ab817ae619b2b2890c5cbd31fdeeb369e68ba953e6536ddb942fe5a532baa011a2eb4d8311daea2b1a8c5e5f96c292af38111d1c5e29f152fbc8819c93dd5c4495df0363681ee952ddfa6aa74b4549a626dad8b313302038cd3df525520564456bec43053c98f7bb0e77723670243b9adeee9280e1b64333f7a49bce4220d592fb4e09ba0d3fb19eb99016d90ee3cdeb9d0c812c5f9e8d0af75fd4219b227d92bb3350f3b47363253dc77726468050c7e8a6bd7e3b899fb3e8491f56573c6ffe9d2018c5d247ace94086ba373722eb5db61733a11ebcb6af3d810a473059290adf320c23cc89ea0aa28fece1cba559705676f56905ccec7c6c25dc24662a0e4c1fcf6670059c7307e8d5e4d506b30d428c2eb0b36b9aa8f6fdc4ede7f05315b025fd70cfa6fccdf9df8614c7a42eb0da32d7f8a8d95c6bf882526c92c3af2a11e05e66db1f3b9f71596afae2b379f43597c55a6a19eccc88992bc2d432c45c4dd4e7e526a25897431f2d532b9edda55da6f036cad3dae291086b2806f69aab3ecd60d5329b71040526504ff59b7cef7dc43c858d752905d43d588100416c9f684f60418192d79c232f58d352083a67335e0da74f3e81278673e51d5b2efcf11fc2c2f675ae769f30cde7121a0996057edb35302523a4b0edabb7dfcf5ea7dddd3951806cd9b62b4798972b2c3f6cdad1a99fae6c62cedc05a1b603640a2143a7f010f7fd1c8a52a4f4a0b74d3c303beb782f814124e346922508b9420b148e517a82bbe9ba813cb7c0748161f0029a26df2ffb8b3c7a342d0cd24f20cba935f0960f0d6032e5d26cacdf33a17a6a454cf4518f0a311979d039ee6487dc7a6ef6666b375edb706834993c1139d2d509b7528f006a33e3280763819222bb754816410480c532d4f28354da61e05d7fd4c10bc72ac3458a395d8b1691c5a06555ae603ce2ad8f9c30b0868cdf831da02d513cf81d1bf642820943656a91f2094df5596a5516cc147b6b11759fdef8334989856dabf14814526dc5befa7de0237dbd6fb7b3acebe61fda9ef887b9f9e9d79239f78d6a4a1b4c8cdc3dc51870ab53e5601a0f5885fccf06d96e53d48ddb35329dc2df2b91924cf3a7c57bb312d9096f501b7d611e6c0f364ada362a189233ed0061a74adba0b07c70959ecd33ad27d26d9f258ca4b457479802a83f805ca2970751e170e0f7958f8b5cf7b33220a068260fafe86e228eacbfa7fec0db307898c863841f380316b284f94791a919c43d3d52780700bd5ca1af5cb4ffba4baace3253d7fcb53b97756f37e6bccbc238234a4ac2edc5e6c655a21d281d673e0770b9a2f72182d7a70f7538d80f47dc09a2d3620bc78e54ddd5561ecd142bc09aaccaa9920f742f31935d05ac2d73b370e3e97d62c524a404d14650c4e4052f48570c2b75c45f66d765ff61da4aaadc11c0a0bc3825d880980dc655db81cd8613c543184307d884d77fdd774e958a58f925559d3e3bed195448816896a359cf02ec3cdaa19ecf2b2f1be4f01691b1727dc990671f0273dfbe06d3fc7de1093900e77e4af82d85719caa869338041a6ec50336a6e11df14ea573dab0d46a02306a95ad8057bf23883a21a44462398f7ee03b7f6876409fcd5863d7b31d1c061191c2764be1254582773176ad94a0f4c34c5575355396d180b0e2310936c63b529581821003af575020975d4f5c4bd9400e088e3e7bae1949b2226e24f61da7f0a79fe8022947fde3f26e3530828274b5a28e5f4fef1f5b4e805473bc0ea0a605d6b24c89b97b5db7bc8e6778eb14d65280f34d6795b670d655f049d0f9270f16e127f52d9d568b875481622899f5bec1889dda7c253b761f79d7088582c55ca75685657fc3f397cd303c9e59063ce2519f436468b0ab6e16ed6b90ee076a42e01de53e27eea69926e58e0e42400f01009abb0c54c65a508e6248e63ea113c796011c3d2314664dbc2945ea1025e145a808901ef09b8b374f2e40902aed6beab5e9dcf9c3bd56355bcbf5cb79d0254d24c07b901dc42713dcb5f377ee06091d9bb03bce2ccc6fc92ae9a6e65bcb71fe84d2593cd6fe25f08c31c6cc28c7afb5c11f493742e08881a3f5c169ff7eb5735504d2e347e69daf36dbb45472ca124c32585d746cdb57b67f1679cce09e203a0ed68677d543a92a36820f280f1820e531364726a8ad004a7d391487e2d0470121dfb5603dab32d90f1bc0d2d75e94d4eec88edb59703cb2566cac78fd80d53d302805cc761f66befcfc8c8c3b804ad3f12d587345551d0fac6ac7b9c06825f00e3f1f4a3096f77c00ca634e788aed33ff8818142aefb032fba42379a4dd9e02140abc671b0438a3976cc6d6900b7c554ac45caa9ebda343aa2a70311a3aba7e8f3d2aa657dce2dc173c440ec055229b85c051e0fb2132a2aa6b61130072aa6b4caa76c67a42dafe42fd9c7f65560d6884dd94ca05fd6b0810c36b0cd123bc2425bf981da754e1c642fa3d1d3ac3faef655a441c6d09457fcd8826d7ee79f78e6978c43db16e9a7d581b1d2ff7c632a5cd8b29f24f102cb914244caca519d5451aa5074a6966ae5e791ebd90efc72e8647a1dcfb5b33023120697878877d66d6a8d1705cfaa8ee0c06d7d038a72573da7e53f0f9143e31e9d4b4013dbe89c28ce9275e5bfb3bce4451cbb15a47b038bb1947934b7e908c710f52d0031b59efb38afb6a202ce21181ba8d16e15e3dff8b71bb64a8dcbed6c32e4e84b4109d32d06859a236b14acaabba8ea3b1e5c33d89d2d2863e09e5ea9609a323fc394139579e4e1137c5b526e9da628be8a5eb01a868f42ab4c55ac441aa18140ca73abb6e071f0f8dc0c48953c603fa116875f8021dc5b425d88172323af19ea06240282284ef1dbc192d2a64d65029f96d7f53c01e0e3a410ed86f5f0576331cf77d2fff70c3d27053a370d24d6a2ab79b19b32eaeecd07f63e2e1469a7bf71e9512b27df16bd51f61811d899e46c6f464f4817a5c16662e200be29533d70f96dab62bccba16befb9af233520f4fe19fdd133e85e3a3c7abffe243d0c5644d805879d816f2b6b6f30f610e164227512d84b29c34be1fe77a82b2a1b48cae95dda758beee835bb9ed555498f384bf381e33b855d27aee99429d4e2fe76779552658819adc821213019c348afb8ef38c44d2b4805d279bd27720f87c22b290551a333800c8827711b0d811501d0ceb1604a1e36d17a8bc9b618404aadd6baf2cfb83024cc1b686de51b7f6fc12151cf537a1dfb7b70a2321a576ee7a620f2639a0d554c71448ec44b0f50361a33720c620386e2782568ae222c031d9812e92d0497a8a71835bc4bb1f95202cb95116db7d14b9235e4a08d86b46f8110a621e79bc72aa9882f720c0692a117e2699b4a50d95ffc6a97922d67a29e43547d5b47ad9ca5d889f84346f1c3faa440209ed3812ce30b997e1e0271d67704d3099b486c4fd325d5f2ba8d8fa5fdb0f16972b386ec4138eccbf1bb13eb01a86492616714b3b624a1be1863077d0e34e6cefe0eb8725af6d604440a98fc9eaa799861f380104418920faf574784ad37bc19e98670c6850ba8a283bb7733e0ce8a9f0391ca1b409c73e0e22e709f5e3848a06ba5255e5afa854e7a66cd7639d6e2afc771ec562e5dee58a96a8a4141aa2c2c48c3bc43ffc951d5bfc2efee38c167ab1884d08ef615e52903dc4f29a393c5d3c6e91ea1c2acf462380d5c8e3378600488ab0b76aa1c36fece3f4b0a1f42eb516fcf71134679bb296277fd641327535649a1513b0d16481a57dcd3df31c19f2563735bfa08efffc67de12f9259357ccab2a5d3f35c4004be631422f10348a74d32aa84a0ab84534d2f53dad1628fd542abf309e0a044ac1d7ed322928d68ac88de7eb42ba9ed06ca7a0d68e81dc6ea7e7bb517e66cbeefb738ef316c74b592d66de81ab3d4785a5b14b15cd64c6b525359fd11801de3b54f7a92ab6f8c4d166e86dd79d8c97974b577d42f8fae69d5c857e0cdb860ac686cb690663e25a735d4bcff506301fe9efe67580d4609cc53222295274214ddeb66c4681418ba4d3b5a237925d19945473212b4fa56dddd836078edf0206d53dac11b23754826a28d9b5c72db992c0a033237a603cb265cc516005ddc6b6a3737599fe25d8e38cc761f07e4c066fa5f9b8cc193160d5f58eeae6cff5f956219450b28bd07e51e07a586c22a5800a45b4fb764509f2b218eb32716b8fc931b80667d6d5b97998416176ec17b36d7b8394474724562a7e5a9d8f78b1217c3747b6356c02a1c543da0e89b2d3a405596de874ed438d61c7953185e881f964c1f2a81720fc9c81dcd8738848a8aca046794d9513fb2d841511f3bd06e248ad8f49c7518cc561ce95316e292c478bacf2033afa09a609f38a39ded93d97d398c50fc2938a5d1be29dbb864b00aa170300dac94c9a9b3dd61b6c9768219735e30ca2063848a6a8492b984f0716c8c0e4f704362300a46df8fb2e83aca3f4212eec965c8abedeee6a94aefce3f22cfb7ecb960795cecc095532209779bb691e5dd9ee7ffcd5785255eca90c56a439d17afe49f6134be753bae2c9c8a5ed1f375a7cd99769573453a0a69304211a134dbf7412df7df72b7c7bd1671acded79098db02b30cc64ed8e8651fc46b2d2f78aefe932c8ad1a22c39487f4cb27137fef821cb26533340652a901207a626809bea3bc99620ed4c6acd480c75b01bf37003acf8819af7c4e58776d69e3ca0f06e65f7beb88012e23d91e51c645855770f42f8b17b5b971325212c57d342ca91b318a93374b81fa273e6eff2b9a39ba9dc7f3d9ac8f35e49e0472d0172f3972c5a1681e6992b675dc700b10fdc7bdd28f0040740bc6458f42dafbe9f33affcddf575367c8c4fc65fa7182e6d966a0fd4e6060eb05e5d4c7ef62c95053938f0c04f5c4695be4c14e10f2567e9b11dfcd6df53e63b94d4641bb7cb92328248f7afce6bc948ecf2b088e2c0276f176309a91a3a95a70df59d05e4675dad822d786fc06d01852d6140b60cbf6735aef91dad461f225c3e08a736df0cf5c7176b24b2424771654fbe6b649adecb09c65e878b6612446664033f9a13f31f4c5b65ac4ee6c3fa316c3d09b9f3574953aaf9226f86b16f4fc436f4037229c4001d866c66f473f33f9d4cef462265a6f18b988ade6e31e63d4e596c7fe00f760ad6128bd108bfff2f89cba2b3ad80889b788edf6ac63dd631f9b0e7fdd8ab98d64cc91ccebdc3b5a6f04593b1e7a02c7492dcdaec09330aae2314ea415f047795ee677877eb7b7346102c0e9461f4fed7106f8def8255b32dd40898a3a5541b62de268dd5f285d47c4892365aa11854ed755349afdfb255b9a51e7d224407f497d3495dc29f7786db0518e83ab0851303cd32da6362cc9383c139919d3a9b9a778f35e0b7fd37872dbf0cd57545092f390f87beb0f5066e3cd5c412d417633c8bf410837dbcc3a1978b895ff013af0e014c8d43a4ce873ae2714851c09286a332705042ea86ccc33d57981791f31127b11a567ebd811305cd8de534423172d5f24dce8307356c31bab2df7132a3606e80ac55d96298e94f9d8babeef546fa575b2de5b9945fdc108750d57f149258867493ae76bb127f8e51d9dc7b82166efd3e63d6b2364553ec23fca0d326ed7b10d6014effe53af01f7fc46817d9f0fd99280b8b16dd9ce197f9399e2c2d2da18476b7ec4ce5ad2be6763ad7935c403eb4df908d6f4a1cd61d525cfcb3c26aee84a8219c7184274d02a2a6e2883e278cad3d980e5abfb84b883cfbeaf3e0f1788b0cae9b686a60b902ca5eec744eb90d5625f984f8f6cda249977b44f8ca09df2e78bfedd6e5db403a1bd25d3e8056e654d3cce77fcd476eb9ccd8e663d59d804df958f71cc421f73c308b78f4dcc8a1f7e5c32f4da9fa12bb35666c823cce99a26c0980fba1e2f6f1bbd31a7accdda303d3366af7ce515f695ce9954967d31b07f530aabc1f0535a3c7ad022f9f64910b0b6b14f8925aa2bc24080a5acfaeca6534e9671fa778259c44b77835318972b00c3b6ea4332a77c05b942ea31e475fc39d5be02ca1cab7077f7a1d4630c650782e5161cb6d1750fff3e597379772ef19ba0617da329b5edbd469d6b29c764fb89561bbc9828a8e653470875a31a0feb8966cc8c6535f1a36713bfdd899e922ac0b2d2e24f3e7599a415e85e7250f58e0bc31fc615706c3dc3540d45fc3e75901a6fa5b20780baac0f3d3599a494d971ce6ab8e318e660e0e8a3ff3bbf28cdc95a429a81f975634e93edf3029c82f20f46a9710c1bc2116718e3f0d6685ac251b168b0e5cff4ee20085db75f537f2fb4d44908f2412b5dbb2f6a47ed6541de6e2b879a382292da1eaeb477ec5769bc10a46d457d2119f38c4d92bd6f9bb247d56147002ef8558025775e5e5d74bb3cf06142139edaded382340e672fa082954915e8fd4b3b19b4ce6c0706660bcf74d79f07acce9dc2c167af80a085cb46303c4b98b55eca1c2d3b08576d922ab44ea8544626098dbc1f94b552d71e7cd4545dcbd2f69dd6024e0c009768c9dc5808db98fd5cba89877ae0614828abc869fb5f1b7750da91293fe53816c2c3dc13eba56aeeff1bafe8211a82ebf383e0786b6242029a51041a4a9d43f08e46373deb894e766274e3bfcc0fbf919c6924eece9cf9fb2f8e3b7ebab036a639a869991a2f489d45ff37718b3aac7f87316660a017b3f8c6186d8866a2b5035b9d4ebe5e802d7bf3fb25f6a7326ed0088bbe180f3d37cc3dd707c19cd6ebbc0958bff6c02c69efe74dd769d7ecc43d9e9aae23e02a60ad9aaa165ca00338983dad8a3604cdcff07d57f4d9a0141d31c6315763ae6d4886dfd3f00fe5db980519cbdd80589dcf89a9f94d08a9de4635ccc583ccd7faacffac6f504dcc9f9f30885eff3b9d91e3fefac75f4bba95d3f1d73fff8fbfecbefeeb9f7ffdf58fbfcc742cff48fff99ffff98fbf9a722bffbce5dbedaf7ffe05fa7bb9ede0322edb0c72735182d20cee5b0ed6edd19cd97fe4f308e67bfb1c653981fbdccf593afdf58fbf947199b7c34e8fe6ffd1c2ffa5c0cff9bfde78e95697c7bf64779eff2d19e991377ffd733a87e11f7ffd8e7428fffa67950e7bf9ef27b74cf779fa97ae348bed50eeffa5fdafa6fffb912f97ff96bd723ffe87f69fbffe4709632ecee16f13fcbbffffdf4335d276faeb9fc77696fff85fb399341b73f1ffb33858cfff31cec5dfb504e5b6b77f1b07fe0f18ff33a9ff27000000ffff010000ffff664d41c7cafe0100`)))
+var _ = pkger.Apply(mem.UnmarshalEmbed([]byte(`1f8b08000000000000ff04e045aeed0a8028d0cde574fd5266ba3d33333b8abeccccb85daab967fdef5f37d7cbf1f7ef7ffffefefdef1fdfed7ffffec07d594e705aca6bacfefefb53a675d94f3b3bdbbf7f7f7ffffd99d954fdfdfb9b96f21aabbffffef8a5f8fbf7f7f7df9f9fed4d75fefdfbfbfbefcf5d96f3efdfdfdf7f7f467616eddfbff91ac7fffebc331babbf7f75361ed57f7fde998d955b65c732fffdfbfbfbef4f5ac46eac8ebf7ff3358efffd29d3baece7f1f76fbec6f1bf3fbe5a8fbf7ff3358efffdf9d5714a8bd88dd5f1f76fbec6f1bf3fbf3a4e655a97fd3cfefecdd738fef7672ce535567ffffef7cfcecef6efdf5fd39ded95ff4fb14c607174ef595533782cc39267f3df7f7f46d6cd7fffcefdaafefbe3bbfdefdf1fb82fcb094e4b798dd5df7f7fd2622ce5dfbf3f705f96139c96f21a2bb059fe675acabffffea425acf6a35be6bf7f7ff0ffc0f8dffffddffffdf757776375fcfdfbdfbfa63bdb2bff9f6299c0e2e8deb3aa66f0588625cfe67f60761cd57980c7ba77677580c7322c7936ffbfb31baba3adaaf37fd6b9f9fbf7bf7fdd5c2f7ffffef7afacceac1b8fbf7ffffb376753f5f7efef588625cfe6ff77766375b45575fecf3a377ffffd1ddd57fdfda3710843fffb9b96b2fafb87a1c47f7fd352febfb39baabf7f7f088440ff1f88feff20900fc3ff30e21f8ca67ffffd75c7ff2bbbfdef5f9d8d47f5dfdff13bfefefdef1f5fdd7fff081c42b0fffe9479f9fb07c3300663d47f7fe6d8cdc3df3ff8bf3f6329abbf7f288a40d87f7f4157fefd83fefb93baf2ef1ff4df5ffcfffedf9a95d0df3fe8bf3fb7aceebf7fd07f7f5ef7557fff681cc2d0fffed87138baaffafb874134f1df1f3b2ec570fcfd8369e4bf3fe6eca6bf7ffffbe755c5df3f98a4118482200af9efcf3caae2ef1f4cd128024304f27ffffd196737fdfdfbdf3faf2afefec1040411108292e87f7fe651157fffa0fffbef8f3bbbe9efdffffe7955f1f70f266904a1208882fffb338faaf8fb47511046a0048dffdf7f7ff1fffb7fd77c1d55f9f7efff0bfd07fd07fdfffeeffffeefbfbfb6dafffefdef1fdfed7ffffec07d594e705aca6bacfefefb53a675d94f3b3bdbbf7f7f7ffffd99d954fdfdfb9b96f21aabbffffef8a5f8fbf7f7f7df9f9fed4d75fefdfbfbfbefcf5d96f3efdfdfdf7f7f467616eddfbff91ac7fffebc331babbf7f75361ed57f7fde998d955b65c732fffdfbfbfbef4f5ac46eac8ebf7ff3358efffd29d3baece7f1f76fbec6f1bf3fbe5a8fbf7ff3358efffdf9d5714a8bd88dd5f1f76fbec6f1bf3fbf3a4e655a97fd3cfefecdd738fef7672ce535567ffffef7cfcecef6efdf5fd39ded95ff4fb14c607174ef595533782cc39267f3df7f7f46d6cd7fffcefdaafefbe3bbfdefdf1fb82fcb094e4b798dd5df7f7fd2622ce5dfbf3f705f96139c96f21a2bb059fe675acabffffea425acf6a35be6bf7f7ff0ffc0f8dffffddf7f7f6b76b67ffffef7cf1e9abf7f7f4d77b657fe3fc53281c5d1bd6755cde0b10c4b9ecd7ffffd99d954fdfdfb03b3e3a8ce033cd6bd3bab033c9661c9b3f9ff9ddd581d6d559dffb3cecddffffdf7576667f6f7efaf0b59cb7d204d6a16866118d30b5a2168188681188661148663128661b8dd677e19c3300d238c8213bad86cfde88a6c863046510044ce30af00d23a8b84655b8609423bc2de696a7fa9503b93dc3f78d1a98a5f247d974c43e1b32de0d2f22fbe7956b4d0c9ec10f5c7b00b24bffa2bf885427a0d797b1e3bbce4d3aa3f59539b445cbe2617d82ef100800660b51cc9d892e9388468d41f81bdaab3e98a56709ea21a2147b43c63bc44b37b0b4800ae97abd96c3bc87d1a3e3b0bfe3de32f72f5894958e6f79a17bc67cea01d8cc32a22172ef08f3f21728fd19dac4b7a876ffb3d06bda913a3e3e706aa660b2503aca9164669434fce14724696bc7e6f30b9e7ef4c8b58209c1f341931a646b68bc8f24e76bd9ebf3720e13d8e9ab1be14ad14305c72d46cd01ddc0855d5ed19137d4b3555f03cf5be5e7d79324f6172bd336a5a417d4d9325fc10f8b7a77524832eae458e259386162a22e7ab67d0d32844d75653b76476a3f5abea8abaff0029605b3d58c092ca6ff4a5f4ae3e6a07a5d15779f05ae4c58569c091a4d1f7b241aac0736b864912084ec1f5c17b8a8526e8ae2463819ece982d21eb861131c9a7d07a0367f0c2e7efcc21d2d7bba84955c0ace4d1205d2adcc81ae046590f29f2e569fafd7cfac6a0a735af467cb162ce51d46084a698d184781dbec59ea402aac9ee1d50a3f38f62bc61a684a65ed1a5cab019cc47147f1a51fd815cdc626609e053685221087d0c247ba6ce727eb8ee42e7b124f852eb956137583028fd398c4e1a3534832aae245614a3b3ac960962dbf14c560fce24361870102570b23e82d27d67d85adf777db20f87d7c026aacfe269a87f3b10fab22052a33c483fa79173f9fc3bbddbce951d5ca6f2b218960588b2ac34a569d2f09eedf85defde476fba2ccb3064b1d62b6ec2a72ebe2c699a4e00ea1c30ca5318bebe2ae417fddcc57e78cc397498cd1c03bc12dd2aa629776fab194c83b5ba62ae7f34e6a9cc7dd7e789718ffc308512f22f5b7f9904f00a9190f5c2b195bf3846fa310907924ec1cacfd95140f5dc61bf83b335739bb93837c370150e111d12983c843a0c6319463b8d2cf440cce716acf8a13442964e616b7c213f0af2c414a6b73a8cd3ca851522e17f58e35ac99a18e95301a6f848f000822c7c1591f9a5ce59a1af1927c2a46622687ec516034e7153323acfeb1ef0dfe7d2b5277740a3f23e0a566221a40d2b352698020162035b0c82db1ef0dfe7d23267b6dcc31adebe7a093d570c63b502c436edf3f6d061033c22d0e0112f3b247fdf8b1ae5a5603c0ada0df092c368d7a0cbf89619b366838819a62e12a3720d180224b5bc795365b94de9af921dd5ca158dedd22ac070ce3b0819435ad00022ed685f607da7e6f9e84cfa82d884ee208ae1da96b8bfc3ef2aba21d49c276c9f2363aaa20459fe40eb47e082c35df7bd6f11cce9282a1421b784808c3fe86c97844f8204ba3ee055150a6eab0fcf199440ed3308523801ee80e10583bcb0c05dda719cbd82ee688c9eef0f68cd7751effd91a8e106f9a9f05c37a0b1cac171005f16fc1bb4ea3951436ab6bc6c1205ab098371de003d928fe2266cc2fe0ad7b44e7f67e105aa9ecf73bf82e218c6ed7f5484d6cc6ab0d3f8cb694b3a755835277018b4909dc8806114ed0135f9d659407a2cd14220e063e528901fceb1ec1f5bc2f8ef1b2d142cfd54df7d9e1159b8ce1b9504294c411c836f86a23e2338fea059b2935cb669f383ecd4477330fd74aed9303e6889a9024164d66be08dcd9920308a67b83068c5f0faf4565cb028f62b67c221be314cb00462fd9b67be78297242e7c360782c8223e537bb3565671ccfe0a7dd186b5137798a82d4cc1f55257156f386845d80681d3d21777374a552f5a5a56d3a63d56c9bd5f4a654a7a3200610e4f8b6fdf670cf910b6c05ed24b0b5c9b84438d28f2ca7cb54ebd114565113aa79ea322004939a12673d3c63167197163563b31497162aa13ee251163f958d6b9ffc284660458eed80fef966c6148070b9d3a34285df433196196ca68148493836cef5eb91a1ac42f3137e0fc578e6c54322f2f40ce1214c51c711c5b78ef2e6ae1e8431c6343a8ff62f4d51d28e76e1153305ffb9953eb25f9762a58221e03de415804b0d158e8fccd043dce14ec16c83ced14d707c77ae697876c7733e1a0d87f97dfc528dc1ecd7cd94d9eef0b17d23240991662892183e322c1beaa0fc631edea33c6993d76e2563b4078eeac207d0b399c6396ba3d990429900a9485052eec7766ed075ac13a6f1bb61b4acfa9badf6f79ecd8f119d6d2c7c9d7ff8e46c50de507935359804794a860bb96cc8d891b958e2e783789bb7952230dec97e03c04ca3a87e94036d00c738ca530c35a3ad48152ba6d49be1f57cfcd1b0cd15e71896d50dce825df8286c73383f8f25afd1e96ab39937cda75a612df5442598d068edce3af58cfd31a35af138ff329a7dfb76e97df26fe75a2a481939c503ebc7041a34c428cec9e625e56bd37869e9eb31eefa0308aabfe59ab48175d54a2e40528be0f3919d1abffc7951b1529d03651a31b38d72596387d804f6ce6a005d62f7ee4ad01490570e930dc663545f27fc191ca9734c79254dec45b7a55f0e3387c3c407ab70328f7a86fb0e3b83f60c8f0b281e5934b019a576f225f509a0cb862cbfcbc77adba90d39086388494732c8a6da21f0db5d25907c9cf198915e321f9d6f93aefb8ac2ef3c3f0fcc8a403b372ab0cd456dd9e2871db8db0741de452f4b589630a7d1b4f270128da33166f631a6f68b84f871f22a09cebd7790677cfb76c04f5a02248b414da48506eaba8a841e9a097af776ca8f6facc00a05d38de864870fba728948519211279251396fec185531f8cf669af690e78e957ddf95f069dd4c475054e3b70bbd8bb0b17670c3de224a22053f441f679f2ef34827ef85f460362f9067b1390a0706b5f42e90fc253ff3c132a585c9c7cb05e7f47488d15a4fa30553f018b30bb9ed6bf6b195b10e67832a987b3552c643d1dbafaf197157a48f83e6c92682dc2d474e31b0f71bf98c4fe50b2d687daf3373be28c26af28633c92673ccc9e252d4c55a67170625090ce8e2cd9562b30a915730349f076920f6c98e6fbcce64315f4898c6ef1c3d717c8bd79663745183a64b26e7851e88c323f625cda5dda55dc76db69f116e0902f32b5e4bbbe2a2b7cd13c436d5d536d5d5fc44cdf3df556a0d57e19408a3f5e63cd20cc68be3fdde8edfafdb31e55845b196305f4773ab65cd9d24eda7295c647258490f477cdbb23476e7a5fafc38a9863cf30429457559ad097cc5643627d133e725f8f3a3b8e3ba2d7b7c729365a54e8f6a4e9941ba55b650b8c25680f3cc9d714414185d8ed17d1d35c9eb657bc081bcb106ec31913e403ca64b6d1b0fb07cfd85aba4b54efbb69d36e127dda5736f2953cebe8e365b205988503c4e011c2eaa6e071b23329bc89cc6eed4a8f67dee83cc8f7421a959f0c470d184b644f6616df75a02d5b8be168da2642bb9f0f1fd3eca774f00aae6fb8a07be3613ee288688b8039a7f6fb907ac11c729fb3892b2ebfca55de41488e76474b642a823bd9d4238770c06d1026fee5742d46f84b4e6fdf4517ba30edb4c7eeadaf5fd82984602a4bad7ccf9d91fd13534bdb730ddb0392fa558cecacb4f8373835173bc2007c62d5f94ad2beaebfd7607060df7dc10e4edb17cf04285e5dbb7aaaa0da3f6f9f588acd2471c47e3823cd7c105758c6ff7f4c1d2004732ac21d06586e281d46bc76325c84abe841d4af2167fcfe31dfccc71f7da985deb0b9c512198bf58c73d50a60ed3eeb224ce2f8bb707d007c8dab4e132ca12be3bab2218008c0b4765fd74294e632b044da936485107416b254e279236b9da8e66c1a2b7cb6b189d22367df7218bc64f6b161f1349f156f5988e7ba0fa16c299869b7b99b22727c793e47d80f9912e203b3cbf140557049d35f85432aec98235f82aaba2b8cbcdfb33e91b12e72fde33dc17056f10bcf5ca6ddf78a70defcb4960cb7da2c86ae3d22ee135faa251bce472fefe7079acb0cb9be5e4f7866c48114ae02ddcd898dbd127a00384a2303309e5994e5a19d1a34a5b4db5b2e72d220b385d323a994cb5b2e72dd2e4ccf86fc4b25f69b92d41428adcddcdb365e23cd0dd1da3926cb4953cb92b45aebf8cab14e8d43e7b530b88336b23fb8a787d857dc2c2766a8cd08b9aa338393329207abab336bf11671d5b72719c05efbe814f7fb046d3a443b4eaa3ec98c13deab4f854abbb5aa0dd54abbc7933eea34ab8617329978d3d6ebc67c91be902b463da9bf94fbf06adcea05932674de58fdecfc2bf267e549a88bdf3ca018f935bd9a364b8aa2da2bed7bac28d65ffc16c80c64381ce1692f14fa7a870b08cd1311fd134ee075538293708bf23e86cb9c08eb598b4a0f5771d7698d6ed8155a85b4fbd72b1e2d46e00954c642a8dccc319e7de2e64e7ca9955e9d7afd7f58e2b98ee0af19557268597d744b2f8de7c9f0261a7a59c79f014f94d281ca7ad1992b88fdb84f365ccc9a240566a82459cb9aebb4e09bc154cca278768146676564520dc130cc6383d540423883a197778045cb9125ee82b097e2eb072f908492b41d8058c1204b84fbd2a18398ddd6babf36c35643d1be3894c8d73de78e6978e753f1ab10b815ff0aa896fc5a7ee8fb0da14255f5c95c854bf89e8ac0099643a2ad0fa3e4b4242747a31486df71858e71731c07254f47e10dc5a94ab413dda76468ff3037616e8019a6ec5a9f63271d4bac0bbc3b648116977c5fe67a0d63c6a87226d2e03c8a4008f9fa78ac5c8f0a2c00560d7f9eefef8a217e39cb6051e23bc46b3e95a8fd8399de7529f229f3a10f5f73c27419a6e71f310e4aac22c966fe8795e7c4e4dd94e69de5cd9399e4c98baa5c546e88ec2867082dfa1472f141bca44e4fba44e3987e502e3c37af4588abdb8d65235bc1a30af434db6b28cc29831d86f0095957317e40a67448b4eb9ac651e23e2c39fbae23a34d7f3b354b1b37472b180d94898e4f0b509030e0f9b9ff80575e1885a24c53f9429597c2cf8f1dd411cb23d24479fbba0426119c73a747bc6191d5ee47e09d5fd6acf5bf85ef7a90e53a32ec393e27d363ce588e2a6c5d6df1cba4276fe7536a93b7a424358766ac0cee062604756cf95326f610a7071f2086b374545ebc475d1cf7650b15760252520cc58b01fe29c82a906cceb14b34df3d27af9e53bdfb6cfa1d0cb51c5ed8c59be8193fb8b8cdbfec9fdb0b1ebb8d2ed48a648ccd2b6fc83cddbd846e714a22ecbe1ba503a967a12d236eb1862e00c7f8ee1e47e3d3235d1360722cc9f23036f6f5d50a3728a9c4887b5181962173246c0abfd4fbf002e906db3c4bfa404e2ad0ceb8665c21b69c9c90e7a71e3d63104dc51ce8025f045f75937fe66bb6b62a770cf2f9fa49d76d18f41be08fb82024f121aab220627560a1851a24510da480b3446ca0c610544d362ca9f863126622d7633ae20460cf42e1bec14b202b283caaf3bedc0eed7b2b580d53a381d188c7937d19a2bced4c56f1c5aa6e10ad493f619d3153ff77c8d7758d84bdaee6e92f8516bb85c39ac580ed1d2143973b030ddf3873731f7e6c9d4c737ad97eed5b5f56806fa2dc065e4ef612417ae44fecd887116f5e25868db8dd016842fd4edbfc943883cbdbba827406888e9fba51098a3a4b7ed7c8544bc36c7866ef942121e897e88be4eef38b3d3b21d02b25fbd6d8bce1eb12205ae631da36d59d57cfa3c77d8c9ab2fc9e894c66213c692d7088f211136ef5bba600db807813569336b49e804338ae492a915d5c02c07e8ced4d1db7f6d1b89d9009dd925901e3dfdfcb55de41488e76474960279f1cd7a034d0d183f61fd1f457d6e253e8e96aab68de99e87120c7dc6edb95f76919b25972e08900b46bf1667ba54c462cdbe0b5ddba9879d35f5ca4f773d1de11c28a0a7acf39b9c6e9c342bcfc2f15b80ab74d9f911fd33f86520fa9ae36bafa75026d85d19b959394fd641da73c339b707dfadeebe2cede2780b2ff5b850b9aca6285eebe39c3b06cda534f64fe2bd93837e05bd52ef1822e3e6469b169d5336b1b36debfa6b5e3041fbf9c291cb2d02d3c164eaad62b9319de67c814187f55f3b3484165d08812eda3c5f6773889c722efc461e1aaf25bf4b6a5bad31327d707161b89a51b341c587364a78831170ee2d5c6c7e8e3bd7ad4777cda83080bca0871ef8841beda3b5c4f0e16650ea6e5c8a9e65ace853f1c1ecc67d58ac8a5b5b210e9880d1f04b4cd26df314b5437e0d2bb5a656b7aebaaa48c3730aa884c2a829e44fa0359b158256bf95cf17dd35a302c8283a6e5da0a7a10c2ccf9255a29d4d1ba5cf6e51144a1953ea47c1f60d44a9e121d7946b6197c88bba38089565d91421639e050885610a52164948aab53d6fe17bdd87da80ab5611babc39b8e38736254f9b909251de32fd629adc3fa8947474a3205b056ed56720a08448139af9cd22eda0ef99dc1456e22b07950a5f14e307120e16d1dfe3ac8008772ae6e080e476e63451e956ad113263686951ba592be4b82d5e2d88b151dd879a998b36b427bb5fec8cda70ff16e8ccd506bee99a00e62a0773c759d251cf2f58ae71e1c27535e70e79f50db9e77d53d8092d561d2dadc9f8fee1687505665fb5d32ff921d1172bcd8423e5787269d1cd5ec34a9da8d5adbb2663ff1368d56ab654ac07451a3366f805c08b5dfabafcc210e9d71690339c861215b98006608318bdc55f80ccbbbf743f8ea83b67bc0d7eff3144d72f2be9c387599f4576869742fa050cb57e59bcc657c4bc32fa1aa735acd40a9a3dbaab3a9fbf8156ac67cacc7b0b17cd57fadf7aced127999573eaf77d8ac0a05e5cad0d6600b4e8a93dfc19b513fd23983bfd31d7d237da9515bdfd4dea54b7b81ca35d7bcfa9ad513aeac257e0085b709229218ed0a333e74c9a90feb69d36e1d7e6295aa7411be32e9c274371261529461f793c4d09cb34bc4dbd2c1c85c5fd76f5b95c68d7bab67bcef00a41789955a799a3e7379139590b0195e95593e5a0b2b534ce94a13b18f338351b3ccb3282784d6161998951557eeeb69e86ee59fd7e22277ff643e7123b7cbab585ca737e9d72a528a9f5a0bdd33d4bed62d2a997da8d52f36a8d92d9834bc84bfe93fd7c78f927312b57446795f0fd641bdf4572c96d7a6111779835bd100e5faff6c5f44d2138c3bafd7c236d50c1ca9f957f96bd731cc9eb69eae9aed3829f4aa75a289da1b5f3d8f61e627fdaf68b4855b60ce96de29296adc7206967d7b052a76a75ebaded68fa65669fdec8e64034a72efdad218a736f97b22fc3a700a29f378f7c0b82ca2ac74806930a13efd3f08a7e09d23b932418bdc22c5ebe1b3f9882813b33a420611848143cd348568a166b40fac4bd76b75b661d8e815462cc8e8f3c21d9290becd20d0ed20becf27b3ad6d2fd88d29385ee084234191fd5d6efd359e3bdd792869616a7494ce52aaba29c3d286c562c5862636005db5ab5f0dccf49cfba786e019572a4e479cb2f7ce75bdd7f79025139c24b1b55ae00494a0c7924f4c734fd9d5dac7bf3828873f90d42462cdda52b8944f4464282ec13cb4e8cb21f01d6eb0ee31c73f621e1590482a14166aa2eb049a587303a5f403bbbcbeb8d81e51d63b2950382c4fea75c2bbb9ae876aabe63b6ae84681fd52e142670a5ce9a8cd0c485c97bbcd17a4a354b3437b0da4e7be01a24f0fe948c21d54257ddf7d5884d61003223b6084360e7e5f74ca6976cc7353fae2dca042a89a4749a2630bfb2b7bc27e6cc0dad2a45284d53a8e724da050bbe7a265f6541420e4cd083a0f344d036112f00b9def3f573cbc1b52b2fd6084c4f0ccdfb547eb830baa07c5e727287e52923c1b489dadab0039e99c9314989f4509ac7f5c2f3396f67c6da1272db12722b633655363ba6a1bd8d37c24ec240cfdef12169dc44f1ed6c53440ee434bf787e87ac5738c31dbbc7921562a3aa3a7d93e8649fdfc7b03a65300b3b689214f64e7134dff612e0124d4fa941139b955df85b169987b2081cd75783a3c06f895382d69cd19ac24975186197e8e6cb9070b2d19133765801519aa6889877c757d9a08a66e4c326df077fd0d7547758a9a4288ecd9e21e7c00a89be885f1f0d69c82a47d80bf09944e79e6a0aa73949c2407cc05ed000e5dcafee798c00dfa6c51bc4c452d2c4042aadb723bc85a0aa0ac0c713e588fcf73adf0fa83fca6c766bd220bba447bdd214b314f3831767ae92084a910715551bb24889369776a841bd85915c63b2950316a2aa9ae567de429420c8c8f4786e0183b250a53510f208bf9e5b4087029e9270884c0e49d727b62984cfcd631f8d76d3b308bef24bc3046dd26a52682132c2af64fec143b6610768cddcbb1dfa847705cc9961fc26203eb8f3f8159f89f9efdeedb8af1de93e1b4f571188fe01cc9820def3bba2d70765b1ca06e6b5571df75deaf5662cc8b2ac7603a2f74caea14ef39401730aec419d7247a8522c8e99f6ca1b122e2b4b7bd2d3aea34997a1fce3933e784b9a432e3d86f8df602e1f889862bfb19e4e196589ccbb501884fbabaa560285c2dc9084173606981c64fbdd789079517d38c3aa1ec25963d427234607a09a4f75831a746da039be1278cf224d238d7f4256f868ed3aa839b153d38481f5866aa0393aedcdcb803daf2d9501b55989da24ee80b356eb4a92e28325dd8b5bdb05a092865e5669a2e03a9a9bef89358aca6e3f50b0a109c2afee243b9fc88b6c9a3053ab2af5eb7bafec0b2a9146a35425c07b480fee1543bf4ecdf3a32d18f3db3b3fe7f65e091055a96b4925b0e4bb82adae3305497264eb9c7693947cbb4da3e3da7a640745e8203541b0b8096424a188f93020ab6a74c0bff720aa832237402fabc3dbbd865a18df61461b5bd7843c3be86e8854c5b331290dfb74e95e5ddd5f0028bfc6594566139d5e2042265d3eaec73d87484712d888bad60c881aaa1de41f8ec20733f455bad7b6060071c1ad7439f27a71059c4b4bb8505944661395665a789349825aeb3dde3eb71d8285397a6161f8e9f7a006e9d0b481d6065c27f5afd618993cb8b83855c7687bea3366e6f7130865f034d1d1584fc3324d7a1488557d40335933ac0785d0d384f0bc64b47f32cd2fa5afb18ed606ba2b7921a340b9eaa35a0a9bbf3c4bef0fc240aa355cb5193efdd930ba82f98c1831350ffd9ff0aa6133e727e2d8fe3172e6c0879ec63a5aeb69a6c6089912ac80288d53600f6a9718c2946622683e05359b1f63b26e3324a7fd9368956b5003b3ca15a72b1086d541d0da806b829550844d00b4733962fff6abaf2d38f7abfd100f57e8bbe0e72cb099001496bec21967556f6416fb27b591ee6a90de0f2ae1f9ac1e6b3edd226a20686da0b501eff5acd6dade9ec9c3059b573bbdae3339c9d8ff844f51b9680b679c8d38774341c583bc91db41c559b85059446613b1d10d44bf139d5e80da0e0295ca3af8b354eb4145e619238adef804dcaa079d2162aec6b962b6bf423e8c8856969059446581165f65047fbc3fe0374c1feba2fbfad5a76a4e5c77761830f1950d7d83e6a8f2aefa500be40d2e984a12d15e8222e47907ebd0b481d6065c6b2dbdca704bc83555ff4be062d324f8ea375184bcf6cc0feda20d82d8829fe36ca2a3b10eab35d61070adf791bee6437da276974fe46b39b24dc82d21b7040da135d6c030bc907cbc5d494d9cc13fc9c010fedca54905bf29e2028ba16541d1c89f40abb6dab582370e0c34c798848b0c13f47197f32bf60378409946828517f2a63486e1a265a29982e859fca3c597044bc0a34d2a2b56782406bb3106252cd4d378d2c72d7fd001a51cf94548702b61c0708b14f142b6ac5d092c33f05aef46afd6f8a2b66a1f2a864c441ed5fe91dad65242d028b13dea5600345d4de730c879ef562cc533ce2d93c73083a85d422630893ac3840d22e48aa5616bccfe6083e793a94cd3eb522b14883f0332bc1a5ffb3a778cb19a59292923356981e1362710f65110d81bf759f92490ee37c7506a9797b14c23ebab2e5d600802b447e521684afed9835e32c08b9c53a6837244fc01086a4751656ee8d1bbb4c5532a26c02ca361b93844bf2bfeb9e601ebdbcf7f083656e9ce88549610239f1d1f86f029aa5c783662b79785da8549d9fe06d66708123a84c6ed14801f76488ec74556f8a8ab50d86fea5e2031738efb762b4bd71d6978e84f78155878bc2e2cfd0eff4d04f39292b7b121ac9360baec57b764af374c3b569535f4ab50c4e6b0ad28b553d8600ca2a5eac0c1247b858da54134f86c2a0294d28d600c0775dccbc359977d41baed02063d2f006ef4114cb059984c7d590f13842cfd3554c53d30a2d68cc3e906e27a052b3989f3443b55e1d8351eacc3420fe6b6d36aee88da3c3e6b1142e1af44f48ad9a4888cdaad4df60d2ae6abd0c11b480b377ef638dc478273da208033a9ca200b87a1b7707d81a6f2706aa0d120603f61dbaba2c32b05839d6c0430eee218fc8f60a46208f5a91119e6f399cb56c787972ce7f92e4616d80cc666a6a66722e06d7b9e6d94895e666730f4279612247256ca50ad53d4148edf2e6310e5a611e4b2333c435ad8954e953b5cfef11a9fc523e11e423c8f98aac5e5e88b7e3b8a8073c815ac06950cce7b402632acd864cc1be9356c0edc3dc7f30d5a274a42fc3a14ac80adc89560eeab924c8237ec6c19591debb73286575fb57452df976694e14082e74ce9bc9ec69c2eff785d1a8c791a0c489e534088e519eb7a3b923fb4c7e7301d10f6841b5d97a8a1e0294ad1259a990878bee1a4b22b7ba21dba288e81609c3ab7a330c3bd6a981ec72d6c861fe1df0fdc0cd4d65d4b34dc9b705fc21922853359dc0169d9b2bbdefc7e507de4344f503cd531cc271d59d10ca192408a5560ef8e05a855f9ac01e06065a36df6a3331740311cd43e43830d658894c221fd5fc7c604483396dd3fa75b9d3578753e41eac85dd51f3c3648e269825436910b53fb498272fd216e4a173748681ab0d720f851b2f61ee62a742f3fc9630175f60b1af5cfe79eeaba2f49a7e7f9bea2a03e41bd2469043b74ee0b5aa899b800f50f9d7b1a13214bacb0f7d2edfc2f560960907163cef5fdf01719f1f3a3c406b92c0b0ba6e1cfa1a99340d167e6ee668033fd55502146edd309402aac41432a1d61ef588024aedf59c3ad0fba0108d22b120c77331666817ef78a05e0afae2bf6ce3bb101093f33056c386c5527c97eb88e5cbee7dcb7109af52e453e6c0d914082d06a7cae4155ce7fa2e682af975cb1018298edd732486915306fbb0d7e208db4578ecd60a980a74fd73388af67345d3a787b34a085b10c7ed50daad3046800416c8b2dc0c26674aaf7bb61144551744fcdcb7d5f87397de646c1ed582eb107a1e69a3f2f77073568f14ee6ae1bd46312dcc87306c15826a9a4b713de9372eae104e50a74908cc0fcbd29b00667bcc307a74bf0dad0f31f0d8039f4e580f97d1ff2169f56173ce7cc06af380cc3318c17b86c68a394f3629ecb5df67d7f7d5ed9e00d82c4921c6257438cee980a726c201b7097718c117897e8bdc3272882a44de3af117f99fe93e2ce6b5d2a3856ee7133362df61cbc170641fb1ac46614d55ec64262d7b882c332eec651d5ab589a10cc291aa50f09a473fb8eccee89857a68337558139055c4417cc784bb41403ae23341c1b2a629b4883c5cc6b871951eec011a4511c361f77f9f5fd7509f0336d8ffc61f1539172e3b97211fc692ecf137d415bd4b2075838672df2afedd2da8deae888d00486a28f85351f087684e2eb45556ccb0f76b7e0cf4c807c3f2567762299e440aa193adb9416470ecba32e1c4696720073049c356ca6621d8a08304b05bb219c0b998aa8c2a1d25c3c1f7410cbebb615ca3ba41c2b9b15d50242acd3a80015461763ed0ad2c3309b2271ad5fd7c1d0b73ce2246266a58a5d9a384ff50257ebc39eb39cd0376af6d2d53dfbae906ba9cc67010d1dc67a1952687823020c91dd02d2a97fe2846c4aaca3de5df6f611c63e352ddd9c082944112a401b7a86df01c759832f3e610a9d20ade1fb88f313e30aeb60fa7ddfae709e02e18cb28384ab10d831d501b69a68971b5e998ff9372d8d9f9760fea0d04c18da68bb2be2273be6b2037486fe4cbaa26ee7e2e453229f40776ad1e846f10d830ea9ee7d9cace1ea4cacf1846b09aae1dabe26cc2d40a81371daaa90aa0adbe87a7d3dec012cc8db410c63bdc12a2fed2463cc2b0ec681d042b84e7bf1caee9b1c8b7d1d3061806f539abd5bac38f74db41dce644b1e935c8853ca9100d7e1cfcf2776a5f0476b4a295952028a16a76e905f326131cfbc784cf4331b718627c46798e5b67f5db042048ad87dd4a562b60a0f08420fdf1608dd8bd5347203d3fa4c2cf463b3409cbfe681210811c9bc1934e299061f689e99cca736a90acab3b5f7610e5efbac498414e5fa7a3324d95e7678b2e00657d90fd4004a1cb7d9a33a648893ad46fa98dc84543c16706f11e7a3927a241f0bd0e7c79ace2287c394726a838d013a49d00c44b00d0e398c01e2b133cb5a7c121b782199c8953abc998b441832e7c8c097616c7292303a2dc84c154b429bc063d300e80826f591bbcaa5a97d0f1fa4034c6efee06f3de7c01679e4b3aba2f702308826858d73b703068090a000832077e745a2b2da3b25edcf44dccb0187d8ef3f9e21465883d01dc7be8305865b38d51a9bbed57993ae743cf35b9ecbf98c1504c8ad3f439cfeb9ad69765db8641999e0c512e54190ca58d757d1aa7ab3b35a3780ea544c2ae41aa51c13a86f91f920ccacc8ac9c564d2c85d20bd8ef2de58cc4e926399578db8533bcb3eada0610a1687a9841819c71590f1305242f33cd394debe7fb34cd2b8ecb04565c3255dcbe07dd56ce9c28a943ffcdd8365065882006faa24fb25a301779348e18bd2208a064c6191333ad620289cb6c32f406af72cfd6d37ef2b6166c8af3a244d8359d0c1784f2ccf1b180200dfb000ee53f525cc31ad00b0bd879e7ed8e7d1e792a93139dbeb29c94df688dd628f9297008263f9c80d7cd7202420d2db6392699720449368eb331ad083d22662dc8534a40ca2e64780b4def014900014dd653c5b4ba47370a13093274a7a20eb788c8009bee3595c50dd20b79128941d1550e63773b03b3d8388ba25f28c823505b0b8bef8cf4a9237074845f309d6b7c784d4b3d555f7ae8a10203d172ca63ee4be772a51ee7223ee200e6eceddb85413b914c3768a00b53ded470ca8a19bfbd02060f23773703748e1665c1560989d612841f9a4a63be369c9d8449f0787318c9a37b8d9f5550b12a3f704b1dd3be55f56036d637083c7456064db6436bfa34855558582dbeac3f39b5ab0b17d83f8078331950c6b937981f57094e8f0d7755dd743974541212f1352fbd8e8c16f8551741f7b94c4e90ab940fbfed61825bf0c9b5dade1b079e8a964eb0aa1f61f65e415dcb7c12f234ef6d1025b03f76461d58763397dfb7eca15ff5e943ccc9fd0868dccecabdf56a55d6275c841a89b4c15c74d06179d304ecb5f9f680da71ceb8dbd21af628234aa0f03bcb692cb2b4e52c5efa3676287c0dcd705a9c49982898d02a78602050b42046890fe9d503cebf3a37b4c09ba949b584bd1779555dbed07a27a0b827376a01558cdf915410c01f4949588799cd477f7b36f8028a9cbb6cf393f1bbaa093fd6ea26101e9bee7a47cc2c467f6ec3abae791036b0a3e4f24850118874fc6ba17b060b4daf08402a47ab406625b47bf7e4deb09bd37044fc9f0511ce7e08eb0e2859819d03889829fed5d387752373883206517f13de5f63c1b0947e1a0f41ef921c1a46c2085a4a6631261d7a638732bf6c0d3560024274028ee0dfb50d5cdf00cca9ab62dcb905cd1d80bda79a52167e2079bd218615e835a48e2de55eb9a8621a585961353f13b48d173fd448d5a5231c5aa00d554dcf43542c30b9ce0f3e4b2170224aa2167b665d8a836d7634cb81f39d728956d1da7f738c795613c4c8cd6350fbdfb346cd2fc8a026a1580bfc013f204b592b1414f50a2ca4c79a4b85ee08ac6f3db966e44fb599badb4a76bc3798e11de34aef98b1a6c46e62d0aa8687c0f65746d40602498717e5aac9e5512a4b9524147fd5b51aae877b9b668868370ad9a4155d10114502890b0f402aa013ad3414ac4b8b1ffe236b53870a035ce655983fa28d21e6f7b53cb505e6e21cd8609d7811d4cd844cba28f04573c2881ca4ae1fc4bbec9a56c977c9df6d51f97f14bc360309e547a233f5d4779da8142b963fb5986c3b3545a7acd2c5ea9f61d07beef20e3316bb08dcda8ada68e49fed3db14c5e02d41ee0aa750f4d9f6313e42d32f53db8d6f9888c0f1348955fa171db1aceb794555465196c0231c9c71142b2301cefcb682f318cb16adce2b6cfc3a8a689f2fd54e1aecc48f5ca1db9acbd6835aaccf42470c4c5be9236adab15af61c0f62601b7ccb447252e050cf1f0a87b49cf2eae9d4c4b7e20febaddef94d2c47975a4b7dab20d8d990a37031db989b052260b65bc9f985a2d1d83fe91a7586d1d93ebb88d0ff52b17de6d88740109f9b2351779f22f56b0f3d5a1e9bd4f50b10f7c985331801c848343f660357c4fefb6c834a753dddafbb21f4c5f24bd3392324388f94fec883bed2219d7854609fe602bb7b34fa7efe59e9ef67a0037a4191496162236bb8ad66c8bb88bf2b790db5318d1a93e43c33d81fa333e9477e53996b197b28da568b74f6d465d1954dc67568f8618f5400143e6b725c178ce3bde413762564f62bef0b575f1f06adfb600a18a3d392cf0ff757abe134e51716bc0aac567fd56fb333f139bedc0d66a9d46cb6c233ca97c49dae3139325bfd0b26fb720976729432663ff359b60776f1d6c1fc5c6c6e24faf6cfbac4c2dac6fb1df83250dc7a1349f27ecb33109bd3aa9243b27f17ee25a89f553ff75c7ebc79e90ce0e5a90c5a849a19651d8a3fa5eb6fea0a0ce1501e6b3c45327f4e26065a0a14e59d3a7a476f3eb2a641544942e60afbc34a3e83fa7ec149e64da89d0f69ecc2b72286e1b691baf6d32acd30e011e7c9903507147086afc0e25c05db38ef9a44526497d6c14eafc3180c0ad6b95ca32b710b2446fbc057c759a089ceca1cdff00d31c765a03f1f93a6ed54ab4bd954fc70778796576b73f36b710fb3a50b0357f6e1732a9248280247895dc82fdb6872672f460ac98f87169a6c13d422bfe565aa6cb93e08ee1c2e20433e639cb5bd66c33316aee70e09628e05330c7e147f4a7d63ba9cf12543441024b87763b99b126bbc4c64f1757fd25263d546e8cb55c1bae73ac8b96d298a6138d50fd4cc6d702ed98bc99802949d794ace58e1eafa4f400dcc2e7cdb363a3d116543c919257da3eb2c6b0afc3079ace2549b6f975522c6538b073721729d685a53d8862b0a30ba34d6d360498fdb8135b6c787958f46aef2f9bebe6b8c42b72ce748157a5f89c07b91b560fa443d7aec0ee64683beaad8d5867ed91edf9dbea95c53486df61418e3c813560041c814bdd1d9c5bcb31ae841b89f209c035164eab11ac1e3c7a6dd8416e27e7838cc86f1ecf526d69e35ee1e1c686f6ce8dc8f319254bbca37ae7e5bf83bddb88eae31a89ce2c5a48b00e4c77cc24c1cd450633dede4b55338bd1b141e9efa06fe80a73eded34cf001e80a61f3468f5cff85c40da21ccf40d0f713b6fca444f1e8bd66fabc9607c1e72e5de4dcb9f7967b43f87dd8f7e45f3ffc10f72dd6679bad753d0649ca2005b5d60c6117e983398aa21a7216df1caf10542aaefb81deb3dde3512e32f7cbb42d1208723d715f3a3546d23c55971e86e68623677848f3de83b19dd379299978b97eeab8221c8a9ba5e8d76c3cb378291151b441600e593e10c3d7d9e9cafa1526e9db2ff2711f5e9a49ca03cf0c398487052377a316335347aca71738fe9d1d7629ea535eef9c691490a38210b8d51f8eed8d5d1788984df59a1517eaf0ae5940b0046b90adb3bd6c25e1467bf04dce4bb5c5d580cf0454eed7c5755a5a55e2ba8ef9e834adc37a0b137047ec60f2e0db4b723b35aa7c68beaa811416ed98b78e6ef84cb62492e2add2407cc2234558c031b26f3003c2833cc3e1b331e1b3fecaf836732ce36feb836f357cb1b4829f4a60b8fa18a99a60ea9a1dc3bf4e57605543d4e0679ae8323570d73371aa98157b50305c3a9fce7557544e481c1028573cb5fdb32f0085ad676818d7af116a9da48e951aeda72ed973cc1a5ab5a87033cba4a4eaa3ecae313daab4d5542b7bde22b280d325a393c9542b7bde224d8e0cdd33c28fb3e382912a8162a4f601a49b2a2916183af213b6f256c36de5d48c9d68bf27c7b4f3c9c6a66d562cfe2dcfeefc207e8d6346e0633eeae05ce5eb140fe07d1a9e3df86ee8ccae8ed8cbe8d89a1ad722a01aa569d2cfd6d59a49b550d3fa65dd97ae7e0071e1e18949303baae5ccab1871d115cca9f97830b7804a0679a25ce389e31cdfd8255dd867212125a2a4eb4de9d34d76bd9e27b4bf01ffeab7b7c857f0045607d2361c8a9d3b8c922f6460d2073a9efdeb268918f64fef7eb2c3eeae0e69b229fad5c9a8e9a4c035db672b921e76beab3ae9da9149e02d6ceff7e0b388b2d85faf0279f5a0724eccd1e40fa15ae3a737f3a6f2549bb817be1e6156efdad4aec8079f9c268b4ec15381e56047d04d4213bb20d09e605cf50fa3488fbd9c6fc17bd851345cb3051db7dbfb333b6b0237dcb78c40d5471fbdb824362fe5afc8ee39e0431346ae10eb173bac0ef8e4f04112ec5d6456860bd923a815bf86179d4f7e7b606803dd1f4a03ad8e6c39f3cb11f191872ec10f515d3a1e2c9c80b31020cda9137ea532b4e42734d12b01939873e9d8b8c0dd0c4788a86ceca030cb8573168f279f2322d23a9cb5c33aa5a7d12d2c68b9d254b3284c4ec335f82006b62ed1d0e7b7aafff879b51aa554c989d97f31196e47a3b44dd426901f1d5bd83214ba2318d37247e8c706d62c5aeb6a8da4e9838b0b03b0468d658cb627f772112a9e28860031977242f60785bcd18b7e962ceed18a78a4a5af2bfdfb1d6bc8181230a8007b222fa58864b29425779ebebfc83369650724558d082ef90bf8aedd00da16761faa87b1696f1cd1709d9ac35224e279101281a30406ee539aaa2a2133690bf8137c61e38035ab275e3ea033bb7494851e9cc46aa1129dd12d3a6d8d3f6055e00f80513e9829fd4bcdf0104cd537cc3ec65e6f6f7e404f76ac689dce6e53df7245cc567c4c0e3f774d1fdeefd98666965ab9348151ea95799666aa5dae82c14ca3ae6d73884e43527d40534d536f2ee5b07f723f6eec3ab6b4f4485ff4188ad303939ab4519e7ad505a38466b24a64987ca205b6cb76e3e9bb1b6f9eb467ecb76e5f9a42c96882bb2af4606ecbdf395d126b788966428dcf6b678d1108af43f526ed4bfbe5a7df0cda1e87432876cfa8e6a50f24ce37ceb953cc0ac807323ff29463918a596a7bd699eb4840b4a6610b007db2e3953dee4d510c344dacc989b1ac48a1ad3600318902225dcc8c8aa1ba1f113660c35af44c890b1923b0b5bee36f8ebeb7cd3bfdb32cb7f2d582b9dd373bf0cd8d4bd7087047248bceda635d2d8c7e11f6d9b193c90b5bcd54aace3b59308b64e7938f002eff1384d6a245b1e3db0a5a01513259aee484a0d6fa894905cd6bcbab8559214fe31a875532b2925ed8b8750c817644b36bf2484bc7c336aba5eb374d8d91b759a923187fb3d720f1fac66c320374389f9b743a454accb4bd6d5d6325678b7b4c9504b1a11fa3ee276a23c39a8324eb3dce6fce20bcd69ef49a850aadf135fdc4f7b3c6b6246207bc7871eb2802a69026332fb90796f9742cd24d65e661eaed107ac7112e74d2b0e6e61b2c82ac356ce8e562ef9f6742250212b210843defcaabf8bd668e1c65948c80f31574cad4d0dec2ecee6212f0af160e2b9c3740db124fd306df5216d9f36694b77ce46c5b493e13f8313c4c9b829890e2722516c06b026d97c072b516877db255e2cf2b3a00b865ce12e1d60d1d0f689e2136f91ddec5cbae3a3aa1b1a699f34344210a5408d9ce78d3029b8acd4a4cc381fe61c2de2e87b730c3c3dd037eb593cdfd5491ba4639907795a739c03a5f84fec4ebec4e16fa25607b648f2519561ec8fe76dd3da16567d73ecbdae85e64546260dde32e1eb1f943643c7b6d3dc11abb1403926119040a7de99974d5705b25741a60d1a6fb2a603df1b70a21631c0d33470eb2c27d64a7e9286076f8fd1b688ba980d0f6dfb302a22083a3244813e137a8a07dcdfa7e269eb8488cd014f7c5673b8a03c2053b4cef4ea3fd2cc3c0044e3274f64fe2bd912970ae1c0266cacc7b4abf07b94f3dd88a8d4bb4a6738d7e645ae867ea8078237674f00b37e5a2a8c0b69eaeba48bcdb00179f6b2dab47f2bb01ece950685e7c78fb38e34bbb4bbb8e4fd970f60084f1f357bf0f7a23cb1c93d208ec28f97eb18d512a0036c2a2a956a7223f928c7ee2468d76fff369eac3d669b4ab8aa604d9a4f274bce4401343c139265b19266538ad171b17906a6d8e42523a3eb906c01ba89f50bef7d9e0a1de61914662bba2199b5b8c1c5bf597d09dd674f20945ee0ca549d854f5f1b000b5a911d29214efde407b42878dffb8baf6f0b2e575d6be979183323179bf54d6f1f9d6fbcb23b53743ae876e2898184f8884404378fc297c2a5d52c3cb6f9cdf41c935f203f41e00063b324a0a1f2e4b2c2d5c761b04f17fab85c671322b2957bf77cb4e765d90d68e927b0be5eb77068e02a9ab4f69aaaa589090ba32ce2dc3eac85630af2ca54e5d8e4b6e060c7ceb6d16270a6f186201f48a399332e817f5e2a72c6af814a53155d9127a5b7f2b84c94f1465dc72e2144e0264986e9606cdf0cfcd9baaace2fb1a55ccf5592014185b9f8965171667d5e693e4b68210472e34f7b5fa51def9310c9fd3a1f8d67c5739fabc39c1a6defe61a03677cd39034d41a356b786fc004cc858d0b1678cf1002b52b89049205eed7fba6a32bb565ecebcaa85f08623e06caa167080a7f654ae3ab52d77c8c64d94eaa8770ed7cefdab8ff2545d5823bc8fbe0ede7076c06c3c092e53ab156cb7d44c7206c8c8e83700066914ad0b6a54cedcae4f07f539f72b4068960b48f377b5a78b07864e65b174ac7466e2679e28abdcac3a745c744573003ecc383e6881b405192a9c296901c8f55ec33538a5dc615e9cb6c7156ab657f6a5ab25176c1e28baa54c51b50e9858108698f7ec86a1de801a232002091a97d3499bd3691e024034f4d804c7d8ebad83af538dcb38fb8045e12fcecae5a7098e1616d2b0d778d920da157a3016ba0b99eea8e0b933ce4a5cdc9b726bd4a02ee2804ed8d91c1378bfc1d000034ebbf72acdfe28f57397c75d5aaec783cc53df46911e8bff09b46a35e38e0a71218d1933fc0a404ddad8963d902fb77a47b88950f07783486020d1d2692d9bd561a7a343e4471cc61d725c5149a1629630a3aef53d45e33b7bcbb656aa237e4c782fb4b695dd4731726c4aeeaf8a1aba3b3983a14154a14edbd30c21b63f4de6492c010dc7195e58ed3a0d541c28c5d405fd9a415371fc79bc06172a37dd93ddfec9e77698a6044bfb8305831b635bbf54bd4466198e9bcead2071b0c775bd4a40c33556a0a99932b64c58db0c75e40c8511125319c5143dd1143e56f42badc73a9ed940d1da6fc38ae83b085bfdbdd03f9bed26b6245a46d12e02ee8bdbbd0c23f4353c9590f5bd2a1c1062f47be228c639a7991549ff0094bf01179186dc8542949ccf633c07d71ed42cf9d59d9dd055798e97c5670081a5a17b858c0231aa0f68a7606714b7438391cdd1c2308edc00822e3d03c8771272bdd8a122cb1edcbd3bd57d0455170001f12bd8ed2845d1bc70e0929457f52181369d7764475df33edf2e61d5576a117c2c7904c1b9bf5bc7e9733422c51e82eaf9554ee9cae5533683f30aa44e1e666e19218d2fdd72eeec1f28f92290b0ae0e623c32858b668a325880520618090754d785ccdf9cb200269f6f400b004e50a08fc83ad83b214215ae7f850c03a5aa4f696e4ce9539cd2b3372c95c9e99b165dceb79c63bbc83627b75c1cb96833a2d35b66088a00c9ae4171c942d10f4c6a470e838c5faded35d609864ffa3378e6d39f335305a28d36943fbcb3c6adcce6ca6c7f6cb792c6445ca7b54d2385bbe6dfa6aed3c8c1689c8cc16e53e7cee3ca36460ecfa24609e837ceb78caff50b506c95a499d4d7815d65f3e4a52a5420da4f63a828aec88cd7b05c7f69f6e8aeec2af9ae499dbf93cdab684e5dfa5d6110e7dca96659a943b5bc3a70a62cfc63621c51182accbad10531b11ce9d9a9eb84a236e4acb4aa3dea598873385ee12c80b94f5ea64a0a86d02b9758a60093bc96122bfce54ed80509e4072692365996f8ee18739998a4bb731daf992c4f3b51375b8668323afb2029fe1966752cee73f96ec3665f41010c153736f3dbc354e8b88de7cd589025b99198f157b7c98f0cb2b938f7936ec5985da37a90b9171645ae365e3e4314a170a184aa8f3cfb9c328131d480f345e699e58e00c6717fd47805afcfdaf8944e157599b8bcac7984b25da8a2c616a62e6aee9bc553ab6b96302e951331d873a5d67047e63af4ab385d9fde3e794bc07c4140c629f4ba964175f747e63b16704b0769bd087718de11461b5b2008ee1fc55ab9f54497334d9d0b4585be42359fea1635e81c7673dd666f2cc75da97d0bfda6774afbac1a2762951f451ba2bff026d32ecd2132b77d7941c431ce6b182c335b9c984c101909a7ea7878a8a878a4be3a3b05f52a728bf81ccbf86c650833a6d7a5b323ce4e85dfa1fcbd90af6b7dc3ceadf2b92050eb303b40dfcd439c4383c082fe3871c1a2d9ffd190d51d268a31d9bb56530c42ec99285858a228eb529ba91800b2dd112c379b72adec3a73c9b2d904b9bbdce6dac1a216ebb34d52c64ae5d028afd697be27f1ada410082b89c3efc3f83e5c0cefc81c29953526ef12aabe5a59c8c6f59199d2821369146a8568283167a160e41eb5a13836f27e0606873bf5099c357d9c327fcaa10b83c331e98beb8ddabde3b335c4d6379659004dc62200ea701747473fc4ec6bd95aca29e0bff82df3293d6a0f4a1fb754601e7bbf5048b3d81e130f305c3e7a4c75d0868fe0631c285c3e223a0cfaecc102fc2203e8ba7d7dbd815a47e201c60ca05bc741f4710ad43d43cb74ca45faf578907969be4517824737d907b3a6ed5469def26a8d72ddae7a5c17dbe0239b1548a1d784026b29526784be26546c1e96ce16bace167a3cc00726c6ff9cc5751b044d4ef0e2b102ca15435026352c84b28b149420c5fd3578fcc9bda276edb7a52a107bc72696acf7d24aa76983df1b39e4cff613a42d489ba1386e6cd3f3880ba20fec1be87175e27a88a24e25aeabfb308e26e5df8b5b68ad6b323f1cea2d0deb41b90005016beb5341e00423274fb4a9805008a424c5a6186ee14d9ed5ead65dd563216c101e9a7b0b67842427d6e37f0318bca12a8fceb08dd49d2066c7e4e5fda8a47bbd7dffd42e80e491d9278f3d52c7d8aade0e187da24924b36b49072693d52f967af739f01c89a83d405d1fd9b1e46f340337fad42e38ee9e525cf00ede919e6bba4b8143a96a82287b2869fb222f6126833db4e4d960fc13509dcdc16f4c70b68b1c4d5ea8e46e0abb1f589a491346728ec9560e143aa8761d85cf596cddcf5db04f471ee7928988ac6d3106092031f05c3ac6ca38186d4b23b2e16d4bcfb8f7693187843ec3aee2ec704da4221a97d79f66a7b2076f966038499f6022bab909aaadd80af03d06226477d218d0904674ea59ba288fcb0bc2613d1a0ac6d02bd48a5f1ce20d5a618f92770f75b1fd65f8cf1dee125b4aa1c93cac66bf1f43344660d283ef056077fb5e8f7742691c421e96c075c17d2cbd713d28d3ec8c9ec6faab2b6c6a764452ec2db33f134cc27775ec6d7db44ba4380c89e88631d61a765ec9ab0063b44039a959c345b9a8b84351c1dad73bbccc9f7df74dbb65f1f84847992cb5d084ca117f6c0e7e630298dbf86a8d7d8e5694d3d1683fcbba8ceccb79c3f238a613130419d9012f9c80b33a82e67a5a11daa9762879124acc653138dfadf99bccc78543c21a888073ae2ac4f9230bb33cc665d2d082a2a90ba5e1ccab19bae7641f094b59d18cb32a8f95fb8b1df982438f2dbd08ff893afff0359a6ed7114af7c4ced7b3c716555512306ffd3b3654427477fa528079fab1f4c511dac2745e52bb86ead49a560884ffde836cf790aa40df537851545f3522a90d820b20490e7416d940d6fbceadcb9781490272cfcc3cf27bb6f3342f1548c69841e5f66b145284e450777518df61461b5bd7843c3be86e887ba2f9ea40795d687b8746e58d9d4250f1852051026e09b9234600406ad2187da16b22365eb5bf9d446fabe1633f5f4a6fe1a55ed56a5ce20f6bb7d712a8c6f4b56814119adca202c01118c21914043813a53fad5e5c01677504cd75b436505ea977563c0a184770eb1c9cf3d28aa88ab602012fbdbb1518f06ae37a6e0f3ee47f0bd47ca4b354a68dd0efb7b245c416546b75aad0b501d704dd25f55dc7199c17805deffb1e4a6e081a26eaafb17af1049cd16d5925e4969059dae1a4723298382e00bbdef1c398dc10aa7884cf5901675ecdd71a02ae09b825ea0c50c29fa159bc99f07e843fa1c397cf2a0de05a0ddc6cab0e12964ce259b422bae66dd60f2be06cac029d64a3a03edfac1b77ec81d6065c13341f8f6fd8212c802b0f51228d29485088d138f9da2aaa6d2aab61fe9a151d36d6fd41b4a23fd3cf686743ef7e45ae569ea284dc12328b3f086aa08249bf50fe5b927bcf34eb118e168af9c3b1037498792880bea26d761768ba758a299e68acb6c735d1ee2a907e0f2ae1b9ec5e2bfe39c2ea20686dc035c1e7352cd1da391d49e42f44ce92bc82d74fc44cbded10fa554931da601d4c8a209d546b7bda20f714f5a4c8c3300ac3aaae2006e30a3861336e634a396a20686da0b581fad3b6469b03ae93de9d1f57902012e2b16068049c2989c5891d8dd6f2679c7bacf19901675653dd76fa8afcdad6d1da406b3dd6d898d7736de72a75acf71eed9c0bdcbc9ec15de3f881f2aad109bf07eeca995f631f194e6df2e66712671471161c4e1e273a9be86802d11903a3b581c612b6de5b0c7914f360e8c926c51ec9771e8368ad35045c137043c4fc1a6615953d3008f427bc1af2ab9ad612c52ee49a805b828e289dcfb1aab27cf11b082fefdd1fe82d95093e5d7e40484612467f574515c26f4fd0eedd122a26931427040795f00a5fb4143d72df7102544b92e9ac8554454916c651bd89bf98c1b83f6afd5c00054bbfae5f5e124e9bc7b92992af96f11b2db14ad9be3517540a80d624b3dd4173c929973c28f38c22da8a6c4745c94179411546318a3bdab051939409146b45c4b7a563d2da074d73a42e4d20651f3e59223871ce9160c3198633ce02025812c3c7c146262c1c6ecadbbe481e37e96d18547563ecb417351103dde8b2998e570db5825995968963386cdde0d76d02c6ae2f108dad54c9c3875a7c7aa6d2b5f78d80e1de4f599a0b2ca93d5d35df2acd1d4c69eb1432832daabaa85b0007493b623fac640fd2d240b886df5a7936cbfe1a36beea3e07d0d24849a94fcc0c848a2c42ec96aa2a8a00c7bb28abcbf0c38b56fcd926f0357b7496ca8b830382953001b989c2144b805d405e499718c66ce4061a8e1bf5e7f65c7e663b36e85a3819237395dc1d5642a0e2dd248cbbec17d7fbc2d5a53b559d5975a552ab8b5a22c0dae524cc27b476d07b46186cb8bbc73d8ac1eb9002cea60e0d0a9a110142b547516522663a5cc783d679279370e97263b7c8b1f14f0c5d0b24b2250020bf32869b61a2793f362e8f01fd433e266874a0075b203b19ca9ee3aff60a906c2a59ba6d3a4c5d02a4bfcb73ccca5a2d8778e80f85d5f61131aee12d1b0429c0bfef9b37d9f39bb302a8ca12c03010fc3e1cb940bb0ba9fc0914bde1bd82951d49ab9fdb0d2da77ea4e463bd8149d0ce5ab8d7187321546bc6614d9810d75f4e2910ef94534b0a5ae601ca143ea030cf0beb2a023b14ab5270b80629ed1a5e0804e4a0e20a83c7b307a70cbbc3b1a2e635c063b7abf5a89e7711bc4280eeea69e002f638033571a48b10f835a051c1033eb7ac6019707d803e68c6313b0bfebbedf473a9ed28d3eccaeca01b561d4eefd10dd39eaf1947bcb41247d26259c2e1c336e59c44c6677c0282e696daa553211f24f7308af2d09fcf74dbc64c4e7d236479933fceb2677bba4a12da8bbce0d13bbaca77b26d5b57a6fa46c8f2cc2ba059956d2d00729461220ab4876bb2e7c956c8b5da1fc3485dbd446b4f0fde1dd717e9d2710182160cc3966e67eef2ebb9f067f30f3f488271d5fba393f38f5c2af0aec9d30749eadade9215a754673edfbb5c2ce3342e09b7c7384447eea697ba601bbc3f82fee82707d1ef231542d38c76086bae179894f79e7cb6bc71e4ee1aa4389a20ebba302c822007f4446fed3456dbe30bb5e851143fcffb9264ba9d8f2a8e4baaf02d07b409dbc0b48b2d05fcde7bbc9d8f2aae41dc25019a3cf3ee43354b974c059b82874598b7ed7f775c7f047665750dfa3941032058af30e60f2883b16b9ae8808ec9a9a1ad25a0e4f40c72c544d22000c6fb07d32048ae0fa81e6c2385d0a005f309529f99b345210db36c83134e00600dc2df0c7ec37595c453cb763ab1c32ef896104986e39766640f0d0048387a20350038330c00388ee3b0c2f7204913b4f41c3a47335ec5990cf43987cebd0d0d90251618fb59b6fe57cca9222a1713f7f8c3d14269d24ed43aae56852382f68401f9c1689324cdd475c9950c40e6f1368d2c7a477bf9bd3fbf32fa184577025642103d2a704a7d90ad017c845161676fa78132edd53002946b842e02909c6a001fc82e54bc9b724b9f44410f425f1058417be6f2f51aea69f4f27799e72b2f41602eaf5bcfdfc3bdeeda86cf76c8d6ce045fdc82e4fb6e9300fcd571bc87b9bfda2ef8bb740404d73c98a74b055fa7000af7b47f555dd3f497dfe02ddf741080d40dfe20b07c67e7146b4187c99b9e6a1aaa619bbac91e54c872d592b92e4834bf49fec742e81ba37118bad4b48ceee06a5e000000000078aed28865896c0ca68b63335f0314039c020ec5037c1bde03db88008de1e2beaabc1e5cbac39a0b2c6ebea078f3506c37c5182c29dfe464a6ec4f0a95c99c71a5725a4fdf6b8d7f180a9c6f72efa427f538fc7d414671d43c5f839a91fb0d0c01e25d4a05b4655449d31c770a86e9c4e6f7eabf416b437758bce7ae74e1569c474d73dc29187efede233a46285704be8b5e637dee5414b8f6e7bbc576538cc1dcbb0fbfba6bfe2467b2648a69d5cbfd6de0c33c720d027a75d1de8081581462a926889ab2a80e89ab6a99e34ec1ccf768d330b807280f0ccf4081a3ae289b43e1aa59e46b50334c27384c5e838f8fd424798bbff0e1545edf6b8567e4baaeebeabc2fea66f27607352897d01abf7760c0ad62d8f3093016b63f53906732c9ed3a0b4a274f9a5d39738f7cce2c181421f1908b0abaad1bbe1e8c0cc663f4849eb319c6c9e4990113c2a05d08ecb51205875ed8ab7023938253c59c257c06a8a039d74380caaae0a9f531fb3d77840fa3a9dea43fc3b77c9120a14226b6fa2ca0356c27a64a49eefef82974518ded77049bc3586e82cdf44ece5391222884ce6ce3f72820831d4d8f7755d8d9fd5a397602b69e8294de8f333daf578ebd1561cb3fecc1b3bb2eae1cdebb0131f2fa675d1538db4688924ef19d69875303d76a50b6b228c050646e1f9f4da6fd17473fba266c9543d8e72e5afbf67d10884f058bba333eccbe466b91ded19a226f14264150be1950c1e2186e8873be6dd752eae8a6e6d49184b3c8019258e519e948a2fcd9247ceb5c7ebd207c7099e6bf3db983169065ad8b1068ec5336c8aff47954b58d02eb4dde6e6e61a77e10027edbe08fa767dc5904632440418b5b1ca43dfbd48f97c6f1efcc819ece984b4741a7ea68941633b8685312c0dc561111f84dec742141902be53c099dd64912e4144aa6993118ab82d598df683f414c0b8a015282b41d124095b097c3a8472c0cdd89fb8562ceca54957bb5a0c7426fa0605406b30a97eaf114b46f270deb386bf0577d281bbd2fcb97dea300688aaf6595537cf0e30582413cf637542fbd75377dc6e0ce32e9ee50311096ee7bff76083b6fd87c40f1c653a16c41e1b61ecac6f360f825765dd9a3e9d7324885140848822307aee183dd895bbfb83065c2760c7afcb1f1b783c87d1d998cde1d4f835fe228d63b8a18b24b2578d04ff73b63b074c14c59c07c0541a12e410df56f0742df1b40f40f45128521ac68ee33a32281fdd741630d81452c1d3d4aed0e80828d1adeb31dbfe52ddf2ea5933646a59a7ae91a4213205265d80e6edb765881c983ea8bad782191244992ef89e76a4b710c378fadc35b6cc2cbf27c1dc05682c0568f2b8e13bfa6626cf0fa6ce02c7d32a0e397b604e0a28a9499c1dab541d26119abaaaa1f0922294823d5b7c7204d1c6036af58bf140e8f7d1312a39eca028fcc88af76e92f7371952c97b2d40854ba02f6de59e87a89708a60e9c77f92bf8921331cb6aa93643f580d8f918c0916cf21ce2e5dbc9004078b7c030fc37c68280b1063fbf3fdb260a7b624f123cb42d5bef3552607b3e081bb00a1c324b8aeed24da5a18fcd0b8092548b1e1c67f415b12400aaf1af1fe3938a865f06ad78469ab06d864400994083b8fd74d1a8efa99aa3d1eec7531fd52daa28f9a2d03e7899cdbf162e535b67f1d0a687479d202f644ab8f06ab46ca60b8a3201c6e3c8e2fd4adf26fed183d46cf1043692aaede389ce4f35c9e3601b874044285f21bf1bd57d9969757bad9efc40729b042a84a2dc610454f20077e7828a55119c7303ac21e47c04ab6fb49a46aa3d31cf26148bf96176c76dfdeb4be6ccc1d57921f9e8ffcf5f8d9550ddecb8b2f3eeff299ceab023633e4106ff0782739d2382892ca2e4cb866c15e6d34b15d7a5d2bbd855a322eb9633a851ee24eae900ef88d14015191466777e8488baf82927d09dee08cd736f8a68b4d8e3d21ee101f98eb243326833a4c19490960d619b8a420c41fa7590a98c1accfbd629e35d11f40e2134571749da0ce0f0a02e327ebbfed9a41e9568dc48e6f788e414860acafbe41eadbcceecedc3d986eeb6e019ea2313329405856563903664e96e77d59ea3323fb6612847d551b06650c5eb6efec3d9da2aa041e26ccae1504ceac6daf973198a256ab9eb58f028e7707c18a44a736c579861965c76fdbb96a51acff20b26cfc684d1e54b6265774fc7785c97adc58b44a5e81f4b9d4103ee2561bce74f9c6fa054bc9882d445e394889e133ce41c2283baeebee0fa90d044df5cedb1bda6e0015102ba7147beb86cc4ab70cf2f8d10c0ec1763f140113e89cdeb8b3507cf44e80c657d85c6ddc88033668e132079f033835c1c227e81f091f14c8480c735b717db7b5fef50010d707e4c90603b1a949083654f7e800a1b7231205316d7592cf00e8ee60aae04dd03aac77d5aa891314a664f74c433d313b1563ba9c7c455e888120798b3a6b063fddaaeba7b65f1f50da95d31ad1b66db29e778e2431c0325579fce483eb7d5315f51532435dffd55400ceb8edd9e8cdcd074481a0cd00fd65580cc44c0324aca955d7d9eb8a4eaefec0f08accba0a77f41bdb4744b59011425e03ebba22d11f4c6832cefd1ec3b635defa0a54cdd13b47088529c7299aeb513309a4157a80019d6c98dc7209c64360b876bafb059b95b7e216cb0f38225484c61b854610af864d71c0dbf62503398a04fadf05deb1bd08c34f9c322441c5897dd3e9dc0c87f841c5ea8aae06d8bf1025d41f86012435bfd2289c1caeaecaa17f0c27c896714412bcada2d6634cc2416bc5d6c75a11e848085d708f61f85b757dae561afc6ca4ab905bdad28706198d19193c5b90df786b0ec9b0e63ed6713279884c216f57b46231ed87250221dd3d63b70304593e2fc5703c4bd2b67d231bc07f8dc424e1caab31c654e0bbe34e94c16cd246e20e93942ed9f8f57343e1a77b0d6542f73d9f07b8d2f71cd3b5d6244bcdc86de923c2b9424c475e38daa51de68aa4281e22bddddb2b831424c079ca70857ed0550568370d8a92c734d2f19bad2774d663be2a17d99d353489a63e1210f2b6195f70803cf0d9920f2608431837029b515d4d0d3aaf159a8159c89e6f98a47a2488731880ce46bbadac4d6eb81a38bd996a03f65426ee6305e6bae43a422b70329da8a8d00d7bf8ed0db0cf5c4b0b2ba7d542d847187651595d85d5da1433ab151da6633022d346212ea2c03c079a212e4c717f41d1cda89a75b863edd3368912599c2a2441c489455d359f2b8c2f1d00e0221a265abd7c5de3f7a6cc580c35a3bafa03d7b7eb54768c07284676d1abc69ad01101ca411379c5144bc9760ae19d5f8b403065dbd930dceb3eca1c017bc3b297907b036968ded15cda5454c3b9f4e61a5a8991560dc761939ffa95e78c1ecb3604e5188c01c43eec72c9a563da50ede1f801e6618bb75c06bfa5660ac6c22d8b1e58271ccc97bced530ba1b386570513f497f7ae504d1c9cfe891a86928054ad56dfa60cd3e1103c7f21f58d8b7464d8310e66f07ba4544c7855eb5a23c94fcd3f9e69a798e9a7099d1f1e503bc58735544da291847a8f26a6262cc58882898137d245dcd341334ff7bf836bb886b1ed79183038feb839914a9b539697e0b3497fc8ec8d2e6b79cf6461a284550cbf252778a50bf64e0d3579c868efe9cb678c9248cec35a2cb839ef1a1e51992e2000ad7dd65ec3e2194392c53330115e0d15303551926baf41e42306b713d8d68af9a33d287cf68fef3644ad1166a2230f5ec703040bc671039c047eaf4929c09db8e214076fb4c38b419cd5759163f8b5d7fd5b0159fb3d386748e7ec551be5e1ad28305c00f0bde5566a32e70f97e2aaaa2ad77c7823c81b739715befea360f4d8405054382bfb106b2e4e024164bb621706139f75c3439046889da67ac802f1b447a9fc25a35fec4b94549c2ba687fdc0c8071372d5bc4844f280819c5f2d7936bc8760c0e156bf2cf8e81a04acd7b840a791998f3e13a481505f2fab1f4c86d7ea5f05e5806b05d78cb6cdd35459dc63dce14c5c88e03f6b3b6857608c08c919350906f1115188bf0f24f0bd69e8dc16a33f9c13732d7fb67a049be7fdb3c22e214755a517c6691a49480eeed1913c803b5d3310c3112a9dc4655c67e8b63b758ee8566d671a3353f3ea511a57531379cfcdabf74431e903b82babceac589259cbe7975347f5a8f5e9fd68c8b477c51eeba9161ce5357fed96ab9079b057eb301c93ec7292db4e1e8b4b5453635ccfcb45f5cb060b188772ec96961f06fdaa07a0be3dceeb5053daed818228451341995f69cebd1133017df7c04fca0906016c5644c1c8620c10bd13c2df0bd7c62c4ed015fb10cc469b00317a5544083df2ee041f4d46a3e40a26e1f24fe9f0b4d27fc41ce199200f16632738d281868e3113f62ac35258873870c0aa994ed6232e11d55405a59ff81279616f69bc8b603c3716a3ad5c26cb3abf35247d3aea4ce4e4f96377da06baf4742b869fa843d09f675969372891dc7946f97032e5ab822d4966ef03e5db9a2e9cd3668e653dc1e5da2a6e3398f62846d60a940cb88003e2a1142057525e57d2de6b443fc47ba3114f193dbbb250d392efa10bc7d6b8466a287e21e721b8e6a1a027230f71035054808ae5dfe156bbf01daf73bf0429ceb85727118cdbc0751935a68653136f2ffaf186c424ee1d02929d934f2bbdb641f302e328ce648f13284a8cc22ea6b5bb73112816850d981da534650f11499bc81c13964452846b556d641e764c451105d46f1955b282ba1c509b92ad4504bf062f4347b8a755c83d6cdca681894f96c1d167f282b76220c1d856b91435eb475d53fc4f4ca4d9f79d71bab98d75946729ca019405055066bf900e9d93ce46512819a4b25fd808cb393746f009c27ef65fa130be77dd1a31171c5c527cacfa5c157dbe0fa4c02cff442156c24210b4acc344b802619cd382e09da10a0f05851a12768cc2b68ccec5d18ef9b52d5d5565d73ce842022ac8541adfade4979f58e2ac34a7500af7956d3dd5858f7f8c5b938883db4c145b897d83a492ce0837fa4953c68e4230d70ccaf649e44c91c1b8b6246d18417b208667386ae29040ca289d961f06fd0a8e60673ec7886845d9f315d164352bcad6272625ab82dbdcd37c7e3b272d73ddbe11361fef5927dcef5e9f5d8e8b61599b02522412339d04b06a13eaf292aee9bd434a5e4648f777b6cf203f1e0087b666c13e7d1f8f518c143388ad6cb83370f4eb243407b9696b6ccf8be0740a8778aad279ade06c6daa6dce6daeb1ea046b04d58cc6069ac56354af20397d4660f0ccc2806b22c2e5d5f68d8056aed1185383ba2599a54c76bf0bb0922001b4b504baeb9091be2d333423e6a0ec8ee1bd2f3f7de1eb3fb2df601d6c44d2be51902fb369251ea4b675a6a8f7d331c9486faa9f6c18356f666cc2310d2f9fa16b3353ef8b73bdc203555a5806af75a0bedd88b341e18d3947dad62f6c7d9473834809603146c84c6f22408e5e4170661e20c57961a2c212a520bdc0fc22f3be24685917fc1bdc30326713a8a3ca86960353a0efda852d147be946d9c98e14293bd857e359c70b98ed15f810886bc40059b4e3ba798c6afc263c53236899ee0c15edca925ec2e838f316c8e9076310b74b7a43599ff0e26c2d3b3bafb321e45d2f4720d48f34a0cf2802808de0075d8198ab72db5d9c398b93f8bcbd8e23618838c4ae0efe45f390bc9622a26fd61a98c2b310d54fa76a8151cf7f9d4fe5d1b8c6fb0bfdeab2536980f03c8e3a87236c9358485039701c4e80a44ee78cac47cc117233c8bf5f52f985e11bacea2b6200dca77eaab28f6cc851c878f940c323531db2799370fb8e0e7ebc00d2c1ceafacdffd8d0a5de0ab71e15cbd900db3a56e40718b3d1422776bc725e5cdf2f6054ed394d77206ef083b941e443c68046ff7e94ba0ce8db067cd2b0d67823e709d26c45744806b598e9d893280aba7f11d41e5946e699539a0c3158a1934667fef13872c93979044191676b1340ef651b3c70f093e7b089334a06187c2f4efcda9325646cad1b8f683b39dc7ca20df41c2208c61866645af129a47aa5588d4d74c77ddc297d0272a79b522bcba562f49bfc9c8b4394ca008756329b1112a72be963831bfe880b7bad928c3f9760c5881fb9250624a61e439fd0ed64c6655c7f9d48691cb718d29d1eca1a3554593b87a2bcf97377904905eb4ce39e3b88c738c778a0ff9fc6edb920abd50100ed10dfa4a197675bff5787101f28b92a85920f0df037c5133c50bede876490573c769ebf6c9dc90eb0159484ea6dd449eb27a4f5bc2fb61ca03310c6668face4e0c371647e30eeec51a6050b2851827a41a30464f39dc8479c40a5e63878035e33db828035c57310648adb5db83e9a5cc4423dd843057198e5caabbb824c258f059715c56762bfd379a54ab0e231b8571b11ebf6bddabc0528a3e3846dec01111120a1c36e664152026d1e18334ea05b87fd22481726f66251237ad99db30d27e72718b5e464a3b49d1e9f622b0161a1648f8a0541059898cbfa25b349dbec9c814fa178379251d450f4ef3eefd09590f59019882caf92a203f60d32ae42f437770732643895c38c825ae18cbf8b7b933d6553731f666f52b19a46e50647648b09198d0d79ab938113292de5f93416ff82b014ee8585498f85998f84ebb7cca366e263d0da7da5113d17dd10e9714d5914b9d1bb623fae921505f7e1bf7a72aaaa89d212a2b58d309db18b8a0d48fc1aeaca759762ec8c837fd73ee7a9e7d893018daf198d103f9e6f8ac6fd99cdcb94c2ec924d6e95c8dcaec32b192794f2858af3d583ae0ceefc8e6311e543d964a69c1106c9ec71551892c580915c80bd3435e011aef75f980da66aaf650ef24c7be0b5987e846986c0ea5a9db4743bc37fa70405e75cffec03a17685705f9aeb050810b3ee3f3738a94c8d9296b71b0810639e360f64d9b73c65d2c9613166b947977d05dcbfbdd9f15261fa41397c80daf5bbebb44f4b3e0143ef697a67d2a0cac4b3be91b94d205d2d6ca17f52ebc5ee07450b04de2bb177ac419453b0eede18979631e139ba62b0dbf0f2191c3138af8e3eeccc3b82ef7742cc008bcd750f0f3d68c3dd70a160e6bb7e3da5a6fe9cbce185ebba9bc028f4aed66ee4fe2ac2f816ea069b4f32a744b4cf93e5714a724a54cd85caec2e9a94c32f75ee5bab929e22da2613aa7ca58012bea19c14cf7d3500c0ab6311b81bcf0889f88dc9e383eeb6c64d1a71cce6ec86182afd6cfecb26eee22a45f7d48667f97c3ebeb6fbb72fbc9e37ca940508781efae163185abe10d5b52cc4e2634db3811fd2450a5f8154e26f4dde24d5c17c9b6b90edd954bda452cd4d3785b0172dce0190634556493794f3f60e89f08c97e1323c945594aa4e37c78fcf22f814921791eca7738a1d72d75fe81c387c80b00687bcadb14168e44cd70af7480fd7b9e052a720d6da7b244240374e8ec0bd2d501a18db9c2088c8789c0873bf5939728cd45fa3777f222784e08e8f252f872117f93d8feeacb89c0baf6be78f65e3d24e6f34c210129a38eea912b270e39fa28094509b906b22ab1b26a7231a61342226d85c6698ad79a123dfabc6d8596278272bbd44dc02a346f1436711377a9d35e49ee5ac047efd5f8ae561a9280b9c47b7f80d3ed8a960f570e23ed58897b832c181b459301307e6d6b4c8da00a91e1d88c982805f326a6f46ba9dc3429b07f7151ae11e0189a457f40f1f9409a7e92d3ca8f443ff73da94fa6ff45f763080bd623eaeb1c72ec5dd4de7bf8e089a7b6e53056b35f0ef43e5ef531609958570f923cfde6a09a42c2936ec6850a2c0a71035a4228074bfe04ac22823ecef910b75873f16add723dd9c9287b8615599d536512b96fecb76172db6c9cb6a07b4ade60523aeebc205353f91d8563ad1f44ed24ef6e315388fd939f2689006e8de41b1a2d456a4d4c8da572fb396cf3aaecf37b815bc0cfbd1a26bfc362db387f888321e002d9f30283a21cc375d22b4eda19cd6a121788a9297a9997d608090129db78e4c8cd71f1918179d7edd91e315977de01674d49c6251f657674b424f661fd1e25620c65a803efee81abaaf87dc97b467ccd7545ce144e29293b3b7176cca5a9198d1d21da40ae29e622c382370cecb73de603e3a55e000d9d4decf1ce6a68e07d9edf0a580adec0a8638808ac575c0a88671adcb243182fff3e540652857e026671cedc03c5d5edeb81dfd8da61c30a7ebd247d1ddc6fdfb0f76c3792df3a5749789e6ae58961bad4c09a82d167701c711e7a3cc4489b477ed7a5ec0e5eae06caccd90c226689cb36c33780d89fc481e833c387013520750d41c07de4dc35a2524be9ce86966be3f8056205c7e274269d67ee0dfa441f95808e36d4f3f67ba16fa7c4a00ccbbd8657f77cb3c5734f0032500c7f32940de07df00cb753f5a0a41294dd0c9fe9e42182443419f800f7b1a1e51f9d110a10fce1b1f59e422370f4ed4eb4d8d3d38cd066b204c77e93f0f77d1b1fd90121548dc91a93bf200dd2876cbcd7aef6cafe9cf34fd438e602362eeb60e4baa90add8945060adf19072462f732c14c15c2c776ca8811927040b4bb6207cdb1f076d0cf26235e5a93b29c1b64cf5b0121e51b4f9c64bec0b5242556ab986dc357cdcabf3a2a41eebda62ccce858711c8e2379e69dabe72637bd1ff60f5151c28fedfeaa60409ccdb1be2a43e7649b30fcdbe982f0d2e5a8b6fd89edd9edcb09c5ab081defe7d16932f7967b17d977833845c2c81b07e113c0a897f14806167f0ec75c7e872fb14fa45e0e88d4e9e9870cb0757c06aee9fdeeaea80421319d65815ed577b9aab078c23604deb66fdae56212d7c5bff7e440d92d5b425914c3a58fe695c08f617155f9a8ee878c4041538e8e2b316ee975812ae5fa039be7aa4e14f425cec0b2226f4a550519de3c281b46a00c2cc5e3bd45ca35a5af73711123d54eabc6dda12df7f79475005f7083bd40af210b652c7020bcadf0caa1cd3bb1e13363144457261bb2f7d9e1c09208827972d9cf3432d34f686a51d4460a10c350ac2a4db7dc22e651f2b01a2ff25cfa2268940df58e394e711b2db17c262b1d1f5426de647d2bf8ef72de743c4a8e35e5baa68e53911c8b11b8a3aa75f879281172a8a911df4e688579f4ebc6b9a3f15e30dfbca58fae1e9531188bbe331f72c28b55969caa6c677bfa9f033e8c231205316f753cc4cea14cb59ecd4aa06548de2439f8f625d667b0e915faa0176322b93a5cbd67a857cd4cd171ff48cde655b24f358e70a1b29015a64dbe2c9ae0607eda89dd6b25c3300d238c8213baa5f93b97ec6a88e39aa0edd1b6ae98d52540ec3d1a32dfb40591aca5edd265cc0326cdc4716bb93949699aea2ef3b97136ac299847912b3a4d79cc1e53ea00eb8ff40bc8a912c6dab8e30dee41d2e1f2b644bf7e98c70229e2a35886cdb5dc12eee5bb332e75af57a1f9f3a72b3a28fbfe6f4ff221722890b67a4dc7a4c8dd08387ba8046ca73ea9a6385138272a9433c52bf519b83ab9cd60b0aaa1abc93c4037f57e3f04f11a0c13fd108a02266054771355e3e447cf67051f447da8dab0a1fa74ab89dce06b8c42706b59dc65194006a174a11f8f0efc08491a8ba2f53a32b2c9dc35d4a84cacdbc2076276863bc9d28c4d467a182e57c7650843fe3e080f75a434e54343a2c98d152e372f673c702682606e44b732184003a29192e0d930f6fb997ec40b1948c9820aad993a8fa657bc8a9d15870008bef9ea99a76972e60e1a8200dd5bae7ccf577a0f7a415ff8312038ca280d013555cf520fbba8dc5ada73d78bb5aea3bb919ee7e53774ce02798d534cb4bad72d9e027191ca0ebf911b3b4b631795661b24abaeb2788db2ab2cb20994d76ef5333932bfa11fc760cc5741f24f27344e58ac6d000d8676c60d50a2547156558143183c1eaa309234da68d34614192badb963c0d7011df4511b96acaa45208ce96edddf935a7a5b48d3b96598037af809c33772ea2a5421b1278f7d59f9c31325b79ebb1e65f83eb0d3964934f5c8e39179df1681a685cf427755c01612866d985c25fcfaaa507461443b261949ec10ec546647a00019de2e6d12de786cf87454dd433ef382e7c831eb3439d5f1ebc42fc9de80da816fe4e843f8c98ffd8c830c75e8c454173714abbce7e98c490e241ccc985bd8038bf5ba1d11f86234af09b477f019b8c28f3d6066e2c3caea0a3d3065956c788d74cf06f4a37ca3a3840d22a73a400048ce4679d9a078f1b07852a2656a733c68743c6319b8d1c3e7dccb1c14149cd75591d15571d150a250994accf5ec6e993838465e49fb4bd3f49a967af77a1580571ee5f066e9542e1e209cd680203f4d2b04334cd302f93e401ac0b9826d992d40c09125557a9d53b79f62bc089a00ea5caa7de7fe0c71c5e9847727aa6a03903f6021908cbc3e599d59dc88e636ce1d660a1783d2a5e88985c9b805843bddc684feec8dac336dab76e79bc2b20fe1082a09e4eab18551b793499580756d35350a2327b749494febfa9104c96474ac6736e20987cfb2a2cf797e1ddfe55d30905fd6dd4b8811ab3f9f7ec900e1344746f63c56fe14192b0821709f8465ba4e8919bae8df9f1427ab4adf800ff936618ba20676b73900a6c2bdfe0e762f21a696faab69d56016372edbc332bbbb51ad3d801f9892c45a89c639235b7789683de8327dd4fb5f21f66ad0f9ea245bec08c7011f72ef46a3e4d3282aae249c163eb7ce39c3e126eb34ddd0a06a42f08ec0959482e889d2af9e9d582e8a763e3061f47cc66040301f2d3503451fdd9e412ec09c7e6dfd49f38767e43dde53fad550ac900ebde54f8ecc9b187f5ba3a6b6cbf6191ef6d4494f46396b3c349b1cb4ee331dd382b00b4cbf9d711ffeb519d0a8c52bc70eda36c98d9de3de7fc1c2546e088847df4e3293fb1e2a54bae1de93d72f015cd2c7950617bbea89356c9f1ba36d7d83c7f70cd2d76c79e91cd7443cda168f1209f682d7d1c1989513903ea0a7fd6e6537b3bbfe6161f7eb626f8ed6bd463993f906a39d956e575a324e7f59b3ad7e7a6d37512079a0bcf3030e69a9204d61935a33dca84002b2bb8a103ff3cf58499f3c5a24b9c2fcd35f6cbd7df74fe3f06340e7bb1364101e98adb1fba1b81e745cd9870d869f7af48d429acd98b03d7b9e76e25c5e60604dc140eb391d760c1190882a1fd3cd8aa04099e8e5cf02f6fd5e8abc7ab634a5f2effe2a8dc82908b9837c07696b92b1cd32364878658fe13b96694298368125d736da1ee38aaf6e673547799b67a731b996f9ea1c752ca2732c6b6f3f9f22d706ec75bbb6396701b996f96aa6b0ac7b79b5a53394b103c94b31fc1e1b656f78fd5db80e5fed455d7413284be817e514155abdb62e95c6f2f25c8cc59c64c2631acfa80dc3b5c724a4824bf28525154225199f3991b27398c8b886600f9dda7d7d3810198ecd70ef8df873c4340d93de0de7b7ddbae8871bcfad77521c1abf7210da0f745a02ccde024240048a1d652b70eaa2de46e7f01d53ce38439648446950dd0402d65806233ee02211a94ce9e46cc98c5058d6d0013f02b64180673b8b611f4567cb4b9ac65dc447bc442fb43d59db52199326011715e7d98d26495156c51f60366c9d61c534344c460921705318bc77f3cbc503d3110557a88f1e3dcc0c9b07db4784acb17818f5aea1834b54d9b5ccd797c80ab53d72b5865fba7992a10cf563274cef9f3e28f312d6609cdead1cbaa64f58d8329fa15ee697fb031d536f91371ce6903663c5720b98ab503ad64c513ba19b11be20a983ddb0c4b293f8aeaef733dbe94ac37b003d589e52dbbc6dcc1b8f0f7360f458753ba132111848d4d92e1d12c939f29446613f87043b4c6b24b8539a85fdb0b88bad9003cc7ac88891394683bf802461c28fcbf96e6d8e7f89bbb0bb310e2d149664883b8d1f2b331278b254c6a073b92802c4549040528cc22a177c81029fbfccc34d1a27d06689759d147119849f4f63a16df0e5503645816e2037a75d1701de7082036f02f0f04f5f9033968b209e5e9833648667b25e51a5a65214c3e894e67599cb6f0e283165716295607312417f4d62d946aea1144312525becb544099441fb010c8ade843b4e087e476ef6237cef9721d7030ced49ba55947a12792ee9619d0852bf55053cc2e57f00cff16e62af247a5347fa13874039cad66b3d426c44b5496aae40dbf004391c65194d5156d2892622060fb66a1caa24a83a76bc84b1b6dcbf149930305e14f217fbf82bee383752fb6e62ab659cf214bd17d789307dab97b1d9bf87d187d086b715e756f15c8b6e3305200c63d6f305c56bb442318fd6c80c0d2b18f598905d54ac6d1bd9504c7ac96155357a3a71530805479942e12e642f4518408cae8526d1859c0613f244291c57e114c7e7162ae9d4f8f4414b4b9b2059da6768a1c8dcd44751da47e83251b2b422d82390be097b5c0b94144b2fccbc78842c5cdd05f70e5fa99a87548de3bec01706515f72af4880c60e9103fbef2c712110f546a844fcd6d61cb00d980187f6e34cfebe65810b7983f6344eb60fb66a9cc2866a00a88ff40677156e78b871ef97217b9451c2146478c4daf83424cffaf7bad2bcdfd789de590e254b22c6dfdaedd8611a171c471f6d4625dc78b8e883277275929f45a6d2851d3dd760e9994076d9ae45c40a3da9e620e99389fea21f10321c23ccdac5e24c6577bcd1cf14d58fc5d18f5cc7098dd0781dc730924207f407a6d513fc90e88341e6289cf0ebb010bda099ef679be6de981029a5ff6c4ba72ab9e12970a05a29fc1e10a91b85587205b4d769ea26e3e2097eed94ac3ba13722f8d6644a4eb1ee56d5d468c1295da5ebef9ee50fafa88c4a451900d28bbfd5263442c167b6898062599418ce11976ac7a5307f31880380caff68bbae8da6769e9f2eaff9f6d62fdfb108c36d525d742bb0e011ce8971fe320f27e29c633889dbf07259f6e87b303b8091534e8e787cd1535029ec8196314108815c762b8de479db06bfc942bf0be629ce36e707dd3c140919600941b93f07dcb97feae24ce08516eb74d0bca16bbaabb1aed8789c814f366b30bfc0eb82a083e2403d6d2dddcde4cc7eef1153a92ce3d013c5484b5c085884453a7d87fe667a271087fac54abadbca7a9c7d5ad343caaab7851e62a3d2f7655361e98e7bd8bb0181bec453b2702ad6f122787d67781d26ac2b6edb385f57e0fc351d61d30657f6d2f9a62c6b708126f083a53e1f5172ba8c1599507bc94f96befc7ae4b5b61bd02d40863519bb122390e12fd6649e8e05643c43f9f9812e19bf97aaa4841d92182e320f740def553dba15c1854003bb158672326d0eb766c929469cbdcaf919805622c018da5b019949dae6dc44fa9da424a08483426346793261a441f2a855f0f84dbb7b00d2af26a74fb4e8aed685fc3d496755cccaacf71a9a4fa97a7509d20fa5ae1ed0a0d2e19485ec2a502a275188ae8c5f0f64422ca310a4558a85058e1ffa37fe8947087a0b2001b2d0a0e8f7abea453e01804f5d2cb222bcb6c093cddc25281f9c0319f6e0433ed64c69c940812fd994797e2ec028afa02c2ec0b20c032ae94fec2f018a69c7dc8c3ca974271f60e706b439766f73e2460bb3337f2493c086d8dd8766fdbc8fc07ef06da9f87ddad849893983ca0c474e4bc684921e4296e246d169e9f717823ae8078ab7c4b3a640fe327efb685b8f635647b6e865707eb53a461dfa811f3d152581da40998c4a942da5345c05c0f991e5ef91218fbb490d50e77efebe5f55c8b00758c1bd5ade35904cf8e5146f6eb039c6095ac69da8a265a0675cc6b6c20730a4236465b3bd74ba4c26e55b8ee7976938aa14bb5da35a3e11e8c23137952ee61825d43511bab86a439caecac4d85696121916933909168457c89db73ceac59c21ec546537ebd36fe1d9bcee6a76bdc311fa9e2774ddcb92802329513e143ee98a389fcb5b79c8a93155ef26384fc62598eabbd3791643f8cbd2aa81fbe254653a1aa664a4437320c31cac81a351333589eb808d0a591522cf6cc8696ce646085d181c77ed5b1d72397a853c9789c24e20b2225769d8e51ef71d826c274a9539a6171586aa6009f6364fd0432af414d6126d55394a064aeea8125dfd2520629ec7d851b33bca6175af175f0ada74fede82f159b4558f79e5cf1b658f540e5fdb1d60a3405c220114636c2a541452191cae164884df4e4e590d52ee39024356cbbf8f06dfd3ee8100a58816caedfdbe40098f81df3ebdb7fca1c6e4d775b90ed297dfa51c8d3af63e34d2fc42bbe9e1c4048d02ebc15a20edfb14f1052e26f1deb34e38774445e7784f21d6f122dae4317b66df1c099bc37778ac871664ad29a3a9879d6305dcb8df21c6f0b324d11b22fa32067f544cc17cc05c7a6a326ee9d0bf0705baf95bd1dfaed1d5f205818d3fde6f6fe87be9666e3f9dc58471807dbc5b4976c00b0b6d439b9b95f99df45bfe8d8e35a4db603e8aaff8f1e375d7566ba534e999f79dcd56e3ea0d25c1779b53424f59f17234e28d473e3c2918f50513c9c4e88310da63ca2270dd04ee35589627c68a5da4f511aacbf6b4c81d58800390f26b6e1f306b0ebfb1d9c6a93aa28689a20a1760004cbfd8d764ecd934eec6cf693b425d4470ec1f35d4cf9d910c12a2e4123b7a142ca8142ca83898e7a4bf0ba4eb0dc390f68d2c84103ad9b566be07c8383afcd09e7c6591b23f403fade0c2abcaea36c84a611cbcab258cf62040c9971d4224866fe49ae73d1dd19c7ab8779128c7664ceec7e55673063fd78e9aa9d7a368aa784aec292b5e9646bc09e45be6242d7a33dc55a6df04c6ebaad9ec7cd0af852c5fb632ed34852918aba14e853c0ba2c5e129ee20a9f9852ca58e63e2e432ae1c6c2bd8d4ac962836bec30e80a7ae7f2d778239c18665b6e388d32951b9cc556bef87a0247b2a358e299c58a0dc79b24d7c22aa796eeff862787603977bb33f0bc52f1aae04837f24174f2e7953ea0b13ab0ae5eec8ef104e51aa84166fdee82cd9a005cd32400c7062b88ce1a85d7fdc0e1df6c216f845ab0975479ec216f220d9e4fc438de7f890f3046dce248ae53701269fab42f9395efd60ad1008a673a412e96345cd72ac2e38967a84578dd236b01c6630b171707904893afa6442121fd9bfac4f1f9091d485911c7eaddfd614cbe77ac02855113f7ace36b9eacb669066e92bca637760d0d588147bc87694a11f93f7a25641526764ab81d66575c6b7d221c8f2470eb8544c9cb862059f918450eeee70b22537e7ce265304874ca48eabb5cafd791c75338012cdaa8c26428dbb124bff9cc9d76c89df1ee1ad29c89c74706af7ddfef6f71261792c1d08ad2b7067805bcfa6c53785593a9ce3caf3bb140f999e7eba3caeb98c35272695d6d503559484f70761285644cd31f68b52727a57d9cf362b6b19de15be737d724b50ceed33922430d0b9947c2217674e60ce8ea31b6898eb738fc077ed1bb8be109a7cc9292e94341ba149225082f791a997485e74f85eeda3ce8aeb1fced5d0c3c414cf232c895128dff3389ed3c3bee86391ff6bafc7f329fb75f649f1ad85e20b74d23401228d38016a4a2309744d060fbcaab324d302008c582848861741a6f20d049273ded9c0ee452447b9da811695a2870158a9ad7ce73a45c52d45c50d06121501f06040e63775317b81d532745f0a7c0c8e51bcf128093f1efd5cf669e8bb1a6ffdf429e3ddb41ffb2bbb91908d9e26be6e1e4149831b666bf5df1678c77495289166e31272e78e23ad300a37eb91ec046aa0d33009cbb06c89572a6ecf2ac27882175c4dafc48cc30eac7e567df484549617fd8f75160200660cc5642c9ff109ec0d197c47f71002337fec3e1e3cbc2cd034c6a850833e655d71ee51b5092e17d90888b0a62cbb399b5bff9922990263567cd26380b12cf498d1ebec2a421a2ff490b10ba5b3d4eb0ff0099f9f12f88594d70bb7f3aa24f4c643449d7f6eec1e49461a47e7f64cb07213755fe1341b9395b74276de840c39cbd4e8aba0e161f1561aba1ccc4cc9fe4686e819005967b104ceb3455cd3e1777d8b3bb8d56c4d60a292130400cffbe52f133747e3a7fe831d615cc5bb91af7de791cd58b56a1a504a9a3cfafc95bdd2fda4fb55005efca9cbd1ba2b700f777ad237f82bfd198ccea07e3053d91a2739ea164a5c986b01d9d25595cf4784f119c552936a6120a7d0f68abcf5aaab3c2a836d635b861f85caf75b22204a8e3561e4ca9a7652c5247ac4aa82920914ad5abbd2d1225d14a9adf1f9da8180b0d63d0d9cf4532f798b38d1ab51ed710f62f9787632a0bfaa7a1caae51c9df17bf7ecf0ef01d7b3ee5e42aa5fa6f357a7bd6c7a04c8b038f7c2b31fd08ff6a4357dc699eaf657bc4c77be0ac8ab22c03daac1d2dd4bf59a8d31f98e820dcfd06a7730f4d2a7fe799b8c32fc5e99a2d719c9485a9a9cd1630ec0a4c15ece18f263f471a8015776e282ed183e090cdd9783f3573ffa0282fd7a83115cc3d7048653426a318a63a57f03b65a448bab7d345b08f621dbc946ee76e08567ce61ca83614dfd8b15149603cf7ec8376535a1b5ac561aeea07fa678ae87c858a53212de8a5fad58f08edb71457c9478ce100a14d682322b6532e5dc767debfb23db278e1ef740154b7ff25248e9f755e8a10fe817a1ae91fa78439535ca4e7079e7d46108cad444c40cfb66c048779e35b944d0128153ac4e04283bd0063164c99276d4bb5f3f166d88f41512f6a65cdb0b0374b7285dd88adbeb3caea3689aa76d1cc37b4ba6aa6a4ce946cdb198ca9512ed754b999ac20e38ba920106ca28b154905f630e889b98721b0b810dde434177b3cef0b801f7a30948c6c99e3ede2c8cc1ca02c230be23d94c15cf737bbdd1026120bad2eabd383bee4554ac125c7f8a44a53f78d124b1d70eccf24318fd788f6702ca0207a104eec51e32da898a2354148f5f8e0760f07b4e7daf9b38704d013b3cfa94f40dbe55707fc1e1d1b58e0843943b6676c3a98aca52472457f6c7406eca5ca4e95f1649911b471a217389627a50a1d9fec68f1df5d53b31a86fdac658a4c4846c1f2a258372014af70f559e3dd8cc5978957608fc0215752fc11e16da4c2471b2de743a2f1b3761b286eedb876d22fb7e6e8705ebc845406d2998eedaa52a3b4cf39673a715313c773dafbfdf0b7d2ea4514eec08bfd810ada2913a62c25e58e869981e6d8379db8d720c06a1ddfeb2f88227b87c76ce54bb7402c5456f6a52aeae7772ed653202507c85984c5250f110528ad9a2d382f9ec6a8c3bdeb77badab199ec919c4d01e45e29f76629e8dcd6572d73100fd02e7f2391acf4bc1a74c0ca7e84729b13ee80c57e7c409d3176a46e17812e70894fd4f262c09c0c884bfd0f6ba04ec638b5e067e972de0c6f52125656048b9a84c9c133d30a2377897d409826009c6dfb922618c13783901d59eb9190911907ed76084cfe9fe1a8e43f9033f550a64ef520fdd24e5e93ff76c0a5699ce2050c625519307d1a6a01674e9551a8e55bec572e52659185e990bc71f05f3ba3b57adaecbdf888bd20dfad867ea4c26e0b9ee1dacea86690a4c191c0f8c5b94142c1701451fd135021a416d83a40cdc25bad8dea493a44c52e648b122cce1566119e9e01ec4f8016dec82c4fc37bb89a79a506d276bb9a43f7c2f6f42fd29e686c5c707b7bd34b6b5ae29eda80be9a729ed880b818913f69e657359c9e4467205e348094947b6834da517a15e79c5a217514e38b914cf181b51d7887a6b978cb2223730c9f3a2410222e9ac9e4fa1bf5cb44630a6c642e90b38178c17e7c8516198aaf371ea70904c0b0a9043a621da8c03c12c0bc45989e06cbc0bdf696dd2718e0cd70d318ebad32156aad944664e3c754d3b00cf7511657fc3afd7e34ea83a88630542b04437ec0569372be2ae6017168a24fb43cfa910f51ae00b27f17475fc847353fb098e9616ce9d15ab66ee1b596c10bce4e2b25f6721dd9cf3c291d3471114cbc0e58ccc4ad8799935682d25038cbb82c16dce84ac03cbc1c17e2a0ca2f593a0f34b4ae06b8db2289c0a837896f8b849741d83419f484b57b968dafec5ad34ab714c7af6fd0cb01da8598d8b30fac38f621032473f54882f053468c34f63420aeb688caef02b4d8220980a12e37bbfdb0669122d7fce94e018d388f8e00216133b54dd86168bd73c18fe6e1ed6f3dcdb8bc77406ce6a588e4b3a94a17e630a96494e3ddd38201bea644154820eaba40e7b880263f52d474042df67e654e5d6131478e3764bfb55a749ab92ded2774b619caab4ca9c63a095e066796e6454370bc314f02bb4ecf0634af7e003ba185f394ea3678507500aafa02b4d32a1e4e19b56142ccfc38eeef708303a641db84169639321d39a04b79a05d59ab49f741a391c863fb8dcdbab268bae53986abf5ba0bd131152e624f59b347a0ba9dfab194d97dacbe5ba0626aad8fb1f947e4651f24621a2203f602d8410a2df1d05009d1aceb34d0c029e32518ec31096602e673a8bc601539c5f1bdbba045d307f5f1d6a54bb9edd0aca3e362701037da44ee9829fcb7e59c310a9161881f2a2f8a9f26a7334236d48db9593a734a9d0673b95af27842bdaec17686d62c68b92a9d0d03e6295f5a845118e828eaa0763ac8600d724eee056de4fe8f2a0d23a8d6956d1f08911e7a8b592365a341cc25e3f448314a5e3640d04ca8e0abbe46731c92e6e7d61f44e9df43ccb6a99e333a4c33f4742d3b985b0ec4b3e5a534e1a0f3332882eeecfaa41cb99d5df05d63708ebe067f50cdeca0dab0137730866a6766ce59ba857a2fb7aed8e25233e083c27c5bd0cc6ffae460ab5ebe3d0d9b1e5d94750765bbf528cd9fb641f36396f14f7997cb4a7853203ab8f25a6dbd419cb721fe4f0a2f06729b25d26435c75d1dca7bbcc03b625623d53f0495960342da40091c488ee67495b02bceaf7ec740f3fe4d401dc56abd9f7adf3783c775d39dd9055962f2d80c1fb8c1d5b914542fa9675969075f59f7769e48e3399a77356b4c9dde74a325287206172bd6233dc41f49b21d8183bdf9319a122327de58db1f7c93efcf2f60f23ea0a9e7170c5dc91e6f60315974dea4972b85edafcd4d81dd78cc19a56c1fe76f81d7f42c040dc4de70f6c2eb75729405f6a500112df861c0f8362ea231e593dd9e38546dcba4ad420b8aca7c9824b4a6bcda6ef820e4800cd0db22c8d7ce487089305370a01fec80d68cfdf8f0f82cbc560b54b268337fbfde9dd3246910d0669629998eb751699130536660a8627f15f405bf6bd2f0b36ecfbde74950ec02ffcbeaf1b530b4601d3d6b83655a84ab58932485a9ca3129ccef6d82f621da8fb216432a8d0d721d9405ead02ca46d14a0b1dab281558b26fe779552a268d618db3f08dc804adf33cb5d172e29ae0500556d931635b1582755d94e7a172e70d54bca666fc7765c9efc09e21a3d5ad716fd9ffdd784ddadf8ebf28d9260ea777982837e0dd82ed9ade548da2fb4e8175519e87cab4ef710e9493a1c415df67eb8202cfb0d2d708e37954089296f0767b9982599c2883a4d5b907081e8eea4c1f29a692fc534a10340eddab9f5f199b31b6d4bd25b8dd51ed43fff2172482555d572a59d238deb80cffa88cd7320e860e8a522f3c68bda6890e5d3dee298897288a229c60610e63389e80f542b21276fdd5a2df50d401ff7a401a4710bae3da5e84bdfcc05a5458f10dd81290fafb9264401a271007ebb2ac3ffa9e503201e661e4baa066a982b798df67807977020cc75ea9ce8d25a3925c07c0220f30a4336ea7972902fba3a8cab467ad511ac1600cdef81a6a563da7e7082b9084c76107dcf2c35ae03193c3ba1768d20bdad7d8a981f1fe02946e9c9d1ce15c601a5127fc86b24b6c7182ab062c70c74b144b15a6a072c388c7cb88b1353a732c56695094fa4a672b1a2710acdeec7ddfe10ee03181abd1da0a6298c6ed182730f8a26d94f101107cab0f7ce09834b19ba3498938a61a279bc1edb00d5107bcf1a2e121a6b305bf62064129e2300304d5743a2f3966eb52f6e6a9edc08fa2c0c5b6058badeaf7bc3e801c6784b77f034de758c5c8fb8e3d3440ec60f6ad2039ed29cd3e7646261e82485439e717be839c51543573a3b7555db709021784d64be614e608d2ba4a5716494c1f04e6bd4dda2002ef289246b7c7e0181c42dc63d9d4773a460ea1af3108ad85faeacb7a535341676c80dd099613b5ec374023058265c55c2f5b6c923600caf30051b678bfc0fa21ba4c74900095553ff52870e7f747624c0102acc8fd349135590c1a4d95945112c30a4a4213ea0964340c5c41f81efeb9fb97aaf846c6d451a21c8319d10f8b5eb0b3f845f65a4a5f44e0b2780be77fe0594945cda3202109b51a5bf6c03fbc62e22426700fc1fe1888bec1cedaa81adcd0f306aaf3441165411c8bb26acac37fe2f94bad64d551f835a05f5a3f1735e3e2a5cd678dda644dee3c3c08c5eb62050fe1afc4e154fd8af6b2d455db043c99fd4008bc2e0b01a6dbaee36a0179ba0b2c20bb014d541a5a972d85b4cc64a919bdc7311a20f9f7573254d8fb53861dbae4dc8c76e7fbd5afa0740ff7bc1909d61718e2db0f9f0011055e0bd0c3edb3d4c92315369153d9b5d7b4f52568f4037f6045e1985db8bf9401b9447e42402d484e77cd79b07c9a769c11f0d5cbc7bf4c913102a9819d78c09285f669b3518a037c2a26298ac06f2c4ae52f4b633f3a77f026419407e8efeb29eee081d006a41dd0a4601de457e21b02feb50063f16dfb82c02e4d19f03014211e7373952cf4f9a811012b982c0cc3318c17b86c684b9f7fc28afb9b4b06602d44b9b8b90ca7e2834ac432ddd1d4aa8634ec10c4cd102441899386adba6ca4000f96b46ac40726ba3712dec8306a27d13b026c85695161d027c8aa31f9a75821889b01b4d81296bf1273d2115120ac8503a7d289243033583a30a665344ae5f87c51ce746f933cba878c6ed548058687c38364543c92f2be7862d4a0c5d3adcaf14e5df682c86afc475294611ea052956c7b05d0b4530439c166fe1274fbfec6e84d38e46a3b26674692045d0124f31481dafa272c17632264d922803330ec6f18b54451ea82fd647cb249a3fe6ce23d53904d785e1a6b94a4e15aae21d45fb47533ddabdae7d2f258737a85dd5471721692515084c1e33841a601b09ed17d59925aaaef27d6b4085d64fd37a37bebe62f917772a3cf936e6062cad8fc76391195946784ca9f883beca3c3704d52f6c192568d14e0217f35c97902c8eb2ac833192fb84e0d188cbcdd76ed73193f1ce56828ba24c41cb16f66a00da22b72fee96b3d5a5f0c2fa3fda1c120fe1b3b1b7c9f6d4ae9ca8acf0b6749e08b7b1865d4ca0a6f934219e6b3319ed1ad1a29c043866d52721e29f97dad65610ca3759b35ebddfa4e63d04c5bb6d644293d75fab581384f7461abc1c143a9c247c8b7c8a94dd70f52f43c29d5488837fc81836cc2f3d2e80f8cc6663a13977d61fa89d5968d09692c23311123e61f50afb7a6233183d6434fe344f66b8bd4ae8b223ced0edb4102acaacb88b8d3a160abc4f75c854f6a1dcc0307d30f78677a0154d079a4e693a48fd198c46dd812311fd86c08096150a791dad1d47efb65d5f5dddf650842c0c1981a086200036e47d7d35485a0f08cd697d12548011e3449c70d66a71ff0bbc1127951e03c4ae7919a4f38481300e74767b04be918f128242575fd446f40fe3a28feadc1b07058a6e318864f8e29d0198b6bdb17cb0850ac1986c3876ee29e887924e6911a4a4854d80de57a3b720c0db87e34a11fa7299de67a0647730dcd412cdc186c63b08bcd263cc739a6056d77b796c9625f98459df353acae47ec63453bcd33356c63b08bcd263c9324d876113f4ee075b4c932d7fdf8a1eb2499674b8865179b4d7826e199a4e784cf97f9f9859e97fb99dc9d818aa72e4cc23319ff44cc2349b66a294ddda2d2ef744d83e185846732fe8998e75dd55768e23e958e7962d8ce601b835d6c36e19924790cdf611ea9a1c4c6601b839dcc2311e7c6b6f81904c21378c41fc330ca4ffdd579283b0f1e8376dc97314aa28a0fe6c571e12839be36d69e36c6e0337e903cc335b4219e2888bb9af50389dbb6c95fd5b00fc3bc524d6e1104b2f1fc8c2f0058ed8780e00f0073b70309eeaa1ffd8771c00d40cf42ca6033930421530cc05d4f6f8f2028f28d01c90357dc5d0f5237e94c02d8506263b03dcfde064317d337a3a40c3a7ed13ceef05da02a23ac6d58504d1ee26c83880580f0b5bff65cdfd50440b2d290cc5ccf069f99ead5e28cfc81e8e527725ed3413cef402dd84bc35929257e1433cb89b238744527f1dc903588c4f7cd7efc43bc20d3e011c200110b82794d3ef5a3282c09ba09c8081c63451a98cbf313c0204d743070526563b08df1763dc78a1c18980f394e707cbd6c2163ea8160a2238d4ddd4c3ff2b7b73588fb165b46485a894d20419fc43c52f3c986c1c8814d0c6fee1e8cc3e88783b3278c3addc5630252a784fae88783b367880fe676896ca53e09cf64fce3b6e2c4b09dc17686dc092cd7315243898dc176f4932a0d60706f018f75bf9f6844693186eea6419d6b8ce1e4833a497cb7a8dba3f0d181725ffd0858adfb6a4190c19c9f29c7b3568c83b8426d3788948016cc193af30145d13c641d6f95ed7415cbe83116b2829a96635e2b1931e51037f363129ec978cc1338293566e28351544c67fc83d93273f80115d4009570b23743031591b3ece322b1909a34839d8c6253b376123cc6ccbe7875eac9371b965276222743059fa40c68c635ad743926719c9735545cb75372089d34a439ce74392615939da35cd1f6f1f463125ec9cc2cdd1e9db998386d03416d6ea5e993f88305df4846d2c40a9e5cf2bb633f32334e0b85c05e667fa2fa146d185c4fb7a22ac293885f1d25abb2f532d54e681b3824eccd52c4ad858b811053941ac9cb14a69a957f9d001b80198eec62b309f3638a7d2c449fb2ae0de703d79f08977a7c86135b51886cc0d054a868d5ad6a533c258581a3c9f69ca2656a02570019952d23d2a2cd3774513c50b2960119813939723ce0b9604beba79fbf12d6e38d690cdc6d5aaf4d450a13261462822813e64d083467fd49922a29740958f23d4b1d41b860312fee28331c6625dce4e882adb29e6ace9b0cf58ed237ccc0879dece4b3fce3ab2a9376f00ce9dc2fb2036a04ce6a6a4754585a0906086a0a882f7b24c079be5d7a79de47b2426d32fc80cc15302ae0c679d0b480e44cbebe61bfeb9d96663ac6e41a2bfaa934c9d63948662b18d214680b830fcd61bdf087f3361e5f87b23476a8fcbd38a992793e9d1bf8e31d9341a234916b3ac89f8bc00446ec52d76d6e5b117f97f3a6e35172ac8d22304596ced6c029484b380e120f8eb0b0eef64cd708862d7cd3d88fc94171eb4ad30f44f41cbb8c946330e334743305233aa6669d4a536673eba68c9e1475d5155dca3c03c9a433d48c233141dc5802e1283ff6511c85214ba793e37ba6ac872e414bbcd1b022a51c26c9ce78bec46862634f0bdf3fd4df181c4ff82b31bd11747487077b9020304062f053900274b6af7be298359e5d62de90c41b8e694c6c25fa16a27ed6cc7f288cae304c8f34895d46bdf8e3ef10f3a73714ea9415f0a3271013484b51aacbaaa79aff101420c09db4410ffb006c7e7494bf21bdc7d0007128bd05c99672a902580d32dfaf1b8cc86a4186cc374d01674050bcbf4b032e6021ebaaa17490fa15cb881dbd8ea95755f9d0403205e5180cab175471afcebcdc967d5fd596407a27230297714c52a973690d732d2189438346d32480bac7d43c2e4128023524da25ab070b679604c417fab49534ad5e971b720c76d58b48e3b04db465407590c67c7e33c42788519c2dd48c10f08d553305e344380dc4f61ddd007483d88e532223eff15e17bcc5345ca666e230c5ecc1b590d7cb47fd089cc3390233140ee6778acc140e2adcf7bc1e73fc8154e47018500a1ffd9a1aff2842bbe12cfc7949dbc8bea96ded6c189ae238948e31312b743f66c41c47e0afbb9edc0334ed3baec9f4919b6934eafb0502997318dd8004ddbd9ab609b789ed440e63145be999263404411564a62268faf0651c074beaab6a3661c1ca7ee41919e300a24e51e40dfca9e3f40194f7b2945752cdfb67337748132053af3649f6e740195b0f58802ad7e02a6d002f42c047f80a2c386a422a1548454467826c00fb4a71feda9f61a964411557ae6ffacfd9f4a056b4095b5d02a0a76b2f302370320ba5244d29b813080db4e65c8ec7bc589aced53b444e7746506b8025bab446d1bb8d23d69c8e92433de1d949b4df5acf792abe8feb4a7777bb901bda256105fc5b80df47d27ddfa9c02f1ff4d0b79a4976e797a15bceef8b3534e042862ed5aea4bea41182a53cc4468e2432035314201d693a45a6b8ea46ae6099da2c320573b7a610124d8d6c22462271a06c8075960178100a6082247c359487d684d8c6b872309ca7545d5d9bb5d474bd1f7dfeb487c33a30cbbe9a8ce440561cc6780b294ab3368b37bab932cc2feac54e7475b8c324fbad86a96e786493a00cbe5451f9ab60b254d01cede943a58962a298624ec06ed5be8d802c358f0ed7b5fdbd4de684bb1b80587bdeccc304143c0fc73b2dafa3fe8830747e8f02163fa12341388f244598fab4d0c1af08580164317ea21b3b095f9165a01bfd35aee3e84d6813175e9c548ab19de194a6c1f29126a3c2c6cdc2868c89c067375654cdd2344a603f5cd37c94c228e9eaf8a59c14792c1f35ef267cbae03408a32433dfbfd8674e114236907a5ae7ec5a6d6d02c718ee7b3e8f9bb89d86b1da4caa628f32f1393f4598cfd7885df8747a29fa4ea9041504e630164937c372baf1b69d9669d715d6e959924c26103968bf68b8472020483f7e775b95f8caa7a7d33b153a7561c6ff2c584b4d4c9e00dccfa2b490a0970e029feacafaaed6b1eedddf156fe696aeca4a9b3b76351648f9be69149a2b079a8c6788433731fd6c769f2d752fb022c3979d4afb3f2c6069a5672915cf84d23540be838441e6f5192b90656a1423b01993d594b27c059eb2c2f0586fd712be46658a8005c98f6ba28538511561f2984ee59a243f0ece36c76114d52f3c6f472e0276cd660d5a176b4b4c6ffc8659f0595776ed65c88f5ff9cd407f1a1abb7401a5621a05463f920cb5d46cc8e487673b38a4203334e27a09b25aba2ee73b2088d4cf75fb6e6cc9a61af1eb0140c07f4b25e03d96248fffae8b968d62e266c80ac8d2e227bf460762d6f5a2640a15c6ae33399c2e2e6b2708d085f67818a2ed6f3c2cbf54a797b08fd3634dfc837942a61108402601cd024ff1227437cc609df0b11971a491ac29ae6e43945e4ec4cdac736b78c11e1695b3460af48b9d3c1f43812b69f2605cfe7099e189d651dbe7fcb2212ec5057f027f8d9c7a53d1e3f918235233913497cc15472997b46ef52af1b1b361e79e6bd9bced764b826c4b6e119c6e3209648f508d3f0d2828fa71118b093d9c34fecd290ed7b16f9a901ee31b4d8d338435737086f4ef069128ea327618b73b4b904f6f5cfb2160bab52c0be57505a99f07ac2f40e56a3032b84c611eaa3012f77d5ae837e71101295217bf18cd1a2c3f080a8a31c12d306d3cab1fe2164de741fc74be3fb58444612bb95f439eaddac0e3a4a7710375c10911521d65320d00345af3619873e908dbbd2dff18334a80f328bda9c202145b3ea66d3040314c65d3d93a5eda037313cee91646919bf890048cf58f87f81d0204dfd73652b792b14b77948db4430b3051f106b5da920692e572ac262c3b5e9b28d0604ccc0a7e5fc40cd5c30fd1c70fd211b33901a883b57be034e2b684cc5c6827add679823f9594042cf1fb90eb0d0038ffc102c4b33fea67cdf663c5d851b610a7295cc6b04fe1d809fdb5b841f2bc57e8ca8b58d8e167d403719b681860df745cfb223e55383dd65de74827cdef6071aee6158549c04111f84b85782ddb1eb839e90b339585794427bea97b47856159bb7ba07e5be061cb20e8c6cd11a7eeb523abf657e1fabfaccdf0add40afcce5fa6ed8cbc17d8835276bf1b624d108cf2fd49d5c7bce50447d894766c49ff3086ec6a905fa0ae93728b21a904d195c427fb96406a543061cb34351b4cfc9e3f3f8a50677c05f1a7fd51294037d9c2c8cccbd0fd6c09c1e3105fa61a25bfb52905191b803d99ef2a95c5fb26dfd7522e8c6261cc482bddeff3361474e565e9033d3a1128c50a2eb4270220f728169c54aceb285ff784f940fc5486a12c83ab9a3ada5e15d3d239ed10ac6cd0fa35d0292bd76a2bcce46f005d6b60f0ef08e35c3a07b0610b3c636a26f848cc1c4eef444d60834cd94f8a2362c1609fefd530ff3d28357a8453f496712822f4518691a42af099d0f02d1e386ec1624bd620c1d850173253cc54c9c61d61f77cb4b9a4033325157aeefb8ad82e92d692291c42b50adc626ada776f20c1c87309cacb7a42ccb842bd6e4794b06173624957895c39c33b1e6a101f9850dc14cdd916232b59cecf89362c178384a290c5ef913ddf13ab2356004518b2feb8865f3ccc312b5f0e2c8fa7e8deb007857aa8c24852648f4199f17854fc6e6d983775c69832fb9e07a2f3502d2f62f14d267d64434636afb990541cb0a9540ce116af957673b2a82d71626e0e62ed53203a47aa074ebc3dccb540be757e90d24e641cc3bae34a89b228bd8073fee011f9695aaeca26b3f6dbebae1d9b080e04ae4dbcc71cadc0671456d14df273454245aeebded5c2aae868776245a08c1460189a8088c86c6dd3921e290124c93028c893fba6dbccc467356e056469f193e719f56ea168e3b0e807ab12b5eb16be843e5149ae5260b1ef5938a494d513f1c31855d99f393c5172ebb92b508eff619e4dc406ad1070d08cc965c2881e6087d7079a87a784a79ace6445bdfc98495526334c3e008ba0025ef1935471eab8a2fdb52ca08b30aee039693935354d6325456a0348701a1ed2de69859813dc68c203ac322f17a4977ece530f8fb3a64f15e4aaad3396244f47e61a6dc61b872c43dd1232cceac3519cdb1b599bf2cc827011697f496dde2c43ed6ecd1b2ebb333e47cef511d9864be08e9b788fee2237e91c5cadcebaed7a6614425b01d7ab015b19c1e2fa524c002d5ca4caa099d27291922170bdab59ba9a8af78ba15ddfed6341188ecd701cca5a573e3fcc29fed6be2a3658fd39d9847183a6b13a457200e08c8cf46077fa436d5447e996990dc6f7cb0d449eb7008b0300d262adf8bba91286591475c62519222c67688d06b7c81e9eef94069a2cad3769674541a4fd0fd3ea41bc3499ac659e85ae3701f94b991a57f405dcbffc4956539870fc2a8ce56c26b1ee497ecf7286c6c6953f343f935cd6d8dd1765e3a3aab3b9057f520c54520c54708a18cad22b8133e5c0232bbe6c2e9bb6c120473038e83ccd791196cd15b9c4b53c5bf7a72aaaa89d0e3c0a90e376a1b725405784ddd24e10c943825189394b2ddde1ba4ccbe69cf2d2962e63d58c92f30f905e31c83e4a52648c5117866b92a9a612ce5f5ecebf9dab89efc2b490946df0b19c895f86c47a4eca2cefe275f72242e1aacc52981d593a4a9cab03f3813508f0a2c92165c4f51d3228f6157850cc6ec608eb10da894b004cdb8b5e27cff0c4ea98cb8f09c8ee09066ae5201f530d6d3b034aa3b1a6cee3656f509978ad40c15c6f53028e5a47030125b360dfae73dc8e8f63279ad1aa93a2ec2715bf80b9c4425ee9e99294c5faf0b3d31441d2e63b4d07c8faf0c52d40fe3a3ace362fd2291efbe95fe6635853e612801dea5d56dc6a4ad2709fc926fbd575611a6625b20c0e7b2943f954e4fd3250ee215111d4f08ccb968ad6d6faadc4741a4f60ea386925fcacdb3c603ca5ae4ab00fff4cef882b27604a2de29cd6bc55926ba59fbeac3b04c2a95bd2a5f4e8d12d0b1fef70848f84ccbeb1222bf292eb0baa270a9dd62ce3704c967492daa6acb5caaa7b764d55e4532c816b56e381a6e9a6cc075485c8e46ed1d851097e8a871d754bd4f555f3a4edd6096b99ba5a2fdce4970cc9fe44805113e1f1cb20afccc1a5436316a172762e52a296f2d84d0898b1e42876716e42c3062f690cf3ee25c4b0bbde56c1710591a8154a51ac88592190e0ae35120da72c0126a6d32c51612a83ee38ac4c528c571a3dca4993ce513bad997921beae2fda9e58cd7f25b79fbeb04404b3ad2b5c27d91541bd2e725ab00b4a5c1a4e574419dc3f3c3c9a53492910af75e23333da0c6f90b2bd77dde8054953767d1b47d25aea36b2c1b28b3055dba60f3bdf4f81bc8fc4676db64f18ce676c4d8989ad84ba408f930a6c7cc501922267960eee264c3d03fd560002833a966d39af4cf778c536a629902961fb34c0fb46a3eb02e8b3a70f67aa1dbaaf7eab1ed40fbfd2e88f669197829cf2aebdfac1002ee2d869003fd0525e4959f0db2cbb1790b7a6d5d456325f4a447f4a1e74618edca026a2c0bd5f16da588a6e724e98abe069b7c0f58cdcd82a19ca11b3d45a8add9a0986762ae02455ae9599b8d4e2c3cd30cb5876cd732ee592d1fb22a10aa321479a87a6646eafa49fe628af403281628fca2ef627312c992ab1cc94a73f492128000bdc04e4f7a0f3586eacf318617f22c058094b2d118d60399a67508cf25cc9ed8bdeb87a5a46b3133b4d9055f7d32237cc51e03709cd64f802b744178dc9e19641105ac41ade084a82a2cb27b12e4cbc9bfa13a4a49753833c352d6be47063dc7e77ba2d7aef4c62726d1b977331774da23d25739b05bf0dfaf61bcd0e1717de8c9acdc7d5265b9a7c1806e28dfbb177245322facc140fb9b3697a291c74072e18c6dbaf69903e31ead388edb36b70df51742aaa4c83a631e89ca1e5a590495ac5e70401c04b464050788571be3911ced63481bcce10d4432f88e8ef2e2108afd9dd91a65560f7f39e169909427e613982f75d556b124768b4f037ba6ad6f7dfa19530f8b5fc1c9af3bc54b47dd0dfdcaf3349c671b503304dcc1909937b5d52ae8c5417b26ba8ab36fc2b318fa4fc7e8f2c081cbc9b69715f6cc3f8062415a51fbb03c24a1065a42ccbb534cbeecaccb988c7ad4565f4611889ba30e216c4fd54413c9f2e7ebb928dcb41c5d75ade00dcd35114d8f0176368dacff8351196c85f263e97fb75749cf7dcac3a399277292d6c437694d27ad3cfda796b856d3ad16f1ed303b3979fd0b4e79cce634310b0a60dddca6a7e489f9bf41253fc360d973fb8f09d71d0b1aba19efda4a66c677807532ca2d2a662e72774c5f371d4cc848a98a7fb23f9463873ab1d275bf6397658c5b40f68be8d16a4ec04b281cc72dd837623602e4b79c5704794158086d04d168d34793f1896324d5c5e72633d9e5381027c2cfd0c2ebd972eb7e425da904bf43c2dda04fa02ef68b43d4da72b131ace00160377b8e8074c34703b56c08f219d089b1cdea0b9a36550970ffec9f734ba08c4decbba06f637cfd388df3b08184168fd9ad12c59bbbd49ec75231826696293f219c480bd45b66b06766c09778319c58695f7929e36dbc2cb249b4e794ad5e8b2a10eede733c9a653722036a743191a4bd978df5f3b745b46630480c28e838bdca62b28fd05674559aeb335ea1191782f8a36a1c012a2c21661fb29999a82906a491d4db5bf01325a4d86589483612cda841807435c20b55e4464d089062420f002197c83f205a74b7483ad8615d006d2fb0cfe068962e133cf7aa4ed619bcda0e93823082add50796d601cb70c4afb87b597e880dff5e7b915a3f0c3437a25c755dfb8be2dc148afeeab42e8ba53002381f52466923eb2f146194564f6e88642cde38a70b924b93510251e67941c4dbbea5386fac42b3f40aa6e2b2f246bc1140a93f14fc40f7e5afbafa8e6c6e7eaf7369d566230cff790a9402df38e985eb70d058963f2c379d088ca2fc9e48ad97e1fba0be9627b74289ad400a3b2834982b3488cbf93715a8c9c4bc41c94f95a741d83c6fb4f497ad0fcad873bc0cd37323c93f14fd1e945bf80ba1ec00b212b4667f97c215eac54076a813e1a8fbfe62072cff59a46357dd937e42f73709f98ee5fdfd533139ad4efd7700d2b1def48348fc0ce04f87284f3cb9af56bd8c66017d30dc376cba0a40f8297852007b31d9a1e7ff06a4eddb5e0fabd0ba064b8ead4fc164bf1c7399794c0982c3f7fe4305235a1e44d8d1732c0602ae58998471283565293d7bec61929918c916139a7d599aa5f0cc2b0dd328e8fa08694e53a6ae257fdc12d2ec5734f480f73eb3c3c99d3eea7aa4169bc96c57682c49073cf0dbf49f85e2463139f95f8c1030d26521e897925371402d1b52ec5b82f78f09ff77c5e9982a0bc1b9d315011361327efd6da40e867b0e20dab69ad80163e3f8d868173e427609860ef20f25bc3b06e68f96a0c76ed0d7c7b55a17b910a1a2c11b27283a99427621e898d7911771efb9ae450604e51c8e90573b8a9ddd048f51a561e08c6f25e6f384c8fe578617123036c7e69d81c2cbaf54290f95a551dfdcfd338bba5d210f1d425e607c171d3ed8033643d9dace2bf4a527465ec0d5b63ac8031d8c56635fe38ce38fc02021a7330f8e59be8444900090f638a645f0c8ffe0b194e6b92ea7a9da78335bc507e947298fb79f05339a9bfe42776a80b47c1af4bc68575d8fd2b67a8896828e9f13a461b8bc1801e2b462f6287d61f199ec9f8a768f418eab9577881bca049675df5bc425c0f3b4fed0e3177869a8ab2dc9471c6d8c6a05d6028e2096f13d21ea958e75825129dcd92bdcc311c9c74f11060d389515a76bd0b669a466696500d0556598e0738910761a0b573d542f790e79c23f1068f5c3af9c72a79c802ee4b448cb7f3814c104015409b85a449f022b608365a7cdad2ed8b4b73cb6a22038eb08c7822aa09e1036608a76e70e58c83c09bb8034ecba7995791557adb212af9b26559dc150adf11c8a95719a2808ded6a13232d1614fd699f534f26cba7de5982cfc9ac730e872b1915a558c395762fb774927ad1121b4124620e5b0e87a8e304c6ef1cd73f81328fb3d05d645de0dd4f98829f22ba0ffce8d432ee91194ee158b24de6f7a0edefc2dad4ea5d3bbee4ee42b1fd4b8b984e967ba85192437393f891af3b92d6abd3bfdaf9c0e001a10fcc3dfe265850c1d363a02a729272a8bbb2b89ef2d3172636b9763250cd20aec9a2038b88de71e7b71ab239d7a5d212b2fb9c7ace25bccf41e14aee41f659d1d9b18334ffbe243719b579a406525a76d9cdee97f442861173e422ebe28e28c3b88dd848f1879f71325a9bbbc841e0f3a0cf13594d94d5513719bfc4d2efab974159860e154d9f3323f778198db5b86a3a96647e9dfe413e4763d84457132d1adb5a95fa103469903f1887e1be8d3478af2a21d8dcea9faea2711f44a59c4bb683f0c414630f18fc40f30efd44fb49f8e904dcf263a15dcc1058f8947397b3e9157577ea43fd26b42248967b2bf3084e53580cc224ae092c9cd326dde42c4bc33108e77548011dad763b17d3c56018d6ed87ce3199842ff3a536d3edd2f63c16d2dd66f30534f0a382b277b2045929a0f462781826b80cafced68d12500e3beb08375a5236bc38dfa4b86ecf43b2a4718af55b53706769dea6216d0578b3767675f1a08090320496498fe743c39ee757311ac2e6cf3cebd399852c15bcefb7d1e01239fc5fda1d90d869aaf7e99e90d4b7aeaa800982df415ab4ec418dd6b66caf5643e0b0ba079d1af71d9f85645eb7c5a52dc0c4e87b2dc51f1a7cc8aee9c17dfc8c6a02ff262211643d9f6d22adb7148cc8414aec2d05671d6643370271a146ef5f6312eea123365826a0f963a8f302eeb57a38a6be3e9e870865d972a8046ed801bc58a71f9e6141022906190d2d7f3af53b938e1ab891b9ba30b611e31e455bc7be6a7f948916fa5668b1eb9c7e786621c11c785b853829200ce396425b4d9fda4c431d89215ea402b7a6c6f6f626f717b8fbfa11cffbaec3cb599a291d9c652d8fba06bf97a4e3ac9f97864829688d1dbab120c65ceba288d18ab15e0937e6d97b6729b1b7b4deb29a28ab026c0cbb961c91cda954340fd6946ee17e0ec1797c8d1c4390a24fbef36f0b9dfd936be3c457e473156638a058b1e1040b3151f8e34e15e8401a6c8fbd12c9d4e7fa923fb50218e476591c43733610a0a03bc6b303a4d87a07b855da51c08bed8d452336119a16b5303404e17eb8b19a44e7566c5458821884c1c554c641b90115a02406a065d9848cf305236d22f44a366d18466158d515c4a0e40bd1043f9c7769209bc8fc4349e4f89dd87c0296d163caf659921804697569aafa9063be2de6ef5eede9101b83edcc5f5400143e8a4dc71eeae2188999a892d6956e14b6aabdfbae37944314e225918d32f48010054206086770468b13630b09cf24d255471102d0fdcbfc22e920b7461a4b9b7fdf17ac87bc3389a826a698986e2899c23484803dd00a8c497826e51fe42baf3b645d35071cd71559c56435611b084d19bc3c80f6c885a0e28d6c03e4535e6577ba96cb063d517dfee4855f53704beb9ea7a733bad3b4e15f897924761d7f787212df64498fc09cf465062c990eef922eb4a0b43fd3834535841d8149ec2dad177e4dc10d5e5130de4778c51009766e7b0410e39198578a67a6381f88690eb18140f06331d6d1188f8e1bc0ffb97eccf73cedf3a0ff81ee1ef4f4d7b08dc12e56eb3909a3980c63faa4e67a8cd318e3c0b40a37ab23b08ed46ed8b001c21f1d2d01ee308fd4106209342c335e4136c1022dec3eb2967344f33cedcb4070f77e3eb5c464c3111876d3cea0fe7ba000de71eb26ff9ab493905eb421e1b43b0a583ce3b58fae541dcf98f3015fc12075f16f5dc13505b7141cf248a78e5f2a4e16fbc872dd279b6d8072fd6cdca0db9a45db36cddbb4afd3111d5de6b01a4d6c7f47fcc3bd340a3e8d2f19c73e594f04d7748aa4e05aba876b14c91338a926fd7506ae2cc0bfbd3aebdb2a91f68735f964136736d6045d820b70d7d71cec6cc27d29457512f320b599f5b1d542d6da1aeaecc2a5179e29b8a5f596d5445965e00a9125b21042b8aa584713d979978be43983b906fe960557d3b40e02d6d46a6538efc7d74d8e3e8d2063a818468d66b006c20449a5b451140b6bccfee1f10d33a5605e39808eb95ef60e327ade26bbbd852573ab9c1a366ddb346fd38d9e43c6532d9e9a806579460df38ba134f116f3aeee2b7ae73228866a9c817504d61dc812249b2a1b7a59a0a3699ae0b76d4de0cc964db7481233f1697ec5273b2caa00398d4674dfbcd59d801b2c4bf428632595e2119a22d2c55f0577c058a780f74045cfff1a21e61a07d977f9e6c9677bf3e3d70694d81f4b703fd86609a5eadef040a571a3b718c34b59733ba0906893894511d7de2883b38dc32facd50f229d3d5e51ffb6be9e4393057c184dd8b4d177851b5be8f4252ab69bb1304ada1118a9a41f19dc820fd36aa1b79758cfa9440473d4412868d9235242b77e0b3269c4ebf70401814806556621ba5378790067224739597dab20989942a4abbb74aa05602256544c388022256959268c006692896906a69b58ba34a91739c87440ecec0776af22d3eb07cfa5d5eff9ddf2bebbe7db774337862f0e0330b63b1df5ac0c7491ff766832eaf8070d27ff6556a205c0320d65230089553e88966550f0434e8ca7104ed2ef5dd7c4310912dde038ef67df224ca9c7a4f4a34a036a1695a8b6f1b401870b8b9a4561d42fa1f1d530e88ce015d707ee4d8def3b1140063d1e88dce738c2c2e7e8e7cfb63b17f876344780925a1d7172973c08fbbf191783827dfb1a79d497521407aa61d8b5ba5963c40bc0d1446250b19c226c9b9febbe0824377c9ea7eb74498cd7346a9c9adee315a4e8c36969f9900ea24435e4c2d15b44910da6fb66d8859f39bef180688e0ab2450e57be3f7290015d2d1ffe5739a21bb6a2131ec3d206c92a810fe430fcca2932235487cebeb38d1d23caf8400661747a32a4883a6b2a789aa2b869d66ab2ee09eb2ab52cc3cd6dcd708f22cc0e46344f5c5c13ca0c25ac92959b8f98884c1aa771539bb06b1806e2c81af0c3b816cbb20c2e7c98f5111c5f5fcd87853eb72265089ebdd453af0d01af0c01ef3d451d273c92f73e92abce391a48108201078524f307d644502c6f04ad7c10bbfec890088a0df18d0738b5037c9727860e5370de0117111ed66d78f541050d4baa0fded1d80cea4636b40bbfc2640ab09b594565d83b42eb29dc6516a9e973caaa2d0ee788cc6a85b3e8ae0f79cfd078513fa1e7c96250886e3373e90d468651b6c01193850999467b92cab84647ce8c31e4ec86120cbb8dd63635bc6e1ca4403dbaa795998dc3d13919c75f8835dc1aa229432f2ee34048c4af03178a2aa33a81e28d8cc0efcb6e616cdd7b051ca1fefbba136988c301b19749c9173d583b059c4ccaeb7c92dbf291026352dab2f83162c7686d2767bcc911201bbb75d934f1442fef99af1dcaedc5852edc2d838f8ade59b1a9e91a26f20a9329c06e5635b811aca5487d654d70696ffec8e6276b9cb3f45949e18635853a8697ce68a84a92f7fbacbe88b6744ce17330b125f988cf260075d21e30ea3a0eacb483b0f1a3586c5eea92489c843be49b2ef21c7e8ee2a5c4ee80c4db94d27325d6eda1dec1f0563b747f0d5e67e265573f9f28283a00e537c12bd0e6b60be5e3eca38f693d9b750473a09d8c6aa8bdc6367c8546bd7be124f8886488fe51ed62b32687e402341ad8c308537fd385a740b3bc9d2cf9569ea6281b65b07348d454d8d190d3b23b543b245692d9b679e995990b0199ea728ce9f3b355657fae126d4a07a9104575cd6ebf180342cf489e599096e3be020d374b105044b50f90f71a7c6f400a25f0b00ddabaf31a32dcce7208625e0edfaab471f4e435370ea42e460a637f7740011f35c75e988522726505504c21ac1504b67e3596732a8e5d88976fcbb862b56600af33965932c36070fb7bff49638db49b88395764cb9ec2e45fc91decfccb36f22e27bf7069db608cedc78b6188a553facb27c35793e1d2aa59701649a8797c31f305736499fad87c2f78c8945dc2ae3d89d6a9dc0d256fbc40d764b0c7c1cfc0b6503176eb32387933c77eba9c23184daa7648711d71e850061f60430b0a2c5b40f366bb3cc2564011f11bf614f5fd70df383b92d16a3a8c570c34da3954ce34493502f8143c9c3db56c0c48aed3470d1fbd2044e3d99d929e7fe58cff862103948322da50c178bb0e4445056a682161da09bee5673baf2a528ac24cb4a136c44c4cb561a8ad79f85d89390933747df3d90d1e4fedb422c21b26d7e3458f2e77a2fc4a19bfe304749065ef1aedea33780be4d555772f1b4132597400d6ff022a50f10437f45961cfd989a549c1291dcae01354aa46e077f414c7d6043c389dd28605f9c8c2a87e5ce5deb365e8c641c9400643e0f715e170af61e58108ecf7101849087b1bb335102c0665750b7e76be2668cef06a896d9bd45fe2e92705490de2bc6529aef5e2890117b76c738c3dc31113ba5fe49a392e65ef2e5a62766fd90d352f7bfe20ede1cd19d329188f4f5e8831bf0f0dc9ef3753f7165300960d7218facecb15f0a8a8dae9a052026ef09ed3ceb8d390d4a272edcd794461422113ae8880c5c5f36650403c44d02758cf1eee7bed8360ccf795609ee0925b82ee04baa9cd03d512855f2f1ecb2d0c72be6265d715c21bd6c9d1ddd5d694b789e9c1c572b7729c8f0f813da80e9fa1ae86a24e9eca34dcce60f273088d73084d60e1a938a88da98ebfafcfd4c5e52b80b66a8817ef789ee5f47346d73f19a08952186f37264b6b045cc71a10d2e14dea791849eabd7a8c6cecbb3e26ca24473a048cfe9ae3f3c6b5a4f43f4242d047f29668513d77959ff7d062b3af7f97113216a3b399a3ced020657cc3e1c3823eacbe4605adf2afbc32bc12349da71b617709ba30083c97f8a5bcd04c8fd74894d695203148b488e32f824d97537d67c76a8d85c74db2821c785ee430dc355e6aff44150902727709d711cc81dd660bc7ff220ac13245e22d9f329b56d1541dc9eaf2f530e6e178db3e300b4931b4c5ae4792aa3a230a14ef02311251c052452136b1c97d6c06d89f57585d71d5be745c9cd0da1f5c0e814cdaba8daf7912941ffca9dd410ef0af329fa8e59c3661adb1465697fbddf3547b3e4f23675673c9e93a1240b2f1527628bda9d206d3da1361bf95ddbcdf6ba92fdb189abef9266b73538e1186a0e1b7173d3f515172de10afd30acde945323ed586677abc929fb2ddac2cef7a3b4fe3f486c243f0172262e03a633c5caac028ad1f3cfe026a01255e73f2f0ab8651e91ae872d61b1391ddc0658d0db01d80723509530d3baeb6b4841e5b3a9ca27560af7a9a36d02cf8baced0f8589602f74e36a4fb95abb9e136a1b3629ac3079aa03e93a0c94ffbbac19ab2e553b98483be5039786d2328ed5c57bc011c5df23a2e0099a3e9ab92c7249766f70fd3543d2777b4e542e1334b0f62a1b8f5db045cf68ec6e80eff93acd0871c7e297dee18b5a1f17ac869bddff2a40bc269e5c22c6a79a84f85ffa4d13846553f4fb7f389b2baea417be538ea669879c03c4802ca263c2abce04d42d1504354a60216c55d63407c23e795eead69a54a4d7348862b8665a8e472e2421a72997b55a6608655193646509391e330de634fc44599565b231b6963cbd484feb944e89d0cd095122a45ac1daacaadf097887192ae0bce05ad32e397659c2449ccd24a4c03a1da63334ad749b208e81324ead3a5e7fc13332246b53183f18acd2ecc306756a4e6c4332f8cc138a2439c33e882f49b72dfa5950b63c5e0dc04685dd18c10335cc1d4f22dcf51bb6f30de809645ce4704840fc5332c4ee00d6d8711da68c20f3cef080468a0f24db5f6f016587730322f575fb2e92c0861d106dc643fd64fd4e4643763d1340e736096171a4fe6d207f0909fb60b5ac6259c24c05abc42e36f5095a171f49fcfb29aa378d79bbef03b0ca158f457622b099ca41e6ba300a06b986f0b446b99cd31f6dd0f503bcadf040c55a6f061983094b569fcb5c8d2759236a17485ec298ed4924ce35cd59ffa4f2972199b333bc6501253ba4668b65570767e077a4fec04f0464e9440b7417a561f078a47674ac7dcb779ffb887cbcd11cfe15af83db6c71365f1f83cd5e7f47eb2e303a13086e0547d8aa01c797ef9d56d1bb658127a56490d3cbaa69f39f84fdfb57cad1a95740243e7c2bcf7daebd306d72f3829d2024eee6166999bd0c020d2df7d1eb8cd2ccbf10027a2bc8f914ed04cc8de63b99f2361a82361c863b90f3ea7f46330dd8dd028f61d40bc2f0d6913dbe230a300a2695a1a2c0a2f5a5629ed9a7510cc11b7748342e2301993da88f030d39d4f3e4c8bd55e75664a319f83fc688a7cd310cf13f7b1677af16af720980f8f72e835a60fb0879b6e14c6beb11846ccd6874bf7ed62e440e6fe0c3ba4e473b086af9cc10f245eceb906210dbc7d8a8789a151ee1eb6e45126097219687e8a89c98645d02e78c800d1101a29030ff0530f16eded6adaf468e23731cbdc33c1fd76351cca7c316c4446e8bda27bc9416111e30ceb41c1c48fb7d84a41c8dac816e9d78a1acb31aab2a41c9e700769b257c80e97b7886177aac36d7f58d0149bf5a84d9083f536b496aba36522948081679b0c903ca2262d8fcbb2e8f547b4531246b9097b398e4fcbc0665a90c0c1e9a2685323123816df3bccac92970813243c90b96f8f9a9c13c2202a01d2a03ddcf4a52f502c14b6bf5437269cede102f38dc2da6f264d04a1c41ed2ad46e298407ba22c1c00158aef1de80846fc1edbf9c06426b42fb41e9fa77d990a69b6d0de5784ac001efd08a20dd7162ca2a03f7b6c6106745926ef2a171828101b5ecc43a59ff6d9fd54cc9083d92e90f2c16a4c293cf6f22a0c518ca112213047346b0e2532370353da630fe68d77d14f5135a0d19ae03a181bdd97acd3040816705f051cc9d955c0a9ef160a93ebd48b860dc6e0e70a7cf7fb0800dce31ceac9d24657c8b650d239a6790660b4fee5c0bc3058980686e8c30dbfb6c0dad36e4da22ccddbf458ba35c7667da3dc1342cbe5b0c3bf8a24b1a6d52bab8aa5282c9c6ddc6b13a58c3cd530514117cb6a88dde58fc935785cd01e8990f753d19774027d628af08824840612b13823b8996dc5f112e12b56ae353b516a5681a4730826ad6026040866d93a857d2fd070ae28456a499909c7493a0f08361f115e47b6aaeac0696aa4f460fa2db86aa66b0fac3981ee3b7425e02249a47e16b0d94a7d67268844b26d6d1f6936efcbafd9fc01c58be8e33a9c4f22be04790be5a4c4eba029b65ba72d5037953eb70c43e7d4c21fac07c3961142ca07a94fcd29957401c9b4e8c5e830c4866190575db2e84031bb8b0a30d36f6f5b3318b295b49d838048dcc6ad6fe08dd49e8b18db72032b104ceb569578aadaf7c9bdcf1ad4df1f94265d049e9fdf83c4d6bd1058e0eb004217a0989f793bb4b88c543b71d95087f6f3f964de4ca50067ac4b9dac2d0cb051cdd9d271125eb70aabd3dd9e3b584e5f24afd4b08580b08f25091ffe3d4eee7379222b84410596d9709ffe58c5f59d20deb9404234ceea4d060088030c2e904ca129908c7eca79bbc1644ae2d5cfe255839897d38ff3329ff1329f092f2417f6940473ccb06c4469194d7919ed0150384195c9ac92156e28a6b6bfde249c52fbbed8c2f13e81c716586d02de57a02164c44976e4f26bc6b769e57572ffba3183601cab4ee12836d95183ce5286458fb2f154a7720bf07895ae2ba6a544a5e96aa70cbb8624590e4644559b99cd7f82c6af5796233b6bcb5e6eba5d7e1e56a554d9b597375150dbd31c9bb25fdef5c86d96f27f07dfd486d9de4ec74dad05a1cc978adb29cf35685409f1f8f40d1d7b2af96324cf04089f82a08d7688ad0033bd7959a937495cc1ec4438c2bef2f93b028514614a550e87287532525bff2d2ac03ec9604f6d3f1f5f42a16543a75033c287f742c509abebb7d4c6bb96b061f8e854d1b86beeae6208d82926261b96c19104212d6fa79b69a4e76b89927de5a519726d98ed66208e48170c0faf9167727e8b869a4d2dfbf8c33c60f082691dcd0b0d5f8e5efba19e71eb55974af07b2c96961a8acff9607ced5d84e061167de7581675bb16693a326e6f3df9319eef21538152bc16b5fa2bf521d5dbb4417725953ef2da5817718b5d158a5ef7b8482b01dbede5e60667600463ed3d080033568df64ca2a43778471fa1805a24c77b16e291dc6e56987c5f1e98026833af6430e3a1497078a1f378cbc10f745e2056a0a8cbac99868ff61010c7ec8a540002e90b536dfb867e0fc7581f091ad99b1cdeefaed738894649135cf642a0a0beaf13abdb24e44fdba6b97ae3b16abc740bc9a5e14e66b37431c252de46c18ade2dd28712f3019fd3f597a00b71533f4b5d0dd62e4500819732d2374844e093bf9d83107e6a15ab64ad0aa8cf837e8cc874e7f6b0cd66d0749c11f42b92b11700222a5f7bf9490ddb63bd123a18301df8eba061f9b41b23bc79b01e61e879c148f19e7840a62bb379e3ceb0190182b405357365bf550b42a60d85233a5e24d81671b721e1cc959ed6a7429ea4999fb4271719a1a7891fbdb2f24e6df5c91869b7a3a9d4636f4fc493001b644a95ad011c76fe431948658fa98b7e7b27571947941505ee271b94d711410f08c100076e707ec20a03d8fa88931662a1b924a0608dfb5ea8e757be6c5d5230b12e5fd597aa184d80e42d03b802dc3f705df031af817a2008eabda0371da9a8f2b5c0ce381cb6cc3e36adcaf6c93343607de8093b5e437aed610cbb10eadda0d867ae38a5d11aa437de4b29bbde063843ef9ba0a5344e342a9d15cff34b5fc0ef904919287afdda46ca49ab8aa440322b974ee220d31a48ec75358038b75b05beb3199d29904009fee7783d92fe603ffa2295360dbfc73e1762063bce2e5e16747d6f1a022450fa7e10a2b6de849e40100b3cf0baf5452357460d829f8637d0f256f7cd58cad7f18c4d8c06cd80c149de28ae8c430582df0a911aa08e3b4b82084ac93f2bfd115acd508a5ce5341dd5dda9fb89ce0ca9484d3d0210f4c45d2089eb3876036ab73da067e3a675747564c3e7264c870e65100e5a2954c730db790e96f08e39f7dc7b6b948f33a5361f18367a41ab16233b00d3c78a1b84a6da7b610d5b0912fb59b341d8b8b23c97f94b4354529794a3f4b6186475b07d2bfb72069fe3a854051905f48b891fdda55cae58176d96e62e5752552d8c4747331cd51073604341bc56d8bb843de4a155eec2e840751d19166c5235504e0eb161cbf22216bffdcd51c510594d6414ecdbf4f3157974dd5817b84e48e2208c9d9d30fe023ce11dc1590f5c58682795f6dd3742d3fb21d9d434ae65f599eef2d9d11f7d9a088e64a0d32c0cd6a6ed1c090a0d66079ca9d02b232c2e25ef2d8d763f7d8e7435189ee980e1c9f3d8e9f9584a45dd657d1a744a9894d6d85e1e5bc0c0164fe35fee10aad9a6c01e362a860a30c797e9457602731a9b1a38510a3ec8b871462be0061c4879c64071d1f6f7183c0a73422871b8e274052236e1460fbddeacb260c0de637bad6436f682cfa483d18f6f22e289a9c6295a2cac7505d71d4781a4ef6ffe92ce01c131e6886616c90316dc2c7473630303f5f13747bd6f28846f6154e61e744c6a69744cac0a59e5567c29b2ef88f25a8a1f31c9d179a36c4b9cd21c776e90b33ff7fdb4b025fdc6136b0acb7101d2f9e15d2c3d387dc9dcac71c2edc60f38b0dcf0e306a31f59004f793cbc00c04f48a804b3ebbe71fa28a4f2424e7f7ab2ca823d015162fed346f6ae6779f0471200405f7c52bb7fb6a6acb5c946ad48263202049bd292a54105d5882724a026f6c783ed1b1085e6d32d3e281b1d487a8133aec541c3499a9684947427da82a3bb14d335fa9de69414459bcfde15be35a9e4bde9c0033c070fdc406670cce846b02dd7d765ef97ee2ce6f7c1e9faaecbf8c8dc8b54d0fa6be3a5c11b4fad5128852489c3786fecb1a1edd417951dfa679ffdf30d80d17c686deffe0fe70b69911814aa451aa8ba5994ac813b303bd01cbeb427b6a2135ff40ce6f1cfa85b2980fe3d88bf65b56f2eb0c1d220691cd5a06eaa462514c2ab3feb428098acf6ca4a7a0a3c548fa7835cbfa9878778385f54cf7441bc3a66a39e37a56a09a8adb75ccee9d3df41e8183f42271564a0109dce07a0ac887ace6918202991da059f65e7b89cf8b19dccf655b44cda8b649438e1f2574724584c5a99a6ed0245f732ed23fce0e971b56b1416b35cdacc715dae75018a222c8ec8c4f7d2e3c008ce5fca9cc1655b8791bb604a9a121f123814108140482af53251bcfc26db91e0c1f7abb2e79ed422e767042b231f056019264bce945fb09ca1e059623c9771878d0a99601416e95f02a661818f280b152e02a040a3a56777204630aa68f07e53d03a627d89c0c762280feaa51124ede965cbcd11dfebc9270c9b583698f34e58511d25e90d45ef3d9b0f1d212eb041fa816059a1d25d019973933c81c5de679bcacc0511282367981487243f454149dcc38f20414e0f30108b1b0e5a9219f43e4ba526d77f6b245d63da4faf2564d3d6777c8d7f45d38987cd6102e6a1cb8e9c974b337f0f7370bb9c90b980cd6a574c46972c81e4a44e0e80b438d5bec86901c7309eebb6c7d8fcd48177240c4950ba90fbcb6e28b4d95208600785311eb494300873c88f65f9c00546f21565b40478934ad9b82e42c4dc7f1408c0174084100d961e3fb15aeccb6808b00d89d4f6e99cd5b301ea393a71a34081c6303a2be19493cdae71d772a38293fcb135930e44b15e30d481d7dc9aa4882937309bcb31337737b7342dd0f80b823dd6f72898d48c580136e7017af709522d9cc7e66d3aa88b01f1161e69ee2cb090f768bf6fd8a35747e23d081225e7d5734eafb07e521f7872805d1a3841c5e36a14335b8dba066833442d1fe1dc91a4a42434c255d5264edc451e3de460f4a9acca257a9a61d1bb3704ef3b11f52436d1b93107ad5ba2f34fc051fa5c86b18088e2247d002c692a4fd0b4ef22cb8e776fcae61fcb40d6dce4d2c523d1226ea93170be3077ca8b3fd5d01bbea41a1f6812f727202648c47e79400db3c829b2df6f6ef1302ff02cc39a8dcd94f58ad4c645c872722dc99137ea0c685e03d6edfedbca89104ee8121c8a06b9cc2ad3ebc9108605cf76f6d0a1cb9a59fdff0381f57e0a75474c4d6979306a7037426b496e554b55316a51676262503334782f6147394c327535525a587a54397a5a654643646a746c556b5833694d525033336e70667a536d2f65725071726566347a63632b4d48624b2b76656e50667961306f7039642f2b6d7039766d585a46567a546b3068684e58536c763655656a796a7471517461466f4b4f51716f56674b647751434c7a51592b5463784e7a4b6d596e4a68414b424241774f6544716c532f4347733447497758554457617a576a76376f62656b4a7165334753785147383077752f31516772585074302b43636777667631316946737568504c2f4c41514163472f4e4e4e327974307845343370577268722f786f4d45694b6133706950454a6c56743256736952635141456564312b6e594d3253394c7537656c7651334b37522f462b66742b6c4c4a7679555151677a38724c716435792b306652627041597a736e594d682b47625a373373434c346d5432366d396c5956612f622b592f5549692f336d7a36303147703835384a302b6c70444e6c766250727a6e776d7436476658663371716466357a46525a74646e4956466d313263685557625862303351556b4f656e4e586c533158754534446861624458706a39687045676b34485232737244455a6a32686d68616d4d306d374d6d5a754235486e616e4532496f424c2f584337554f6b69546f6437304c4e4742396e334a786f50666239774449586e323543685378754a5336766d4b354d47754c536d52457974566e78435a6168626f6375665658676b6253582b324d57454239366138467a61366658662f5a715a352b426f505262426a4e5a6a6a61326e49365035496f776a30316c58594a5771304942514a775430336c4c4b43714e7872686147764c576253566f52304b646f42754e533063576341585671553445566d6c38456f794a734e6930737372795a424c6d5035667742747871326e68534e456435416e547a2f526e30682b516c525264735665685a4f6f6e494b6466627444732b6e4e52442f6f546164627a7a3241776d672b4c7a515975533459335656586863626e676d5a6d7069786d557561697143732f4d444477755631623759724e6344415a51684150304e39594c6378622b6b303665533776644b386b34467862686c5257494b6b31366557556c736b394b7677347955352b46326c597170597968466632564f502f6c73713155736f3268554a71694b2f59716c457a394b466e53697a617a2f73443452456d326c5974387867694d547a54632b52654d646e4136452f497531393251454841364577526a366e492b596e65416d4578315756572b62424143596a4b4232464e6e4b46754d504d773672736e50506d44576357677870736132744167576d446c44382b766e444767524c436e376a446f4275677831646b4c42494679546b33555251354d4c4b527947613349795979707348632f447143745077566c47343150346c554449614b3463372b4c523144535641566d424e3850547930533873674b65454a694635432b6a65485138593632524a4e7371545237363036456c2f5a55342f786e4e4d53554550745a5950352f6835696c6434653963384152513067777a647779743650653963787a6d33753763746c5761504b352f337a76486b7a343377766b6e764143654638447273316433623161494941434341474c533576495967534d514f414b4c726e59706c32754a514867495041384c6e787049727755346a675048416271452f37754b6f69446739594649456977356c736256485a49454259445a5a6b32627a4948424149707867446779686978504e4e564143494558397964746b31554b6477465039743253444433485155696f686878346354394d563138477a707a6c69386952736277484b5a59452f634b435759664570314938365a587776707a38752b6e6b43573479383741717a61632f486557425469366b4141416741456c45515651362f366455344a6d41676e4e7a376749764541687573516a6f69745a475348494b61336a2b416344496378426b416e6e4f4462497451383243624e674541613435767a4f4245426a35354275556574487655796e2b78612f67485446352b55456242337a556f6b4e586763504d315839757a32746f2f65414b4348562b2f637542454d377465533170577a486e66776f382f6a30675957724f616f364c39416f3257306a5a722f397a353837425a444c425972466b58517254794b697143722f666a32417743474265306a374e3665394b547571674e66304c35757a546d7636654f66766d36672f364133555635314d4d736954424d7a30446f396b4d6b385538352f7650594253544272766e356b4e3062506359515072533758632f3852736f3035366b62644f696c46653633426730656b796e55522f66706b7837344837694e3269352f614f5a6a686f6a50546458504964346f7635597350506873494a37707a4f76383938625648437a6b65426d59333650676874466637723935546a2f767770525042314b2f2f73384c414b2f43696934763157507977303854466648736e485637767a483441684275304548723652416f70453756357367774d41582f672f564c50446743594533576c7442527a6a59644479344f544d413961412f312f582f423148435a3677556e37486c58396b3852622f4c422f6575333644746a6a712f2f6e6639426a71584434672b76436a6d2f442f706c66436b4c2f304467794f79677163726350334c7367797631777576313575376352504339445039544c385830315054435070385547715132727153384477506b39554b6e54372f2f30474d35716534785a41387477324b756a75784943414142506539676641666b3150374252566c396f6b347053354b4d45496f306937566f415439684749416844686c53684655464a675370692f446633775877583176774c52326267706136674c506253744b537a4645396574364f70326e4c6c364d37377a345a7335446e6735524f41683172544f51707446666966502f6370674f504231437a70797a33356b523863446143394864356b41747a3739383259555133706a567a424543687a373931346f434c67416a42426e30412f3041426767692b67303842774f7654396355414b4266756a436163617a2b722f386e66544a614f62672b597445567256383658502f5876335434335a4c4f2f322f393073435450726d2b7233384767394655714b714b554343416344425561314d716771496f384c6e644d4a694d4c417363493035524468425a2b4f637630524f2f326778463366375773596b424c69773635373931444947522f536c745130726b53666a5449596f2f68436c2b2b2f722b544939774151416657624e3635674d473447596a515568526b78774149444c44494a303868394f584c494671304c737557644939417034624967762f2f4b5669744252446f76352f6e67774d2b476e754733594132425767754f655635744a6637764f2f617457716d5877714e2f737038424f2f346c6f44576c5039663366574e3741687044672f6b4361774e70456e76524a65434d683439722b79363939303565715a443575466e4c4d6c68384d4b3972676c312f2b7573663543727638667543586339754b724a656c76744f732f5272376e7678477566776144305678345a374a6e543273577773455170434b79636a4b616b354b546e717865766271676a414371716734635048677737542f726c53745872756334627153512f76627633312f547843314d50394e665348756d6e2b6d764a2f31627432366c4150447033684e5a323432646136796c51583264325650642f6e523859644c6e376166657974722b39546e4c657575644e61335a4339634f4c62676b36664e2f4c66747657647548546a64573055626a2f4f7931757134383869394a6e312f37564f615a6467447748547056736b3356784c7069627052544d6c66394c4e6b4a36466c7a566462325a385a54457876564d2f4e3665375075502f6c3663707a6d735750484b6d6c4f3362466b79524941774d36644f3573353857464f6d6a50616a3846674d42674d426f504259444453554c494452436b644c4b4435626f376a4d715a716a54345a4c53535636375943326c594570702f704c36413530382f304e35562b426f5042594441616b5a4954704a3835632b616c377537753351416d434347676c494b513265426753716b4c774d384949555037392b2b2f3938795a4d316d72615a3035632b61527271367555554a496942446941744358754a39534f675a6769464b362f63434241373874316635535966715a667161663655654436752f7637783845674d7364325a64347566794e7457376561636c657a2b554e643349477837586538316e626e77375766784849524f6162737576665a2b39492b6e79363759717337575876644d6b3256525042317070312f344b7077306d66543132572f565a49504e74595330443133646d5851433534497a6e4c6d33312b3969567a507265375a4a7571696332525775773345632f703543574e4d7a4d7a6c54536e376d68746a58772f44683036744b50477074515554612f2f597a415944433054697748366935365430484f5a77356d614b515a4956416c2b63544b354573716470392b476757594f416d2b6d474b4177346644512f4f564a327734732b5153554c426b6e6d796b47694664457244723266354b3237662b59446f6f2b382b31514d38554138534c46366c394b5364766d393638436c36566557545046414b6d796a4e4f6a42354b3276662f2b2b3570494167464569743565634d45464146674d45497342596a4159444f30794167446e51673157366230454572534f5246383461624455794a72716b3642314a5071434c58693252745a556e77537449346a70503164514c704f474a6b487253505346734962714879566f48596d2b59445271352b39666774615247707052467a4148694d46674d4c544c4b414363444a707162556656534e4136476e33686d4446373172686d496b467258482b4c3732544e374b6b324356706e395a2f5378744e2f49456c7258482f51705a306c59416c61342f6f744675303841456e51576b69386156504348434147673848514b4a496b445148412b33344c33764d31663448413933786d764f2b50334142496b6a5155302f2b5778596d337a4e6e6a427071427438774f7647574a684b676c366d2f3376496332642f4f6e416d357a48304f37357a304179666f37336c66522f7236533964686d6f50313942523376527879675250324271556e344a375048775455442f736e7a4345784e416b6a576237666259624d312f304d516d383047757a32795044616d586375556e415342775741774749334a34634f48336633392f5534416130384649773651525a4254346f456150516d43542b62786a74654767363657324b61684a353534346c38543952387a5257344d3749715545672f5536456b51504c774f42363174654d6e5a486475556f722f4648356b5a436573734b6646416a5a344551532f35304f56364734764f78324d2f55765766696c7a7a5951744a6951647139435149656a394639784556697737476e6277552f53475843774441367730703855434e6e675242446f66684f33635737685078574b59552f62475a45566d576d793465534241454f42774f744c6533787a594e50664845452f39615335767141553048514445594441594475505857572b386d68476a696953436c6450766a6a7a2f2b7663527454442f547a2f517a2f625779715a716b303639563241775167384667614a78446877363965766e6c6c2b2f6d4f4d34496f4c2f57396c5349595556527475336174657570755475596671616636576636746170667137415a4941614477574177474179475a766e4d5a7a367a534243457a59535141514378576d344474624f6f4c49784566376f6f70534f794c4f392b38736b6e6a3966536f4871434f55414d426f5042594441594445317936363233336b63494761793148645741556a72342b4f4f5061376f4161677a6d4144455944416144775741774e4d665772567366424c41644145525252444159684b4a456b6b56496b705474304c704870394d424148696568386c6b676c3466543234797448506e7a6e74715a6c696477427767426f5042594441594449616d324c4a6c7933714f34305941774f31324978514b31646969796d4930477547495a73685456585667313635644c395859704a72433667417847417747673846674d44514678334862414341594444613938774d416f56414977574151774b78324c53506b6270492f643978303151714f3845354b36554443356f454d7a664f4355444a4b43585542414658704b4d647a726f65652f6b4e646571314d50395050394450395774585059444159446359414141514367527162555430436751424d4a6850512b416b655371616b4a58433333374461775274306d796b774149492b416a4a514a727579516b4648514446476742456c4c4f332b3066503761314b6c692b6c6e2b706c2b706c2b722b686b4d42714f52326270314b775741733266503174715571744c5631515541324c6c7a7036624459496f576638664771323868684177525176706932344a574855493248567a7a7a504632766a596a5a4833784b2b32635a325939632b745543456176424e76306246567553756b59705854374937392b746171357a5a6c2b70702f705a2f713171702f42594441616e626b4f6b437a4c6b4755356e67516839724e5234586b2b2f6c4d51424168435a4e4558633441694643582b7a6b317274344b515953447954332b797a777258504174386251614562506f635235654745465a676e5172426553614137694e756d487a524c423255626e766f325830374b7a7034464b5a2f56722b6b357a43787a4d48306131532f3171392f4c65706e7a484c374461736476456b664c357959745079526f673845666158305430426346485130336d56304753514163416f5a2b2f392f2f5a383172656c424b5855677558446b514d4c37767569724646774152684d2b6a30613341634159495954564e4745555461494446417747477a3772577935304f68314d4a684e7a674b49554c50364c4736395a70484a304441424f584e6143735655646b4131383251334c427947736f4f654e6156787759424941774b6d6b72394c2f454a6a2b5766316e6c6a7277376765366d48364e36746636396139462f56726e6a7075755767475141514c694a4b695075694755306d464b36436848754e464b78346446485a3442524a79657755714f565144444148594447434745734f57676a4c794a4f55416e547079494a3045675a5061324f5046394930497054586c764e427178634f4643414d77424b6a674a676b4c555151495376666e72726f524e65534d6265497974376f44524b324865555463556f673443754b3253597a4c39732f726648706866796146797776537a36352f707236372b6446782b344a373173666445525438496451494156654545535a6f644b495978516a4147414b444552626e5a3259444471783673576a4b494f3236362b6d354379585943306a64336e377650486e2f76377a5a444e6b622b726370474876357553306e6a476c3168474679524a59394353495a6c596e5a4a7047504d417741676847776a49414146767242703752676c64496947354f4679786f5a525375394770465a4b58375a324c7064725077424d54302b2f45777747665946417750764747323863415944506665357a2b334f4e733237644f757474743931324551426364746c6c7938786d73383370644d367a57437a7a41634470644b354f6339693236417555306c4541513451514e68764b7942744a6b6b4149615869485a79357a6e546c4b616450506368564334566e676f6c5036593676627932314c30597974627365386f323655757477674c356a2b7674695939514c547a2f517a2f645852762f6a31727a6f7356427767484f30487854614139454764303468472f756d57375635693969456d534d4a595637782b44774136426f4a6871704a5250394750764c666d7532576441666a69786d73574b5a77365445414751434a4f6a627650446e2b33426566364f78423247736f35584171654850734e726a413652382f444d7547485938774449615430455a416861744274762b4f6d717a592f3873787268306f5a6e314b36434a485a6c583441554254464f7a45784d654a79756334634f3362736e616d704b643972723731322b754748487a3554796a6778586e37355a642f4c4c373863633551794f6b7a3333332f2f737136754c6c746257357431795a496c467a6d647a6e6e6433643044504d2f334178696d6c413443324577494b556b2f5178736f69674b4f612f36714d495351686f39724b6963464f30414570462f5363785666363134494d5675716b59574a6750516e6a6c6b504d50314d503844304d2f325630783978664d4b44424f4674494d515a633349415141334c3866645555674131347246516c55592b6c7741524f42412b656d50434552446437484a447a694141494832674743514573434c737576792f74672f37695747774849375137546573647167634853556754746e4959337967422b66364f3641596b2f3974316c4a2f32476e4169594565414141666b744535656836394979656a6a68412f3873574e312f515875797779757478744e34422b525647384c372f38386750662f4f59335231352b2b5756666c734f38305a396841434941425542733269726263544634414b593532777741596c383447774463652b2b39623248322f75556c4146693362743044332f725774776257725676334e7a7a50397745596f5a514f4d4365496b59746d6d2f6e4a6870613035714b594f6b424f6e546a336b56392b3241515472702b3345746433723452645a3037613535454332447478454c74502f476452665663525a3745484d7633613174386b4d503061343949446637324356384c44494b5366716851304c49464b43705341434367306477636c514d556344675250774a763149446f65784341344355653257326c34344e4944663733747a56582f574e4b4e4c322f5544774e772b72724e6548667a457669374c52476e4a6c69662b716c42774a6d31382b4470732b5043336364676e51673456554a334131685a355043444150723966763837502f6a4244336263652b2b3952364c62585967344e5349696a6f344349466a6b47484e526b4f6f6f4a5836654f394e6b526351704d722f3838737534377272726e726e2f2f767550664f6c4c5837725059724663424741497748566c736f335270476a4a4b644353316c7955745242714e6a597676415a335858514c4670677a4c78323559643471334c70344137352b3843643432334f6957715a5642615a66322f6f5a6a455a6b3865746664664271654952534f4256334547704154467153566e4d55437355625451744f414d36734232383339764f714f724c3439612f3246547354644f664e48316750697332796b63636232793642724f65687542704476362f546a4465325859493151776368684a542b4f7a65743356706f6873446f3072667469714a34453577664c34426a6944677039594950737734534432424a314e5964582f6e4b567837686558364155727165454d4b4b427a4d596a43537173756878755830683772337330316c76666d4e63374f6a4644362b364338767443367467575856672b7257746e38466f56437730504b694b716c4f653945483131396e4e2f31776f6f507046794a4d2b714b4c71744e4477594e46647158516241497750394544694342704e763851526a456558786f47517a555830746830416a68382f2f6e54556f5169672f7079667553694932426934393935376a78772f66767a7036505a6939444d596a43616e3467375166464d62646e37776636517365637247416e4d372f6d376c3532415435693446626a7959666d33725a7a4161476f7074387151505643707532584d746f4a494b65644b48534a4b47496f6b6d6c4468335254736156662b354b3649506e47685279544836416544662f7533666e6f6c2b506f3336646e35694b496a596d6d6a37514d32735954415964557646486143374c72716c6f4a766647426337656e48765a5a2b756745585668656e58746e34476f3546525043466e586339365a494a4762532b53574c4b4c73436a58393678504a6d6a556467424670694c7642344345754a394771712f6a42704a734c7a55564f3450426145497148674e302f62786934792b6a7834376d626c66504d503361316a3858496179672f626758335565533779636d6c6a6b777563685773364b6131594c7062797a39616b417357312f5864362f4563736443584e56325564723972303239673766644a374233346d425a78697652646d635a2b6b69694566557a796f66336435396644304947514f6c41326761456a4944534564754e5032374b654b5858766d566454796b335144506f4a34534d454b4b4f5850564e583150715a3951664a547441524d656c464879497043654e634d30722f37326b2f6e6e62624a30467171696763734a5342457072766a5342366465322f6b4a5a2f744a70644278507a5162626369614138347538654f5047356f353959766f6254482b4a73782b787a492f33587662706e4450425637557642774363436b7a692f6a642b56726f6a5549365a473633725a35534d373765336231556a476658364975636b51785975696747417750506232386334594e44366b5238315254485856336659746c4a4b426d6c304b5761574c4751446c484c594e3267664934514f586e326674796e304d2b71586f6830672f514a484f65316f4f4a682b626575582f6547436a316c38654362747a572b4d6a754d2b394f36627748755874355269576c56672b7257745078397367676b2f764f71752b49313976697777742b4f48563932466e63663234482b392b624d4b57566435744b3566362f696575324f46537568755374424841454230416348546b5a2b716c4e7959307746364a32436144364a33396c46673250506232776335536a5a622f2b79526871786a394f71334c43756f7975384730456349594e414264694d48677737677557516e5346457077684c6743616b49532b67447950432b51667367345a544e56332f5433354436476656503835652b5a544471684e363363792b6a7a36644e6f384c306130642f7354662f6957786473674666752f52545a625371656d6864763962782f666232725a536a6f3453674436487a774e6d5867496e6e416664624553636f664437354654776432546678664b52743644774951522f6c364b6a767437647672625765516e6c31683230724b44394b4350724d656f4a46375479576441726f73484f776d7a6859444354705a546478364c427a574e49705946453744374f656742443067664b6a722b36774e5a782b526d5041484341476f3071454c4c6b6e584756393833346c6d58377436502f695262655564504d66592b755344646938384a6f7957465264744b3566792f696575324d464a526747454846717a72305563584c794a58772b636f7a374c5141414a526a325058664869677159576846652f5a5a6c425543474161444478714776673466466b482f78545975426f4b2b44523463743972655144456636624234555259456b535a416b4362497331396f637a564c30456a69444b3479773035433759525577754170666a6c4b4f4d5a6c2b37656f332b695345724c71436a746d3373616469746c5162706c2f622b724d7833395347725573326c4b322f65792f374e5061654f516976484378626e355645362f71316a4f2b354f31616f6e4470435143494f544e534a4b5972597359354c6f484c71694f2b354f77627166546e6371392b797249444b6a3442456e4a384f652f455064474c486e766571674d715076506f747930417a4c49634c68384e51316554595a556d5359445161733856484d5370413456636e6a65546c7575433573584c62556a527857326756636f59782f55772f6749734f544656387148794a32384c304d2f3131774b324c7933667a447742326e526b6637663167576675734a4672587232556f707734544543643859326d646e3542494d6546534d48592b2b585671526b4649544a4f31777630573442734441584653546832757549425355666c68454467645a704c572b536c556634656467384e4d4141496e5648363443676f716969694b554655563878637678706f624e6d444e445274773065725641434b4f4561586179467a796b392b3973503666392b793937352f33374c33763065656672396b5378344a6e6743696875776c49663976624d2f6a67344c3734396d2f7675424e746264554c6a48393831375059392b7268755a74486272396874654e487a2b2b7632454a3670702f704a7944396e5363443250446b65355561706c69596671612f6f76727a3562584a743876613333785465316e37717a526131363946664c2f372f4e305570422b794835684a6e71685156496f4a74777033494d4d4e726769344177724d656f4a754277656a506d456d594f5951594f77414245752f373365667639743634342b2f5630455a52624e76682f317541503036487568324a44732f70656a76646e41496842564943767233376244667666592b543133717a34556f696c41554258392b2b7831597348684a307234503376546e654f4b3739794d6344734e674d445431544e436a6535352f555556434b6e524b384f696576554d4b314c34374e6d796f36762b75676879674c3278612b78674232545a332b33554461367036387773416d7a5a656d336f44544c43644d2b673241376967456d4d792f55782f4f76313141395050394664516638707761564c674578325076782f2f3934714d7839734d55435546554a4e766f71696f564753385847686450794d5a6c5a4c7468434469734e446b5a616b6e706c5145307333777a434567557078794b566a536d584272527156496e783358514b566b4f3444366441416f746f4d41585134754a6374624b6670356a71444c7765486b74426f5a6f3137315a79486d2f467a3369552f476e52394a4643464c456b77574377776d453236352f517434366b63505178524647417a314556355162683739336437484141774179666f424f446c4b5268375a733265676d6b355133673751467a617466597751736d33786b683563664d6b464d426a302b4f585039364a6e51536332626277327165324a6b32667838312f73425142382f432b7578384b6572714b4d532b786e30385a727357787062337866573573446d7a5a656932642f2f516f2b397648724551364c2b4e4e62372b4f39597966377672427037574d5050377676747149477a5144547a2f536e303138504d50314d66365830432b3057414144684f52436839676b6138696b545331554b4b7058484b644336666b5a2b65506238315332456f692b65366a7142435a655363764e7631684f3057694e4f777253504a7530505335466a7570304a5a7a75615070766f6e58326550583931693333446f303956546b3368374e746875345541665159645944636c66302f4b6f643975346d445152564a6b37397468753258746664363630702b4e524f646e2b656f3141414366323431774b41514143496443734c65306f48332b2f43516e534b2f5831394c737376506f372f592b426f4a7451487239484d66316378525664594c792b6f742b3536613157776b6832396f376e4e687734316f737558416865685a326f625856675331624e7346734e6961312f2f6b7639754c6f3058456350546f65763445746873522b6e7633314b796e374e323238466a304c4f74477a7341744c4c6c794944546575525875484534535162586475576c75326459564d50394f66546e2b397750517a2f5a58537a786b45634161684c6d372b383456774a4735337157686450794e504b4e6b4d415043504a57304f695254542f74535a6a3235484a4232303363536c4c42634467476b2f5459324a69665564473675656f4e674d4145357a73705a79366f2f334852327245636a6c2f414341497376777a4d7841565657307a352b50503774314b7852466753694b74544b3737475279666f426b2f5953516668356b6437587379764f764f746b4f4142396176786f47343678582b765776335a6232366637526f2b4e703378644b50763173762f737a386663476f783466577238367965627977505144545039632f66554730382f305630592f673848494267483641514269386f50724b622b61726e6c536a45745376452b3259364e3978386571497768495077415964636c6179716b2f316e647372486f6e482b636e5271495473474478456c7a3369553943555253457739585063467475736a6b2f4d524c3141325167756c53753475546e414a48494632377555382b35542f357277567762346a61534d7636525950715a66715471723065596671596651486e314d78694d48455276797566552b776d4569382f716c584a73764f38366441436966322f6d31767370702f353433773377743031526c4c79646e2f67784355374138745672634e306e50676c56565274364a696766357964476b684e45734b3061546c424635765637466e536d665a2f4932636b5a6a4a3836692f46545a33486d334251554a66564a5154373970447575316a443932744466337547733264677862485a4c7a635a6d2b7257676e376f715045426477346530586152773362703131756a6274706f615568676d494d6e326d6c464b4b46597a6848467056623873792f6a675454666e37667a45534f63454b55706a2f694a2b76476676672f6b36507a47713751546c3551425253736341344f534a73306e624a536e3950346374577a62425a444c415a444a6779355a4e4b6676644868396d33463445516d4545516d47347658354d7a715447504d583641594350662f794774474f4635336a484d52746a4e70634470702f70542b7737687346512b2b5651396a6b337745772f30772b55547a2b6c30597232445167464853376832424541364277396e364e6c2f524b3376626a3655434d41384e42444438585747506342714736717a2b4a5a42674150505044417a6448504937557a6861464662433074754f4c6164514479762f6d504d64634a576e4e446565754b56594e48392b35645249447451476e36516244746e2f667376613953647559584a556b774447447739792f74783139382f4962344f6e684a6c71485470586178734b634c442f7a3950536e62703662634f50544849356a666d3771555a4d6274785a75483338585370623378754a4a4d2f57516948424c782b3566324a39706348706a2b595444394b66712f2f4b56507754586a525441597874476a347a67535464685153586f5764474c46696d5659757251584a704d426257304f6a4a382b423444705a2f724c722f2f776c5550337248377869303754564768623476596c6933764b3058315a4f66626579666837586c4c4754697a744b6a6f4f696c414d67324267385850484151442b376d5248733937314731336868474c56644b6949376f59424446783636615733762f62616139367272727271707743634147706559796f48566744432f66666676327a56716c57335237634e31386f596a71526b4c532f6f32455a48712f706a546f76663679336f356a2b47497374775430326870614d445639367741572f2b52326f53714c70477753424965665254594244416a724c62694477646f4965663262666a7a6b3066324478353374582f354c2f384268747558497565685632524751425466674d644f6e51456a2f7a346c774341646574585965577135556e376a3731374173382b2f544941344937506677777256697a4c713139466a5379424f6e6e694c50623862682b38486a39414d667277732f764b3967746a2b706e2b6450724459536e7572433162326f7659584e65526f2b4d49426b4934636e51634a303946626b366e707479596e7337763373466b4d71416e326d396271774e4c6c2f6169726332426e6757644b544650626f3850414e50503946644f2f356f4858682f6c4568594c724c74324a573635394e4a7964563832487672395733456e67494b4f7666544d634e45333677383975322f6e6e54643959444f417a54456e4b45596a364939444d667251732f743246746f584957516e7058517a674d337a35382f5037343978486448563157586a65643447594a51515572442b7771456a41426d416f534d70447368694950434769764d4135736254774e43524d465a3951536b644959514d2b4d4d3079653579367664485934496f72542f3963356c2f516151556d79784a4f56706d686e434e6b336b7944514e412f6576504f302b6d45685948654b4e2b324f7678623437567639693038647155476a44704f484a305048377a43774176763351414c373930494750375233373853327a3537435a38594f336c4f66762b2f55734835715a4933713245785730354479775170702f706e36762f756f45312b45536170586d78656b58354f6e476c384d6f726f30772f30352b3471657a3643556738324d6c6f4e4744647570586c374c357333486a6a576a7a30384d394c3775634c4e3632396a30525344616345577a6563666f4c2b4c3278612b7a34496868392b4a6a2b6e6d464a36483443346670664c645361367178476a736673707065384447436145564f51704d67425159497741674e3652354141357a4f6b644146476d3041736b2f6a346444764d6342306a76694939564470764c7a426741684b526b423669632b6b4e53764e315979645a576d4f6d4a435669644c6244596250444d7a49445377703141633652414b4e34645056687538796f506f614d4136537548666c5277475862654c7461506e742f76566b4c694e676f3647467454664354503552365037337132594d4e2b2f6f766e433270504b523044776342447a2f7a686f7a3936666e2f5a702b6d5a66715a2f72763759302f3136674f6c6e2b7375742f36323950317a307078652b7637367273375576746842532f7245414143414153555242564f3347473965697454553146435159444f485973524d3464757745677348436c7a7a6b49702f2b6c79785a694573766a56525a4e786b4e7a6a2b3938503331782f5938556c446379686475576e736641526b4551622f4e627347436e73366b52424f4e6f6c3976304746425479663042683049495830455a50414c4e36334e755a592b3676774d4175675068384f6e585337582f76506e7a352f4f635667396f51444132624e6e7653365861372b694b463545347063476f396f71416b664a4341444174434270653653415a327237615a384b526156515649707058326f696e33514652574e39783865714977694a78466c3567386b3375755855482b73374e6c59393839707a7a30454d4253486f644c4333744943517774627a576578323649314754452b6377577650505663684b79734952375944634a57716e314936716843315969556443717155467633487575503247315950385561394b786a49377739394a66346878506a4476734d4167502f4c337075487956486464372f66633671716c396e52534779535951414c7954616751574952746a456a59526b6251526873333276693245614144634a3259704859435353354c304e6533396935546c374769523141784c59776d4a666b4768674a4159375a687467427355694d5747794d5741596b6752596b7a64704c4c6566332f6c46645062315564566431562f646f70732f6e65656152707275727a766c4f39557966622f32577734432b577835342b736d6144515370582b7176544838746b66716c666942632f62393737456648673269416762704244436563754144373968334332576564676855395a37683258747977345946732b745778783837446e312f337054436d347676386a41476363317a2b6c5976772f582f596748685474427645426e5775343365502f737351474f763938506e66664e7674334137585848543274786859583274624d7936362b424f59642b51524149426e6e6e344a7a32783561616270782b662b4c7a733675582f664957782b344c3877506a625a643831465a342f6374766d5a4837714e51555466417443585471666676666e6d6d37393977773033764662776b764651526457474a494478473236343462556262726a68476744597548486a65526463634d4666524b5052506949615959793536712b475a6f36426361495246707658416130644d4b6275515878676a6f493339316c35745441484a776b484a3932376533466d48354f4831673745356f464149793238666874462b6b574a6a67395971626152684534644b5a33793976594a5133394b4a7952304167676a536d7a38734e4e66794d546f4348363159514d2b7657594e49724534326f3434776e636b704c6d7444624634484166337649646662646741505433396e7a4e422b65723535372f396b3863663779474c426c564e363668455078454e435559393136786156624f367738424a64742b34384b504838356732414e683351486675326c767545507a31395664693959556678384a4d616b677046693438447173762f446a2b2b766f72793735323536363955336e316a50562b34384b50486c2f326f4371522b71582b6f50707268645176395965742f343148626d7348305141723247746a2b646d6e34764b765849526f52454d38466b45306f6b4854464b694b677565333574656576507675666a7a33334376565469584c63382b39556e542b6264742b6a306845525379716f536b6552547757525453696f6155356a6d75752f697a6938616c614b63625144614b5369365a724c6a7072435166766a3053315050506a4d4a50317a7a76794346783038536341414279382f39714c7a7a6d766341776957674b6733374b7338514c7a597749594166414841424f6869616f747277463446375a684d792b35354a496e62373735356d396e6e75736e6f694c3931634a577252396c6a4e6e4e4a6f37497a35794d7141776e48716d674e56622b4c6e68727a4836746b7836574a584e4f786c672f5737582b734774456363623147415644507744734763322f515243472f7577354766725075503677623851424144693464343974594878476768686a614731766e2f486d782b47716c5375334d3458317747636b4b46642f506377504141534b53396b6645736f6767493752726a6273363536486f3950416875576667744a5a3377365a316f46522f4d31727a3244723543694f484e7150397545784142675273487075322f7a73396c714d4b6655665876722f2f73576e38462f57684e54666f50726c2b7a2f38362f2f37782f37465359484b6b70784d34665454764f755a626c312f4c31353863556665593665652b6b4638396170656f4d494f554979783749666c2b6e2b3744792b39394872653836656474684272722f3663352f462f3250454f71506a3258742b487a763954317a71516179383635333441765763765078566e6e354e66657a6862394475524c4141447432782b2b744c6335346a6f6667433972377a7979767054546a6c6c50577a6a38775a6d6a756e785167467743674431355a646676766f6a482f6e493151414747474f586c6a6b754d5054493165336a524d4d4d72414f6a76774e47663166306d70464a675a46454a70715251314f456f614f4a6f61505a355a35302b34654239672b4451434f746a4855646a675949414a372f50747174564e73774744726d74584c4d61797657556f6e2b2f574d432b3864464a766f7a3168576d416272383873734a414e353434343277546c6e456e4b4f4f7873724c4c6b4e4c7878457744634d314573495951397352523044564e45794d484d4b6d57322b7471666b35365351375666614f4f2b366f65612b396e7a7a2b2b4249796151414d58583730677a42734d644664612f4d444245794259385148774f77502f3566586642674173412f412f2f375667376a776666646730703039782b4c467272614b4a6e66613842692b504f696566767a51584947484c7a675777447a7336353648557a6238447533445978324d2b41434145796f61734178752b6a383175427676662f384f614176634e2b71633766716e362f6f62752f62682b455574324e6437596b4e652f3062584c392f2f74626e2b424672484375364c4f5a33757645676d30305750705649364e4458517834736e71565278376233626d4c6c38594d47523266626755394161654c64543751574137744d58755a787264756a2f30496450634178516a3873687651427737625858337033352f6a58593657517a485175326b5676306f782f393649466262726e6c61726a72727871326176336f78483965765934594e7144397734413543557a6d5a3131324e484e30424e6d3775506c3432774142344d5457735538646e755948734b4e417a3978453677433259662b34674b6167794e4145315438796d54452f414d426f3355794a2f755279634f3865624c7231566e78367a52724d4f6671596f6e5334334d572f6e6b7269385876756d6447526e304b7557726c792b323250504e4c4e695132716d745a64536a2b4145616179336d7457317437384141454d5543592f7573754d4b666a395a666c33773335777762463462334333363466316566763334742f5866675354735741664273307045332f362f373843666154346a2f2b64506366697270373859735066583359797a75682f4157724b3672726d6f724f2f355a586e58436c652b6c634e7651394b367442333748513962726272422b702f2f52302b4e5a54475854337a7362636a326c4458333646523963763376303074726e3975787a652f644d3570787736587838496976504f7a726e4b7663505a3443734a4d30642f5733754c38312f4d612f2b593376334569507250422f44684d414d4374743937363369323333414b55304638744c5265737632507331312f725957427230486d6d3362723634504f566e577a4f4755424c4677423755392f5743323676517a7676366a6a377876453774765331396a444731727737496a4370452b59666f5a512f304958646879794d4a724b74727a63733778732f375056376f616454325a71674f5563666739614f4469515443514232747a50482f50787177775963334c746e6d6d636250746573576a563632794f50394844436f4b707033573736415977776866566374584a6c5454493433504264413854416567486772553933776372354d4439443638415a5767636558336b532f72483342457a4538742f7352342f6f2b4e64625838474a65784b2b4a3358696e67542b39645a5863485442682f3945544d452f39703641783165656c42335877597170654f765458586c7a44524d332f55654e7049766d574d687331672f55392f713763647177585a6662434e66666a5562534c392f2f786454722b70646979576b4c6654336d4d44365a7742747637386172623779445639393442322b38765475376e314559353638337331522f5a61765777354f36616d6e37314f31586747585353467536674c6e6e3245304d2f4b4b313238646b7a4138592b746f2b6466735659632b7a56697a76473738436d545461305152683577454c4b64312f4c6d684b743439787a412b41767377355a7a534f43547134357a316f6b516a614f6a72513174457836383250777a5772566f304b526a31454e46536f48394e67666f41414553414731674d414278626278614572496e50786479324c30635a7a5476464a34464433415669335077427a393151762f4b4e48644e787936797434342b67343775795a6a786537576f76756944616e544a773250493476442b37475358754b627a3670382b65683832735834782f6e6475593950695a4d2f492b4a562f47452f6a344f4c4434434333506d47695a752b6d2f59622f704b385a36742b7574352f623234376c41627a4d6a6357582f39765767552f664c393730375931353849413477687a30416c6b696b41336775344a55744f78764b7a543857575a2b794f644d76505074567a447954444d50486576674d514f533268444e504333674f484549314745497357523243436e4c2b634e75386e4d515347376a646533346d5450766942764b646d692f34335873394561544e7433417359417443396365504738793635354a496e41537741554c4a723367786941574233673874383736592f56467058726239702f4e6466477952676744584e37304454664343783230364a532b304871474344534b594273586c32796c75544864306d30416744656c74583356375437706131344f77627832353639753961426b6e77676645556459796e4c4c5447474e7162474a716a4441725054374f31424745795452684e304e532b51595152786b58765766396a5973627039304a5070374470746c747831675766786f65586e774d41324450383171784c652f4d695539647a2b7532505048597a417a4c7472576e51417455743753325877456e4b566b7a4669736863394c65643476723845584d37595631394b666266754c376f755a50324a4e46337a2b73755235586e694b73766453303062754d712b74744f776271786c2f454533712f6f3345484931543978364c384456596a4f4e763175314f72366578462f39794436327a343136362b2f463432695837372f33516e372b6975632b69784354394255754b3938655457575a7a597550726c45743866686e653942754c524346594b776538392b6e485438664a656a2f4a2f667754444d764f384a4e4b4c772f4f594f6563387a476d42673363397365516b4c466877564f425875634e656654756c4f2f5138454578746344686b413048332b2b65646666653635353237397a57392b41396a726732466b3974615a67536977397744714f5066636331764f502f2f38717a4f5062366a48344b32667576314a6575547172676d42506a437351395038724c6b704336472f6c624f2b7737586867522f4f2b683854547a372f6658534a564573664d623575504557756d364b3677556a3038396845333079732b664844732f2f354b7a7a376e7a4e7766352b512b4e717138363844634e31307a384e2f472b7a4d58534d6c5a6549377a5238732b644a6164455171643837764e4838515369727a52392f39446c6431424e4266433261532f6e70337841494f4c2f335477577a584c392f2f70516c4c2f364b56663762646a67445273504f59595a6f6c6a706a69354958487553374f642b37616931765833347576662f503773457273413247594672372b7a652f6a35682f653762724a73746635766334314251307a687435464b2f2f4d4d3731437049782b4968702b662f38494e6a2f77587867626e624c324d31332f324f67454e6a2f775833682f2f776949614a685335676158512f6f42444463334e792f6174476e5450363164752f5959324c5579335143574154674a5149764c6359636a78774e59416e767548577658726a316d30365a4e2f395463334c774974714862554b2b4a734658725231737657482b64716c6864646c6f636c666a6470434577394b6d4b31645636776672725a724c3563546a6a656f7965315464786e61626f585144365376357473702f72307853393636792b6965746d712f6d52484437346a7741784441506f376e303367766b4c5975566537596b7043456e4c516c726b39347150636f36346f6b446c6c58586c6d362f45305074754248756d35686f7555763877705036532b684f6d6859546c66724e30726b747153796e6554377658667a517043707255347054323261372f36444b7650787a30353149342f356c302f542b3038732b65664f4f52323770316e753448324a70384d78474d6e62763234726231393258334b39713836622f776f512b6667486e7a6a736774797365756e587378394d49664141413764727944323962666938392f37704d345a2f6d7072756631433448366f794c6164394b7161306f757074592f756e58306d6f764f366d5651426e667632746478397938657869664f57345950662b52457a4754394c3278374663397365516c363267434145574b694e374f686278364d7356456936675577324e485273657848502f725233562f347768662b6163574b465a737a4c2b6b4145416677636c5554716a3264414f593633327a63755047383161745839796d4b3067703750364e65786c6a644639627838332f794e75774f6846356443476331532f383239546151616c6a396b734d543377614951454d4d7248655a5237765858497864686531486263594e452b4d654879613673444275576d68564662527178644d796475337a624c5872734f78396a67637a6379303779594145306539466f2b697678665833773354727434696769776f332f696a41367a7852376e332b3261772f386334654e42315832675a4e743336487844743771763435545066317a7869474b7a4a666f4f4742467744575866716f66486275326f762b4839366431374c357a54643234633033647055347969615a544f504f757834456749704d774e7a4f396a587a6c6e346c554e656f327a592f752f306246333630577a4161304e4e4739364f2f336f4a486637304641484444395665556259646479485471662b6a4233325a72686741416843464f7250655768353731724f74686a47306e6f6d344141347169645066303950515255523841664f49546e2b6a4a704d5646414a54767a6a463978414867304b46447433563064437a4c6558774974766d5a4c58564e456f6d6b536e797635676b30417344587a756536532f672b5956716569393963786b304c435a6658755a327a454764757a6c7a444a49682b4e78704a66793275767863734873332b663772314b78363748437356424c57386a696b636f314830542f79682f4c706c75765537464d35314e6c782f49486855366334374879793758303035666e6e766f355564794e6c774a5966392b4b476e3372376c776164504a31416637496742414f446767654242672b6e556679415463514a6843455272626e6e7736644e2f2f4e42545a582b4a47474e764d385a4f683933464b36762f32392f2b746d4d6d61745a434f69536143723466417243474d5861364e44385369535158333766614f654e446f50496642434b5251754b4a72586d506d59497761766a4c6f77614155634e4568504f38644b6a4545317352502f73553843627639424e6e62707a78304f3841752b6c58353039465a4359453465357841322b5a2b58646f6a3151594c6d705330474c4e50763175684858396477746763384c435069762f35336d43796e424a7334716a564e753735306246707650364130424d3456424e42724d677a372b3167673052573155564977552f4d355578784a543865786148692f344a51666a467049552f36506d706a5a3063754c525a5137423735385836397a33794c4f5a3862416e55772f7a3962795a533250664973336d5056584c39443044422f516b44422f4a2f6e466755736444627a4f722b2f67646a51364438376e436c324c6c724c336274646f38454279475a544f4f3148652f34726e317859422f346f366f36523932366563744e41473636647655354e344e68336137642b774a31587074752f5473794e364675656644703079735a6c7a463245344362694f686d414f744f4f756d6b52514365784f4864486c74424a674c6b524838795a6b34696b55694b3842304234705a3952323358376e3034554749524d4872587737414f6a755539646c4133664c584c6461444d4d626c5942386377657466446e7363634f4443612f63427835686f6d62767164597565583068597532357643706f53466c335352392f565930734a314233527338746e39424a67352b743049342f7076536847754f36446a735754787a334e5477734a562b394e344b57314869654a6e32393234707650365a312f44474f5a474e54517243694b634963495a4f694f61613831474f5a7055425a30524c5875655a6b5842334b6747586841424f427a304f2b2f2f42784957586a4f52392f573044767a6c49514e336a7875653533616a554c38324d6f48524f3266412b2f2f4f6836474e544652312f6538654e2f4358687777387261506f352f6e414e4c332f6b524d4e38454e5450495a34546e5371476f4a762b686c65437151674d5167416955537746725854715438624361537052685a564d41674138586a634b5667366e434e41485144553733337665343554485a37477555676b6b734d633377626f7877383939545952625143416e392f316f4f7348516e4c4c7930692f6d4e2f6d4e576c5a32547669615144446e474762796c322f686a6d446b7a42676b6c30736e3076367864655233464a6367356c497050447a544c343045573377452b6f506970742b6263475232503268452f4864512b56546f68394945515a316d6c583643776e6a2b672f71684164386d4d587648744b787132732b6c4d37326162332b35696e354863453459326950714a67626a5742754e494a6f5473516d42634b724944774a346672314b676970484b735956586a32504f305274636a3852425a2b594e72312b33332f337a3168596e50437245712f38644c682f2f3433586e71397175752f4f574869376f6e7930644a36762f2f5a385a663845414653367a6f37322f485831312b4a3152642b484d76505068567a41697a69342f456f546a747449565a662b4848387a35757552576651726e714d39516337774276537a55454165474c772b55416d61447231502f484538774141427561393735462f4267486778424e502f4f4b3535353762416a7646375067517a6873324c636a73392f506c4c332f356a7a4f50686146664970484d55674c6c5a696a452b77536a3368303733756d3437666237734c4c6e444a41575151774d782f377544535147747859646b374c7348493448556f526e44454c586b6157374951337630334732786e42786a43466c436353562f4c756e6f3363394447505850727a37345a4f51416f455a4f683466664e344a2b59386f78507543614171436d2f3666374a7641704d2f777872386e436376615339384e6e6d6e367737372b57772f35712f2b5a4a4f436e347761303761394e712f3762336876447170534663324b6c722b76643477616553466e3477447974784b73494f2f636257424654384d585755712b7a6f79362f4874487870576e57482b5439662f75596757564e70517069797575666165392f42372f58662b755976306a5a644c7a2f6f536f394d4958763645706e5a7a7457582f6a78764d634f48426964716b3870665032633975426d707767615a4d6633426d702b554972316a32346458587652386b45473174502f77377678317a6463366676593664442f326f353373733050424c4d32564855795a4c764444514c6f65666a686832397261576e354539686431756157506e4a362b4f6c50663770732f767a354632652b33544364633546494a4963336763747a72376e6f7243574d2b41426a724173416e6e307657454f5978584e5574455535424d51364a3164646b4f6a6d3450316a61594658442f71764651474173343678463952454e45784d394e36322b566e5076523743514f71582b71562b716239523964504f54656642457631424f384c5648686f42592f316f6265706e63384c6455667a715479357256324b5259514164437863656838392f37767a414865467154534b5277684f447a2b50784a3535444d706b4745573234396345745634527862694a716835314f316a45794d724c31316c74762f61636262726a687454444f4852626e6e6e74757979323333504c46785973582f33476d35665547786c676f2b69577a6c387376763577413449303333706a75716453566b3034364351427778783133564c62767943776863485875625a7566335837314a3564314b374649565a32474f4f4e44747a7a77394a4d41634f3346357942516b59674c496d3130752b317645445a5376395176395576396a616f2f303179676f51724c317a2b366466546169382f7042574667783435334f7237332f5a394e3935524b516b5162524e7059463962356376594947756a6f364668322f6658583333333939646548646670617341464161506f6c45736e73704b4a4e6254496674434d414d4c2f46663446765235536853624d4e5a32366872694272424144616f68784e716e3944656b496d6e597841672f5834384865512b71562b535031535078705466794e7979774e5050326d6c394334512b684777495554644941774a495870766658444c4657472f4878686a54774c6f4167356a2f564e372f567778485a7564536953536d55566c4f303443414e45364d4c5a68667175436a686a4453496f777067756b4c594b65553861684d4f4349474564726847466555326178514f6a504c64533962664f7a32396575587236424d62626d6c486b614471554544715873633433722b62644749776f774c32365032617a5a2f6f3078316c65786a6b71522b71562b71562f716231543944556a47564679582b576f344d7161695966564c4a4a4c5a52565835663964636550596c6e504d4e434e4161553043737532337a4d7a393065323774526374765a416a3059543443686c346e6c615465535031537639517639614e423955736b45736c4d78716b4265757574747943454b5066795751486e484365636341494157514d556976687256792b2f6e494165786c67765842594442426f6b3041436c7a41312b51765058726c352b4f54477373536649656c78654d6743696756736533424a61743539716b50716c66716c66366d39552f524b4a5244495475667a797935384130505065652b38686b55684d39335471516c4e544534343535686741474c7a6a6a6a74575450643870704f47646e3853695551696b55676b6b73626a3873737676786e41757247784d657a6676332b3670314d5835733262683761324e67446f762b4f4f4f786f366e6257694a676753695551696b55676b45736c4d7854434d6667426f613274446132767264452b6e35725332746a726d4a3675396b664866776b67696b55676b456f6c4549706b46765054535336506433643064414a59334e7a634441457a546e485831514b71716f7232394858506e5a76637637722f727272762b6654726e64446767552b416b456f6c45497046494a41334a563737796c57387878686f69496b4a453633372b38352b374e754a704e47514553434b5253435153695554536b477a667676325a553038396459427a486750515064337a715245624c4d7461632b656464323663376f6b634c7367496b4551696b5568416e313135504a6a6f425867505142306764494778726c714e7432316b44414377744b4f74566b4d41524d4e6747416259434341475158794133666634323634767262502b767a7a6e496744412f2f6630356c6f4e45556a2f767a3332325047777142634d50514136514b774c444632316d74714276587342414a314848565772495144434d42674e417867425952414b472f6a712b656537366e2f73757975504a307630676e67505142304d36414a595636326d646d426342774230746b5a714e51514147695a6b726a385467307a68412b662f3764543158377a7879757847597979696745573837346d544a55424a4d2b39374b325855614e3554634530426a30787457576d6c445a425a51596f615a3142694768696257765a65764f4f5537502b6a385468693853625077793354784d5434474544326a34787a6a6b6730466e77654154454e4136593539585057744167554e6667576e6b4949364f6c55336d4e6e766e646639762f7869494b6d715066314e7932427359514a357733444742425261393947774c51496c706a614430395647425165334c6f494968686d2f7235363067424a4a424a4a67304f6658586b6a475072714f575a64444a416268443532332b4d333554303044667272596f4463634e482f6b30636575354651582f31314d5541754d4b4476716c586e352b6c2f374b61564e344c71724c3875427367466872377a623753762f2b4b4e56784959774749716d464a2b4d55754351496d70786267356d61376450444d6f4d5330374e36476245495a56356768766d4d4b68784c5473397866764f41574d637a533374506f7946634b794d4434326d6a5642305667387a314456676e51714363714d70366f6156453072633451336c6d5843305058733932652b647838344131726a4b6c516631393853684e464a493275434969717275583764454e6e78464d36674b7057505a776d43616557597153726e4a7046494a4a495a4448313235633167574163413436614a41376f4250564d45504746577674676f78384730765a42796a464174614648744f356f527a74455a3064437171674244483331325a516537372f4872674f6e54377a44642b6d392f354c47624362622b64444b4a784d5145544e4f2b30362b6e556c366e727070554d6d6e2f57385039567949782b7736397171706f616d6c424e42344841583233502f4a59783964576e58386441447a57742f4a6d6b4b33664d415853686f43565758446d4c7062435a697852652f50674c425956786844564f4453564134532b782f705764707a665a31392f762b59484142686e51467a4e526f4b34706c526c5350794d3538794e42465539466c6b43777254414d373858594d79332b5145417269686f61573344784a69396e5a746c6d6c555a6b6e49497938716148385a59525a476658425246686355744347482f48426e386d782f414e69427454576f32456d514a514b316849593051684e7a66514a2f5439455468444549516e4943536a41424a4a424a4a67304b58726a67506e4130437748416969594e3637564e61484277444e4364617577564549584d694772716134765933676e6f41594c7230332f4f5a4c7749414c6e76343772714e57616a2f5a39662b5078434d42674867305037395345784d314730756a67474b78654e3147374f70705156487a4a7348414f4445657271322f452b51734b2f2f524e4a4575704c5571677078444642625537527559305a566a7061347659686d6e48712b73587a686f462f7a6b7776706c763146424375686c7a2b6751764b695034594a6f566476746e4b6a51482b3839324d566d5970554d6f463035763162797969516e6b356c4f39497069676f74556e32304d44634b394d6e525462374e5479354a3355496962562b4c576b614264464d347754596f4842584e7452416e437351674930415369555453754443324267414f36487064462f2f5478554864514b75716f444d5379576f48476c652f6b3177794f543565562f4d7a5853516d4a68434a7864446332676f42576b50435872696c444b7575356d6536534a7343716d456870696b6777645a55596e3441753136494c41466d415479715171544e38676346684565552f4d6855574d45344a3649535679754f714d54695454414e41355a70777444544e616b46736772616359646c4d6c676d37744853316735316f724c724834386f3045304230374c4e684b61476234424d613872383249536c33366174535a55626f556f6b456b6e6a596b6442397156726478663363474e4b4b2f56492f656742674d6d78327158684857376b614f3178726e394b6e2f336d7832464b617959435769457361707348727559334b516744706e4a77545331344c4a78634b365971674d7039702f313530645463417342754c6d446f3461597a437375435965542f54624b736345796d5a5a6e5149744771302b6c615976627867757a5530544378424d455378592b466465366f7971457158426f67695551696156677958633653685a383273356973567361367048363779317475596652734a36755654585635433274784e524f5930737136716a6b5034777a513743556b313551514451714834704957797a697266677a4f374c6c713153393975614a6b497a2b575a6556316171734759566e515851775645565539686841436c6d55684571302b37564c68444c484d7a314651655056796859304b63716c32444546322f5538304d2b2b6170634239364d47726a69644c644946595431556e7975516e762f70485033737968476e5644616d2f73665576336e544665514151686e366d384f4866722f364a612b7657777857707637483171724d324f4141414941424a52454655537953533251396a624b70445631534642617173526255445a31506d522b57325552475a63316f45486c466743514655596c675a707334744b4a52644d466c4f4f3262544d4d425158614f4358504f6a52614b49524b4d51776f4b6554734d79545a6947416334566346354233565a4f704d7075676c4239375756755770346c434979686f686256446f4b6d7a4539553559687148425952306f62497473506d484f415670414e535468747369776761516a52414a7a3579646275574e487159594c3041395a424a5861486b374a46396a6b554456347777787670667665536e4e355535596c71512b687462763850696a56666553455472514b776a6c424d5341356d4578514e5844414e736b44674e47484674384d3156363064444f582f495350324e725638696b54514f6c462b6b415357715166444b6d68586b4e6964675551564d797a67554257436158584f4570416b6c7173464b426f39594b6c457461316a494646506e727749714d474b476f554d495556477a67747a6d4250486d35707936496732526141796d59574279596a78626378536b4a6f69496f4f767037505853302b48554c52566566394d69454646467a517079497a2f4e4d5157787a505852414d5130425959704d4a3430595a6945694271734a6f6f4b3967424b4777497854616b2b4265376b42363563736e6a677970394645755949497a5941686a57313244794f4d6459426f472f78774a552f432f7663315344314e37622b58444a7a3638764d4e567a73644a55316a4e68414a47474f4c423634386d636e5033446c6b74444871514b70763748315379535342734d6c32734d314e58424e45464e7a7a45394d6454556e544f46675462614a55574a616f50757250467251367475714d6c4b566f62424f4233434d544c4361494c755a676e327570705a5756334f696168706132397042734c76446b66413366794b436f6574355a69563376477251585836476c67686545355272666c706a61746238354b4b70484f334e4768674177365169382b55465a614a4b756138324c594a75694d6f4e30496365764f723452514e58337338466873437770744c7a42495a687a65487777532f314e37622b516b352b344d6f6c396634356349476852514e5833762b6842363836766d376a656944314e375a2b695551793879694d5941524270457a507a6d78635538436a50766657305a52736168714c4b6d437139374b5563515957567a50526f676a674939314b69576c542b2f376b51436b547771713872585a6959734c54684669576e62626d5a354675476b6257534d57626d3074476a3769696f4c6d6c4e5276524557564d6b47312b30746c39662f4c6d507a6c5256653362524e4c307a45523047695034306d2f6c523334694a65717a464d3751476c647445326753524a6e7a4578454d69317a6e4f5a45794b7a4e41697a6464635a34777842426a364b336b2b4770686f6f344c4452656b2f73625737385a307a596b7839417044444756725471594a71622b783955736b6b706b48705372724c435a53706d76304a7865754b746d6f6a756472496c505249685a52664b576c4d59586252736d4a424a557751626e37434c6b784f544665646a773345684d545a614d386475314f7171514a4d505230747246424e4237336c5a616d616872697a6332324363725a4a36695163732b44434f504a7971362f6e7a327a62424e554f6c4a6a6d434a7277754952785458795534696d636a54486c4d7a78336962495358767a4770364134415a6f386359724c676578775a716b655a53685659336a7a4d35464f4b767a354f35366a2b30673954653266692f4f366a79352b387a4f52576856363765706e774e6a7241504542686476764f4c7975672b65516570766250305369575147497367324d7a3468496f696b556462384f4443465132324f466e56765979714830685142647861387a445a41666d466170705531733031515564516f59343659776b4757674a553259435831374a6377624d334373674c746630564359484a387a48654b477845686e5572434e493269464c52554d674572453446696e434d57622f49396a3067304269316964334c5430796c595a76343146454a6b7a52646a444a6f57515351537a583470696d30364c55475943474343424248476b6f627650624f6353493154472b52674362757867524f5a3451786f6976712f2f6a464e5154527a7a51325469694a5a776a452f73444d6c56595642792f6c7950484767524d33466d3634344438513242446b6d444d37735849524c502f41785848726378374b5033596e72367a304e71622f42395a666935782f37712b7a2f37332f6e7633482f7a762f476377662b554f645a7341324c4e313078504230643836542b787459766b55686d486b51455a67714974466b325a59324951416d6a6f67314a6c61674b6c44702f42563239474c63373044466d6434346a6a6159324f6332736349567551686a4636562b6b57396c4744596165526e4b53496437635848493845674c6a59364f2b613239794d513044707548647772715354553535546d544c4d485359706d463335614d7073364771476c53744f4172484651557132592b6e545147574d7445634b333339425246474a34324b4776424e3765766a666e42462b6e4d37384758533642697a683868324a75514d716c4a3862673447685650414c6e414347304c616a4e553333316a30522f6a6d6f6b76714f366758556e396a362f664a7063665a5a75314866396949482f396855333048463967413449543644707150314e2f592b69555379637a4153757032647a514178433350464451534245704f6d523853424a4532736a56456a444d6f386543647a374949416c6b69304f616b56474273374135764f57325a307962494c46336a453433476f4f7470364f6b5546465878544545546c6f574a386247732b5847694b6c785273732b373764336a4632465a4d413344316178346f61667a78387331506f43644b7165713375646a6a4347694d68676d495755494b49726c6d594a6d43634a5949742f386141724c6d68416837467162537245457754414674424c315834576b335978747a6853387a4938445938792f41624c544b31695837396d46514f3848507571312b4b337250414370763948312b364372384946764c726f45757850765932446e552f576242574e64697a646563666d726c2f7a736a766f4e436b4471377970385943626f337a5979426741596e6b7a576446716c4744504432654738457537357a42634241433174625855667579587a372b5976724b33373250654d3264643937743639414943442b2f66586651344f51564b5177694c575a4b636274593362786566442b79717242516d446778505473516c74712f30504156624b734774703076623330486a6548586b794c464236617245706a4f49323179514956747077336344554c3652625948462f43324352396d37414141425732764456355931786a6b6730426a3264516e4a79456b53455343514b6c7250506a70354f495a6c495a466658697149574e53726769674a4e6937683268664e4c4f705830625943536b354d6c49314761467647314878466a444a70717035464e706978515a70505133483132556f6146524d724b69617167714d30313537615a7147616a307152682b545a416b796e76426779416e664c6d5a7a38693333614c694e57313450334d7a6b583433756c586554336456636570414a44364731322f4437726348767a65365666687a4d3546645a314976613956686936334236582b687445766b55686d4b46624b674441746b4736424a6732495a4f5a725173387a50316261653438664d6757455873584e444d746650524c70466d4234462f35624b582f6d78344578686b6730427334567042494a6a49324f59474a7346424e6a6f7867396541444a79636d732b5645317a624e4c6d364b714a534d753554414e7739664e6746517941543264386e7865692f677a5077363243574c674445696b4c59784d4742684e32463848786e58624747566561306456334732447776325a4469384d3031383955694a74496556782f51482f3567634955414e5537343566587a6e786b2f55637269785366325072723461766e506a4a757461445446643350692b6b2f734e582f394b4f79694d6642394e32547675634b753736566b4d31347a75366c7a3639476342554a4377495476546f736f6676446e78734746517a7671502f4a32762f42674351536951436e794f56744b4f4773586a39473339554f33376e5555665a2f37593669396e57774f6359533967705347314e30634448686b453134302f706e6b4b6b54544447374451306c7a76355a6c4a4875514951595667415a36357470333168436f6955436535526a304b36626449387839644e65385055674444476f45556932635942685530464147524d55756d596761707045454b34747033326736476e6b5a67416d6c7061584a395070354a494a37326a395a6f577954593443414a6a444b7143624f4d417430694f625a4a4b47777456735775514b7532756e5462747a573562347534616b7271465a496e72483854384144344e304d6b50584c6b453165385a46596a326c6a59384e2f3636352f4f6e627276755041415159434f764c5031663232733546366d2f73665637385a467466373645677a6f416c4a7872653076393032744f6675444b4a6139642f4e4f612f6c796b2f7362574c3546495a68644f4f6c78684c5934777a4c4c6d4a2f746133625262565a64594c4a4d6c386f774b552f6a556d42346d714a7a35385a7632356f555443584a725861306f616c6e7a3436427047744c70306761494d5a5a6e5649537773753271765578514f705573656150436239706271546b3536584346563172684b47742b484653465154644c7631635938707359434a7071562b316c67704b366855534a6e327451387750344e45436371435051747274754d47514c374a686d3933434877725074437773376b487a317458387464377042414642414f4f33353630436741515932384f495a4e3465652b792f314e37622b584535372f72724c4364544c7748707a5078444b7a546379767a337665354732377a43524b51424c3245576d6d61492b4d71794b4f75336b596c2b7a384a48364731752f52434b5a3362695a6f45444767757a58757a565549454777556e72783337644d3545694a324a75637768515153534e37446a497331366855647335566d6838484c784f6b4b41466164484d4f525647794c61364c7a6c39515932526a5234344d505a336476485279584753624d756a70564d6b75637457616e397a35755a6b67762b62484f51646e336c4767694d70637a44474449494b5a4754656475663678544351785a566f775370697153737750454c414e746c3959784e374e6c326e4b314663567559472b7867547242644237327650722b6744572f2b495a4e2f2b7770674f576d6f7655502b76306e2f623864643843614232414c685a434b37797334665049586e4157784e6b76553553382b3156727050374731692b52534271484968506b3942663243566b434b4442417772424b3177674a67715762646c63367a67434c514662356d7043777a492b446d776b716a6f6d5568727359494556526f476f527a386759357878614a4a6f31516556615a7a75455a58346333457851305074786e444f494173504b6d5731555050557a426a557a4c6d442f612f686f6b464f702b514638477142582f2b686e54793765654b5872633835696c386330653645626f4931646257426441506f42684c59416c766f6257332b472f71716a594146676e494735374a31417067415a466b536d304e4e72555679447657436b2f7362574c354649476767726255434a3277743272696d774b71697441544b62702b716d50354d697947374e375a4b47352f7079772b6435412b4a4561744b5a5a674f6d59565255572b5067313654776e4b353068576c346269694b477172356358424d6b4a504b5a6c6c5556594d443331335a47455045497733504459576a756e6e35666958524d426a72596a45565846504159687034674a3137367772525541334f4b665533747634684d4e59642b6e6b44776c5137625a44487077712f685736425567614559594653706e3274776b627162327a39456f6d6b735342417044496d534f48674563577a413177656e47556a3349583742666e46717859706233715738446566436d476349784b4a51732b6d70656d65486542797356505a37486259686673462b526f3345344579394853324c73674e4f324a557864354c507561684b5942684f59305268476348754677455556345468647a39676e7950713262474c6647325961793448586451664273676457377a4d464f5672756d2f772b39414977546b4c58515a732b74434a6844744433733071622b7839552b776145384c5336384441434c303549304c64414e73326d6f756545514249676f555a434945706a556339686853663250726c30676b6a51666c6246444b4e5258434643576249544346673064564d4d61383633313855733445566456793279646355634135687841436c6d564345615762495669576d57642b334f7439796d4e33705975574e454856744e7a32432b634d544e68477842494135315379487367532b6562487664366e50486e6d792b50396f345a515675472f44585a4d4851346a393931686b644b4356713536666e38736a2b46595a577058336c616d5972467164385667585a66554c78636c6739546632507266504f4d66526748636c506e324a72665830504247416f42587a516d4d3039516635336574464e3456553333377834574a503167546e74395841314d356f4c4c68554536576739546632506f6c456b6c6a496b774c537361454b46454e56744a3973302b754b654352544f5448457244535274584e584c784d45416b4b484657714645565249595374326444546945526a726f7436307a42676d6e624e546a586d7838457851573770634979785146476c616c413479356f6177795245564c6a727477685735706f773247327a4b7a452f446c6b54354a454f4679537135495676417a534a364c6f546833617653585555562b32714b52504e65784b6533792b596679542b3459382f582b5655707865707637483142384578616c6b717546487a562f2f376c39693165312f322b386d6a6d32446d744155742f4e34684e704c476d39337a317755664d54796b2f7362574c3546495a684535526f4e784269576d355a6b6278686d343037304e47664f544b6c2b3837356663577151735075706a77694a3358434b436e6b376c6d5274685754424e4978757063564c59716c6e38353436645734766b4e716461557a695359524b3048424d6b424d4555553547614d4d785064757943577153707836732b4e59414142756a4e4d2f356839494b4c7a716c6f6b444f365471376f754d4d4a71622b78396465624d37514f6a413950375333545075782f6f385a48763372586143336d56452b6b2f7362574c35464944684d4b5670744d34564469456473594d5a62583464564f657776502f4e676e6e6170466d67344b347739456848513642633435694367764f684f6d2b636d654d3663574b58634f39614a774a494a745342687a5430384c792f7734354b62446555367151757053304c4679785a6d657a2b33637452642f385a3262386656766668395062336d7048744f704f314a2f592b765035656b744c2b4872332f772b2f7549374e32506e72723265727976314d35764a5350324e725638696b637773334770774747503235715538507a706970554d32503836354263464b543655564d34585872536d6e634e6e5042374362485251614553305372556c306869734b564730716c594349514355614a49534a38456731724966356365413876347363495277544746722f7641587a6a38546e502f394a6e4c7a77754f786a722b3134427a74327649504f7a6e62503433353537324e494a6d316e2b2b4244763855357930384e61307031526570766250312b65664368337749416b736b30666e6e7659376a75573139306656316e5a7a7457582f68784c467834584e4850394a652f6644517650576f6d49665533746e364a52444b443448594c624438493353725a494b4661794c52414b7338614d6835527378744b317771372b59472f546e4f71707056736b464174717170425746593231633477444553694868764a685951673777314e4331453443375268616c42557864347331664539706b5851314f7247432b567178654e5258485031352f492b71414867354958485966574648793935374934643732542f662f44677a4d7a636b506f6257333851636a586d616e646a39595566642f325a586e503135784350312f5950583632512b687462763051696d546b6f45582f3379456b51794b7a39527331575475633372745a3233304737395857362f41746852385471305a564e316162534149577759506e594b4c52536941696d36642f51716b727451334b355977684374756c4370515236393544482f684c58585032356b6e66355a7774536632507250317a6f37477a484e56642f6272716e4d5731492f59327458794b52314234655658317452676f4135444e4b556a574349484b4d6c684c566742433667626c68364c72764e43764f36394f526a584f654e355a683643583343716f47302f4b3347536c6762306861447a686a655a666274416969696c533459436c77444d4d4175676f664c72784c47595454546c754946312f634163424f6f2f49444451383841595a6861466f664f33623132785550486853706678694e724e39744c676366616364346f686445765835657632442b6b646e3070644e4f57316a78754e58387a4d4e4536702f5a2b72654e324d305668696554465a396a72495a33495773392f6a326673564d515739726141682f72395072622f495731465939664464574d66382b596664336e377258723041377533312f785042495434625277722b66347361596d41454462754e336565486a66654d586a48357877627774644c796f627637586b737a7969677173424676583171386b7653724e545970726465434845394474372f78332f7071366558646e7366596d6d3571616e553468455936476d33786d6d43506a6a72474e584f73627943704363726e5356704e394e2b363657582f6e536173795a5930635050762f35542f6f386976574132426f59526c2f4e4a6c596e7050345a726e3838735161454451447a745142324e4d365a3034367666476c312b504f682f4d31686134375533396a364a524c4a72494a48464e39315077352b49305731674447374e58645961334244313333582f54685931765465424e4c5471644361497068575550506a335369685868676d56645155495767546845456766786430774f357356476e78656c4e54444e2f397532767a486a7477594254625833774e722b31344a3173677632765858697a4d314a5238594d46523967754a725146775255554456385967705036657767636253483878524f756376377737642b334667772f39466a743276494d466d546e4734314763765041344c446e745a4852327475506b686366685833393066645844656e584d4930596a565a383843464c2f6a4e612f74434e3435434d73446d59365273324a316a35337668424839394b6e4e774f59696f545645796636644e6e44643964396245662f5439622b445141676c556955656e6c4e5343587471474d7348712f37324a314832622b666e61314f5455587069456774474576596e32317454665776353576536e5139544f6267577644635755376964696c61506862444c6e5837476d4c314a6135557475433354724d6a4d4542474545445674676a41316c7276523055747330756f5853784373436e775577573659554d736d434e6d785049784f346635456667696c43397776373330554a7938384c7051366b4f336258384e7474392f6e2b74794c4c2b3741726c33374d67746d4767466a2f56555047414a53667750725a32774469486f41316e50622b76757952653635426534767672674476377a334d567a7a74633969795a4c71393051366347415576377a335566636e43634e564478414571622b783955736b6b6c6d4248666e7858684b5349447631714744764877636c6f6f612f42314168444a37524b57642f496974645754716348666e784e6a2b4d32533265432f662b6354414e485a466f4c5043345161415358656d6354567131534c516949325a616f717a354b63672b4b7a6965454b6d794b317335714552584f6d642f6f6944706349462b53717a67777a55656a324c35326165475667542f326f35335042652f446763506a6d4c74312f2b2b6c3358314873474f762b536d7167634e674e546632507264594d646663685072366c32783975742f333175756939317474392b48313870302f764b445577532f2f4f78546937754273666f75674b582b787459766b55686d506a79696c6a512f567371416c64537a2f35704a48554933387a6342565468596b4c7168436c444b5249735a7a3654444257794d594f6a706b755a48693051516a635552696361792f785a3266524e43774452726177414e6f3353396c324f43676a5a474d4d7a53356b6456474b49615230546c69476f636d70712f4c343839746d3243616f6e68342f79473662387851714149304d57727a783132666b61462b314f4577632f76664e44583678686e33514132686a71344436542b78745a666973796379764c7a4f78387353766d72684a4f7a502f2f56326632574149414467376475336c4c312b594d6939546532666f6c454d6a50683064494e443679304153706348517579432f464e4330705579396d6252374533434b31424b6879502b4f744b353951456962525a504f38433746625865736d4742356f5767614c6b4c355874626d776369714c6b6457497a44514f636358416c66434e6f4e3262775a327a7353464478764173686f6b776e4e652f58714571783265474d67537432397a66446d747162787849457a75794e533850474d49566e394b6e34745152565164473843776c6b6744377a5235385967566d37335765547956544e7a683047556e396a36772b44576d67384f64654d716e7745582f394236474f456864546632506f6c45736e6867784c5453706f4b6f5a756755702f355a4565486e5051357868683456494f56444c637a48746463476a4d6f4445785470706f66574154536253506a6d434172625a53637635354f6c5379655631554e697571395447616349784b4e356158504759614f434b2b7546716351307a434b55743955546375722b54464e412b6c554b70756a5a7567366f4d467a2f6b526b4e77386f4d613743693831504c6f7778524653576c7a356e574951494337637a6e70744a303153476d4b706b5338494d69354453726177654a78726c4e583869436d614132494b4c7439507777434441656f49633535652f7676354b62486e6d706277376d67574d414267554b574e61616a2b6b2f736257587771524d767156574b516264704f496a734c6e6e596a5a38724d72617862684478706b4379376558734d42504a48364731752f52434b5a655a51795032514a434d4e664e7a5368577941424b4645316d345957566a3051557858777767315a565134575666495832516f416859475355366c73536c514471634932636a6b72614b375a3579786c666a6a6e5544562f445671305341544d5a44414e493775427168614a686d4943544e4d6f53713354496c48456d357241636d703956453244716d71596e4269664d6b474744744d306f476d526246534b6947435a4a6b7a544b476c2b47504f2f75616d7163414355335a6a5573416961456f344a4d69307132764130716e4930785a53385768394e425453465954787035706b6779794b6f4373744770596a7352672b5743476941414142747a6230596d7877435746646c63727a7037477a483667732f4472666d734c7574465035692f4755385a2b7072336a7a6a48306f6e3239635371622b78395875772f7447746f79632b2f3164727a6c516a772f2f556567726d4b3755746869794768744857374b7356637932512b687462763051696d5432516f4d4147686b774c466969624573636a4b6f52655858746f706e496f30574c7a773250755331656d6343437535706b67707a6c436f48455a6778594a31703150565455777347784b6e476e6f67633952694757614d4931693839505530754c36656c5854304e4c61686f6e787361774a49694c6f656a7277324a705038354d64573246674c4c4f4261715965534b75794b594c646c6137592f4c544533612b2f706e4b304e616b595330795a49454b6d6473696c6669697741574a7a566f33752f654946672b7052633959555074643332516378366648474249424c742b7a4252312f4e37394a362f2f4b6a384e54694938714f2b77647a41754e6b6472517757676467326f72663366546638756e6a384f625254535750572f7572643344536e754a576f374e426679486c3367664e4b525066486e674c4c536c7278756b765251744c722f753971586438596552354c464c642f30446c38744658442b48534c58767a486e74716351667558333630357a484e4b524e3939377865394c6935392b44675558662f35375161777a443041384162527a6668316b2b587269383763553843312f35714b6b6f346b2f565078425438592b384a5a58396e334b3637772b476758794b527a41354575724c6f445a6b43677076676d70704e5761765542446c526d6a784b6d4238484e784d554645324c5642533955465156424a704b57637445676972424e49314135696433446f556d4b436961776972547a316b3277694c4972747652314d70616733744666727a4d6a344f71464a73677a3963476e645442547935725434394e727448484a764d652f3356334a3535554a6f4153767a652f2f34694b2b7837596d6666596554766678584f394a2b42704834746741414268576866416866713364375869507a715042737030352f6a6e6b3441662f475a6e30654d7a5858386862752b444d37514f6e4b485a5755462f4d4363774867502b2b53534762772f736e48483653324c5044654e6b346e6d6a3948597335377836434f634e764155396c5a3969385065666d34764a55736371774f626d42443431644b44776d54554850376c73335a784874303766496a67452f5144777a7839626842664c485039384a3343474d59496c77396b64334765732f6e2f4f6d4a3957706d614e302f504753503435764b2b37772f54726c30676b4d7835686d4655314d52433642636135485157713041545a58656b4b616e3430446c345944664b414b52786f306b434a34455a4f556453716d68696f71675a684351686877624973634e4d7357556455434248424e496f33592f566a666879714d55454b7236364a67617077434c4962466c5269677277614d3851306a755979356a64334475334e476b596e5336663542545a4136576a4d4e63336978613738446656795038777a642b387847565078314f4b4f7643685153387043337a3276342f376c52355739363276444f6a367937632b58764c4c306630314c726e75682f767558483457315456323474716b722b3969594d50474c3143373849726b4c3432542f3468662b6642786d7576354348756d656d2f312f4b3150786e65595034704a596355526a39347246534c3330533753383864364d30752f46523762392b5249494b71723963475074723935786a5877387462676a477758492f663042706e364841507539354c59517a6c79624f79715a663757456f6438683933656c6c616e346b2f67432f456c7341647234314a2b7257784c4475482f356f5677444e4350317030343642746574754d67315a57356a6167392b4d506c3639726f2f306a32336c414761567630536957546d5130515175722b366e3149493363796d6e5155315161364e4756542f3573654263565a524a4d6876335538704645584a6470597a444e3075755064785868494375703475716b304b596e367963366a51424a58726e4f59486c624e73792b6f674a7369724d554e553957392b4842544f796b61434168736741727264666a78374f2b77332b3472495850784a6641484f31504c58416f2b6e333863504a6c2f48397137576f6a5134414c683079313663382b6f68334e557a50323852375959697141664174437941632f577a654252586e62326953477362563346745578664f3044727731644568582b65646966724c38586374693745793671356c76684b446674456e635043482f77356735756a33496a4f6e6b717761656839664774794e6f3066636f345862753170784c492f684f383066645032357252743747552f6f3732642f31776f686f4276547441414f513738624e37656455765437425144584e6e586875624d3777446275424358742f4f615a71502f59697a364269456539304357786f39484b564677332f724b764f55796e666f6c454d764d687333727a4177416f534a2f4b6d694444684e64716c436d3279536c4b76564a59326251337a326b4554496454464a66784b786f342f3176544e45416b37446f686a30314b4c644e3033656448316254413573636861344c472f4355474b447963786757466c3967785157714a3144704c6b4f732b517172437971613965614571484b317846574d65317a39344452447a33752b694d424b537938726f58437853572f442f486e5051383978486a2b6a34397342622b5062415733687163516532643758697a614f6269714d6e7a4e2b64316c715171332f4f747937445553364c4d34637a74513538702f6d442b4d486b367a6a527066366e6b4a6d6d3334326a4d6f753759336e4d302f773452417232455a6f4a2b6a31683141484b2f38552b6258674d4a2b354a594d6e77754b76704c32542f4d573334742f5a757a774c362f725a546345746947452f68526663706c4c6b324e5355452f51346e376b6e677a614f62384a336d443771614834637a7451345933376f4d42373576722f6c6e6f76374333344643566b626e34746a4a474e345671657a564a7469354141416741456c45515654766c756355706c4f2f52434b5a2b59533066593962647a6d754b5741714c39716668366b63584655384f394b78674a456674376d517867476a2f42596559625675466c61786b6251734f79577563482b65624563327479674e593467334e5663314630565645596e476f4b6639624d45516b6e365846457042674f36795034386c37453574626d38394271436c517650726f4b6b634d59306a35584c39717a747a4473754d754b6635635a6976784c4332715176414b3258503939465852306f756d6f344a4e723351695a2f394557674c6a697a3775692f46462b4158795633343146437748654150642f316572427036483439307a38574b53476e7a5534365a7076382f6233792b366e4f7362656f71327a33733271597570493064565938564e6d486f642f6a5530507659644f484a2b464a3851646e586167754f52507a736a79443554506d2f4b62556b5450324672496a4d7853395375374271365032616a534752534353466b5a764b7a6f48692b7033733665305732623552755a334b567532554e41586b7777415256622f5049516c52564c2b54693648724d4f417643794b33665855315247502b444a42747771723765524f56336c6a56744e776a505735455642354b536c34736f7267616f4d72614d376a7766782f7731325a7773592f7553444d427062506439327358476d72444c46365744492f6a744f457874504c79336a71312f6642627945386e666e38332f5036757a565257446232506859622f657a4e42666863504e2f7a38447252794661634e6a2b58564f306b6b456b6e5963453042716c78774b74487161326763776a412f5163356a57526145714d34457561577856516f7673553954735050344d31474341464668357a67487736653538554d317a52687938544a526f5557416a706a6236657431316f477873496163467359456462514676436866486e77584c5337647269706c68794736546a2f39394f4e66654f474674304d3771552f38364c2f786e7466783574632f434a78592b6c794a776130567a5745363964635336384159734c44383634365932346e4471645858366165666676774f51335174314d4c35593932537376446c775865424c7751376273786e45344b7771555a2f596e4172596b744b582f535439695377716b514c6249644b39473862736638654430386d67783461476d4e6d64587546564d4d396e2f6b69414b436c7a62314a54533178626e64732f734c61756f39397a35683933656675745a7478484e792f762b357a6345684d544e52397a4669547657314632376939574233654e3330334677354f684c6467396b2b72357a505a54557772364154485371537956514a5a41677a5652304279552b374b6f6164546945526a344236314f71557754614e714135563350734d4134694764787965475364425535473079366e75637a4235415957465941676a682b68756d2b7a554a6649554a634d314c556a72396659424d506c4737564a4661736d7a5a736d38745862723030437536364159413634432f4a6168497048446338384f687a7557356c4c5747637a3638644f6e536d3563745731615832392b462b6b76526b724b77664e75656b71394a62643842665564785733412f5449662b65754433643850763731717457625a7357667653705574763570775050356579316f523537754f6548345a492b4d6c5a6e767064664e7351335575584c6a3230624e6d796234553546792f43304b2f7632466b3243725238327835664e314471725638696b63772b736d6c7151652b2b6331613861576d3157425449764868424154766236656c5559434e6a623335613266354a5872687468466f4a7157543547765263444a4d435234494546652f625579326d525a376d4a51674a6a2b736676416b434d415367714257797357746632594c65482f7834493337333469344177416b717779584e4b6f36715a4a4d6b7867614448315135793559747578784150324d4d62786b43353851554a4a3935426571434939473834677a503430516968664637483839327151714c6c3354374463455957306445485143754348574141747a306c7950357a4d746f7566436a344533754e53336a397a356538587a71726438586a4131576375746a72796d7763644c455779594242336268777a2f65694f393834354b5378786937396e6b394e526834416c56415250324d735458413144554a37647a4a4e4d6276665279746e3176702b523443624e506f31502b3870417377786a6f4139433962746d786b363961744e6532494670622b38587366393477436955514b795766386459477252502f536a73724e394d484d5a6f6c7a516b7835716466346a75366c543238474d42554a4334495450627273346273444878734731597a7636502f4a32723842414b515377525a49414a424b326c48445744794557395156554d33346e5563645a662f6236715154653064457642684c324a2f72625532566258525a4c64574d503658624863595931486745567372775a304234774e716541464453424a713069745068524e6f4563744f794747787a56795a5653302b6e69686f5765493468684d386d4138475a6e426848613174377862564179636c4a57446c52647359354f4f636c36355141327751564e697a77516d5461563965433861534a396d6174346c7167795a535a563350456d5a31615a316b553341427859454141665957504a376538584e494d3950334c526d77656e4f70632f4a494f624570592b4e36634345364e427275775a466e446751366f6e717a68657a706c3459757439692f362b4c3150495048455671674c6a697871694b447632416c6a313737517a513841374d32356d4a6b46574b304e674b762b556c41796a5a486242394478746436384261786a437132446c616443546f502b7370426c44587531742f5469706253464777376d707a2b38395068325441716737302b3954564279692f75436d416e68722b64365344694c6679442f6d6f524638706c586b4872786457674c6a6b526b345166796e6a4e3237594f3561312f652b2b6a4e2f434c486d752b4a45355a2b362b415952753938714d6a736955514b493763502b503462556d2f39456f6c6b68714e796f4d51646469576d515a695762534a4b6f45524361682f744153554d6f466b4c50416270566c37334e785a5277434a5436303074456f5768652f39394e5851644672633774336d4e5455516c7a31453152426766473056626534646e4332307655736c456e6a474c4e5455684770753659524464783545756366314e697941456c577866545551776132522b414c73783465696b675934574c58426158694a743554552f61496f71694f64632f384147364b6a4e7639332b377571504452573258445633373865683966656a2f5575667958364976377476424a7566324934486e686a43652f76645538612b65306a48397a716a4f4e466e2f6a775239522f3730465054567676786c6b6c343078445a2b566f487832416448455036786649352b6d477763644c4576767a46566c3058765958365336487632496d44503777487a53764f674e4c5a426d505850695365324671562b5a6c752f5634632b39425462372b372b6d50396a4c4631666c372f706948773355507575642b624237646a36797644754868464e79356173515448486d6d58646f684543714e335051787a6433472b5068454e52665830594f554b4b6d49493974347a324763524e6b3661754b513533425149537161683739685a4e6c337954554f45486f587951576a366b382b38416e3348546a537457415a74775a4777446f7868386f6e6e58612b3147394f6b58794b527a4742345441587056736b554d613471594a7a445372765842626c75584671414d457851376b4b62632f434945736a51554d4b774930452b6a796e557861494b57454633757161574671525453736b49714242574a686f554c616f4c49694c6f365a5237432b7363464557466b685042455251775853356a676c7262326e32626f485171695852797172597a3374794d5344512f6d36496c726b4c524c5354533374666661562f745668666b7458467049517250503159514171584c4f53616f76646d2f43557271467049353137383570694257635030722b37516d366956676f4e4145705639384866762b386c384141462f596b38536b44333254424e7739627542763535515034784a52667979643671746f7a6c5567684f686e6a50566b306b767733554d362f76614969472f54466859624a303363505a372f53324e5a566c2b747836314776376c3750306276656a69556555795866722f4530716d2b564451475079626f376e476a354f2f48652f74487366342f6e7354362f3367537a517a3439364f39307a79496141684576584d6533567258336769575a665570696a4c67664f39636d37424e55446b634d2b6e3850496c6f68496a36617a31753250717467324d59762f654a774d644e6c33364a52444c7a59524546594143565741517a6271664543634d434357477659426c7a3337673042794b436c58433530536373574b626c797a784e6e53795444686376483230696f377a3563596a47346d434d49546b3557564b486e6b3542555252776268733341734851797a6577634d3666433463435664554331527152454a675948304e4c6131745a4536536e55336d6d7a7333385a4a2b4c4b47414d6d4378545a327159424d34496e444d77324b6245547a767269466f6350654b774e7a6a5654654737636b41514d4a59773064616b6c6a56424b535066314c6d5a483642434135534a774a7a7539667a707035392b48756438304f2f35747151466a746e38323972465436766b68526465654c4b377533754e73396a5a5a78482b374833506b4f6667317131625631517a33744b6c5332396b6a5057566578305262526761477470597a56682b6148543966736b596b4f73795879565a746d795a373973666b7752637548757935345558586e69796d766d467a644451304d616c5335647563464c424a676d3466637a41375750466437614971472f62746d303356545065736d584c6e674451552b3531516f673151304e444e66395a4e62702b695551794f33444d51536b5442446a372b2f67725753424c324e336b536d436c4450434936726c7655424743374568515450553054694a74357165396c54412f446f34354b475743674b6e4e545033414745504576694661636c7844542f732b703741736a492b4e6f726d6c46597271766e7850546b376d706232564d6a384f6a6a6b6f5a34494541634a6e756a63446f4c6d596e3177694b6f64684374384e42793142474a3030304270586f5870632f386d556d5a6632356d562b6742443341616f4749764b2f546677304d545130744645493055314577394d396c777a72746d3362567266616c306258487a597a3454337668387731384a583256327549614d5379724e35366d754a4731792b5253475948544d75766a366b475956706c7a552f3274626f4a6f51646f685a2b4a424c6b315a78417073376a6d78366535696b526a6949625530494e7a70617a3563644169556169712f7759535469544963746b2b494445786b57642b6f7646345766506a454e507936324f71676250793573644243376a5a71524d4a4d6c32752f305179332f7a454939376d4236695241637263715237302b33712f645250547a517376764c43644d645a644b723245694b71755352456c69746d4a614667493062313136395966566a744f5542706466356745664d3850486d37526e3179326274333677334c6d754e513139557570397859526257434d6455334834722f523955736b6b6c6d43556e30696a6a444b4e3031774f385a4b4232763354456b54496d6d41644873384d6145584e3351497143654945664643555252456f74464139553271706b4854416d7877546f534a7356464d6a6f38686c5577674f546d4a30554d4869356f78424e576a6858443962665044672b6c58474e514159784f413059534a73615342524e72435a4d724577584739714b46444f54303169774274336270316857565a76555330776531754e78454e4531462f5a6a453759376f566264323664585462746d3358435347364d74714763353465384a4f36565936686f61474e524a523348694c614949546f32625a7432776b7676504443646f39446130366a36772b4c725675333370465a4e50634447437863504766714f445a596c7456626255706850586a6868526532623975323751516852413852626368396a6f6a36776c69595a393562325a71627a4e2b5144554b49726d3362746c3278645774396136427961585439456f6c45496777725744516e427a4a4659424d4569346f367655306e69714a4169315457466c31523157416d43505947702b6c6b306f3736684c6b4461595534357163534642374d4241463258564a53747a753956614c2b734b32376b55676b456b6c746f632b744a414234655777636573424e37476271506b41527a6e424b572f362b4c35586f6e366e37414f5871642f594232724e7a7032744b54536e2b543376764869584856642f3766766665566458766d5a3648587059736a5132535263424977612b5253654b785a566763537a364978377268457343434f4a59556b794257674a747a6b6e5551575844434f5a793745446d5849327a495973694453374b776b512b5379534a2b444374677963546d6a6d7749786e4b774a4d765777394a6f5876326f312f37645036717270782f56336458643153334e6448335730724b6e753770712f3771367576653366722f392f533357506b424355624479617364572f3571666641454163476e65384c304f7757577839674869444268494f685074502f7274445758504e584b457130633734716355706e4349674c35544b6d32764b2f6e677953316c662b647a32544c6e74475a6f522f7955596c7357544c4f7875594966497245596f7246347a6565336e43725050575431637565305a6d68482f4a5269532f4a6c727543486d43595172394e6d353470594178515345684953636a6d67435143497464686b627a47794543744e685045373861746163336565467a4d4c7364494546654958415a542b4c426263574e3359585371643035714262426d492b414763544a4130577874483162344d472b527a5834616562316e384d4d61674e4a6d39715956517969327a3236475948664a42336d78642f4443673665784e4c51526e614c486e6152564f6471694f7858737768776b4a43516b4a575851516d775341355a48656d514158597955323265767845357a346b3331396c334e495863574e6c63416d5753482b6d46385873695741473673624f3141515077306334477042524c344e442f776944572b546731725975676b726f78662f32546b4456456a706b64355942426c367671454458443338476837347861766e554e337456513352574c7a3454394d573169425675734a356b5466746867357764592f76302f44412f2f34346d746d64496867694b692f2b4b3133376b386e58466b476841416f4a43516e7058665944514570524d4b68646e6c4b32626a4b6f71556774324d667552342f487a37675466795157517a795a7648774436784c785a4c4c6f394d5534396b4e78346c63566a6b6741355474584f6847464c35517046574b58757457792b414541476244346362487a5a734d476f34416a667168693854744a6368366e456846554937755279325461457a39616334594866764662547165715770556c4e71386f7838746c4d74447a33746d74544e3571542f7949594d5650365837396f41685735534c484f594f716c497367722b7a5730722f6951304a43516b49385951382f63524b4566514177456f39685854774b4c616a36677973496a544f736930637845692b73467948735977382f63624c583437393336396154444537384138755749543038584c4f2f79474a474b41725377384d59574c594d414d434166666475335870793635382f63524c4d6954385a553543496973444b6236346b4f4850366f53526a6858504c73472f726e7a397873744932756c6d6b6152557a4c5a32676e70736345546d5a6e6b726e742b4b4c433838584d6b6c6b3249354e64676d567474484e496f51433371487957635a5977394a555459765576463435353469555a4b627932537979382f4e6c32315461526a654c344937593641534d4e545a46554433456a77746e7a476e4357766737713975597a3557662f79563471596545684953454e414f3939343576676d466e4d362f3532665173414f4274366374545074587938516e6a374f456e796e714974524c2f5a375a734277443839794f486d6a742b514c523866492f34762f48447835754f2f2b4b35637743416f5255726d6a742b514c5238664d4c3476652f635768622f342f76752b4362515a50787a7a6b4c316f64546c4b5a3973342f6a6a572f633535332f6a49782b7256692b4367536b635253566f6b314e433571467a724b7a752b586951694a6747566a484a4c5a62642b525266497171574e303456444c414a6478392f532f5732696749744567486e6a7243784c424f47726f4e6b74564349524b4a67545a537174594b657a336c6d777252493146655a4842484230504d4c2b32414d51676a59746f3262586e756f616e75336e457755684a4e7045335454396e79727459424c33377a516177673056574867506f354e524441744b6e354d475a7831634c5a4e5951596f4a43516b704e6468447a2f785555676151346e4e39684c6b4943534e5655372b6754442b65392b3539614f6332426841537a682b4f73694a6a56574b4877445975752b4a6a7a4a4f593753457a7a384242786d6e4d56663865434959574652786d71494b37767a5442466973756a7955624e6c7838514d415a4657584c6b6e643869312b4149397975686f75593471714970464d515974456f616771464656464e425a484d745748796b55706e504f4f69782f4179544a566f6d7161377a56436a4c48795a7168456a754f6a6836685346595a55544546554656414c355a4c7869454266584b334b6c6a43476a6f7366414a345a486b5834457a3941495a4e575567354841437a624555524c4c39636445684953457449386970694574436163796d684b6737415a6a4b5672625836354d6a394e485a396f47677954414a7347354151595031467a3279626a7631795a6e36614f333054384a724d6e4262454a4d414b414e4d41324f2f2f31356e4a6c66706f382f6a52416b77436d515a69516f6e617a596c73546b3079334a6f696338382b417a55447438332b354d6a2f4e485a2b6d4359587a7a2b51453864726e7635356c4e4f4d4d69436d675844424f622b316736325a5442676e46312b564e4a784e55592b4a637a7a4b614334466b71672f7a63374f5876642b4f716d71656f71676572676971562b35587a7a4a616349612b75494c5a724e554e7a567358727a552f6a5842456b4e4d33714f7a78494163574568495345724c346f50654e66514c45397457623843385a4350765a7730393873757968486f2f2f477a393837424e77477530752b66674a32503848373968614676396a6e78763742434f327235376757554c73333772504f663862482f6b596763484a2b67676635565353514e6b4630774d726f3364756c41564b7939656b5962566c6b633045683467755a4c5075507634574d4d3652534b5a3872583254746f3235325a6d6943497045593530764153737067564d554a7976564b725a747754515765677a64644f5a686341616b59676f55482b66666c6f535a6a466b555164306f67544e4b6d707932306979316c4d6f655136454143676b4a43656c68364c3133664e5a644347354969627955734354424a4c7273642f76616851465147595043475454474553332b794d7539374b474a727742682f482f397a34392f6c677047434b5a68774e4231574b594a32374a38755842647954444749425146697170435656566f30554970454e48656539393535316341345048503366465a31776a447443514d53384b3043585948462f6433433365396738495a564d4768716337354a79623333766e5a69613973664f526a78474c2b7849384c32624b59435770586b44534363515952637a4a645644413161426365556341564a394e783930765849356e7161387234773759737a4d2f4f414768666b445243326a594d7778475a6268616e586346683644716b644d375a7a576365526c2f636e2f68787357785a7a4153314b306761495358424c4245735151677530354c4636736c7744564249534568496a304c7676574f644f2f6d664d53314d6d526179746f53784243622f67465076625241686130744d577859754749573731385432416d483833336a38385857752b4a6d666d634873705576495a374f7754483857784663365241544c4e4a48505a6a45334d3450706978634c7a7a6a78502f37354f396135346d632b5a32473234497131464d5150734c44654957394b7a4f5574544765633838384b3537395a38514f6775433449414669482b796478625547596b423151633951533137686d78512f676d4353345675715731646e72784c4957736d3263693043794c554a5a4f47664e6968384155415248724641715a3076716250776c3136486777617735636c3372474d493151434568495347397a443441794e73534f512b586f3657475259534d5a534f686942463633396739494977427652762f5839735941774f4d66423536766e5537344d574362566e495a544b494a52496a33336a7373587677342f3836426a686c4e6e6f744f2b556c6843304a4f634e4754424d6a6a333175374a342f726a66355a51784d3557434b4b4b372f5a3070726769663734766d6d746f3976574e3753636579736b794569535a425a412f6138586d3254585a697773356a53555077345267657337473841554c562b7050723766593972666d6257393759416b4f7833317a63327438375374707a4d48424842746d7a5047786d754d58537972782f4b664f337a4c7a684454464d51555556524e455261464c77766e4a35756176754e61317172524d3057624e4e7453636a7146755a7a4a73794b39574c753265794c4b3530545150666664657336432f5a6d78746c6d5269784e6a4a70374277715170456b752b4c516b652f7142517a3839467651344f305559662b2f47763276377a5a733445326c70797a546a62484d722b3344664d3549307155424d66765852703034475063354f4563612f694f4a6e4e4149775a414f3675376f59304b564541674941337746473654422b686c773265376d483154554d585563736b5141494f7769555a6d44496462434d36307244734352696d674349373669314459386f344e4846317868597845754d495a495259486b4b3573554d7a49734c6a55365a4967434631383138636345684f74546670354f55436a70465652474a5257486b64526a36776c6f743237616731756b664241423963513139386374723874454b38636843544b6d59696858704743374d356e46686475486d6a69304a45595644455477344158542f5862657573376939673448745947426a45675475567469784264585a4c49777a6741414f67643362526b38516f2f3050484872364b30474e4f796a432b4873376667445974663257547a426965786e594341692b62536f394b62786e6a444e4945505a73337749435452446f6f434c4677537452454954784c384c3443534e67514f57393734753667566e7a386a732b4259484747566137445541426d4f346455534a486e505a792f4d51416871713778504f7a733867764556456b464155447738504676393237354343323266315671717a696d636b597862764a697831464d437a72587a6a2f646d464e42514e3533707a68555257384d4a4730357649774c3252415275484f2b6c7872706765694c3970346f774a4d345a6837356c527278306c466e48316f43745468424a5255464f70514175414d3575767a41476667716742542f596d66375077385a71616d697359427555796d357576717358726b47742f625374764772343631647138336c6b6741634779792b7763484555386d6f55556a634c4b384f7153557347306230586969356a373645787053685856586c2b5a316e4c3255513735776732413630396f6172465544736359624662416c34636e6e7a3752306e4854434758645546566735454d4e414d6f4c6876696734597a672f6b344d6b67695167556a6a2f6252665537646b3265672f41396f4b6837474c4b4a56586b5579716d56336e6243766f6c6663623545683434552f5a6c664e444f477a7366664f7a5a6d625a32486742682f4c306450774463642b634e2f534b716a514d6f336c4737564967376950696a63795a69383262354534524a675059664f487a3057323064494144432b4264762f50532b4f7767417a75726c5032776e35374e566f6d4178633032792f4479736a4a546633657a562b5039363935384257476771366e4c78334c6b6c735162495a586a6c79724b2f58517674613337794251414c545556647a6c374b586d36333430425a4e56682b2f6c304c37542f363751316c6a7a5042495a4b4f694d6939664248577864596d2f4a5834466b436351596c70304d384538394f754443555175325949414b43666e67624975616e4b49674a4d46666a677953316c327a50476f4b694f2b44747a3668526d4c31304b5a42782b425a4272656c423550625a4b3338414156713164437744497a73306a6c3832416942424c4a4b42466f74687971767a6e49364b4b6f6c6a2b355376544f44756443325163666757514c4451746e5a6f50786c31775a5471474e3133746c4e4f645044395858414f5869417045566446614275692b4f322f6f353146314c334d5745715942774e5134706c66466357456b68656c56636551443973685864427472666a364661333532415142323849693648304474686c34644a49792f742b4f7670444357485144773874754763666f74673742712b4f6d33536e544f5150704d46734d6e3570412b6b345671794d304147392b7a6663742b417532586558502f35524b455966784c4c2f366c4e506c766856365066796d4a6e31626f316642646377507a776e78673473663373537373716f5041757069426d5970414855354347596a444c6b7a6f795a4b6542673575316e356d61696f7738654f58536f7671494a693964416e785a424c396734506769696865313461756c7a64484c6543576b4a32356c41314d2f50696c30714936434d354f3535424f616c673145456371706855466b47354b52465742706e2b6c6432322f655a4d51366b484732416341524f6347492f6a317a63767862317658345077622b6a452f4641333878783841704d4978665655432b5a534b5a53666e77526a62664e4e3156303838382b4c70727062436850483364767956374c6c377932304d624438412f504b32565468392f52436b457279356f6855526d422b4b347677622b6e4671387a44794b5258524f524f526e42316c59474e6369486664634e31565235393938645667626833354a49782f6363652f377a65753251634138785672594b594e3032767a52637541566a3678536c5973357537562b502b2f473338485148567054335a2b766a734436784c785a4e4c7a373446582f6755416b44504b7a2f3938626d6d642f3153732f5079374453392f7347366f3748456563787146356c2b2b5747306530415938557639654f314d5778412b4c4b7241436e48784c33594b32504f57554537736c584153414d377831626d335a746b4a786e4e624f6e6a713155436f5a41483370676272503235594630335447466b2b6d4d4874704b72426a6d34614239504177474f6646736c6153456b496f5744662f38374a7430346b494f476434346651306a414450662b586e72354a53385a4f4b4b706a4a426e66393551306271346353454a7a6877717954575a49454b4a77316c7745716c44754e677a6b6c546939745759454c49366e4142757148737876535750503846464a544f6f686f444d4350756e58734d5037656a74384c4968706a594a67626a4f447368753731304475374959327a47394959506a47484e78343568396938755a6c44544f375a4e72717a6d3256685966793948583949534d6a6968535356755a797851675a455667684164546742466c484b54415a6b33674c71544a4b6c5a55503657456646565647307532595241615a77784e5976717a336d516838696b6752624e3445537132537970504e387958486457466a466a536e4b5735433244563569647544614c466336496736745741457445693275735147636d77536d5562745569334d4256644d61576a6462706c6d307534346c456c41314464652b3654667162702b5a6d77566a444b6f574b5674727175647a4d4130444d314e544a593835735653614f6d517a3837416c515a536366396353657a3566667436472b794b494b4c7a4d5a47412b5a3956315475544d57582f574d5036536e6c754a7149436d637178665658746561566f537334552b564b724377457632723573324c4a7377572f4c35645750524b6a4a2b38336e4c66782b6758586664386d34774e6734343965335076506561726b392b58556f6d476d50644f6d595966322f4858777347782b57726d3550665569364d7050444d65363870726a6b42592b4f373772726c336430366668682f6238636645684b79654b4738767979484d68427653767741414664457735493272696b4c346b635476766f4b4d6345646f63535a732f39534161667768746d6d556a4c7a6337363236783863616b7238414943554e67773958376563314454306f76694a78474b655a576d564b4b714b57434942496f4b683579464c3750736a305269536654377475596b776c2f4e332f676353576c50694233423071576e5637784e6b5767733974324b617379366e456172436b596757536a557467697a5a66305156534554396e582b437a30616f39393931367a724f2b5467416e466e666a386e74367a7053357453496c424c445455505859633231363774363344442b336f362f4867524b413843616139666a70757a55446f45414143414153555242564b48726b464c3875353045685255526d4e792b446d66574f3139386e5050782b2b2b36645630336a68334733397678683453454c47496b4f574c474a30546b5a4652386c6b63787761456b496c583967356a4349654961754476685a5176726a337a745679315957544e484246566d6432706835777a594f514f793450416f62627570636b2b5345706d3532596269703767394566523872717068716d315a794f65797341756c7434787a5247502b44584f3053425371357068564748726564376d65706b5767615245493459674557784c6d66596f67774445706d4d325a766e746d455144446373726279754b58424e3255786551645a77746c6d58364971674b52776a6b334c664c6475466756444b70676342335166556b6c6d3874784270612b7443714f46386175386a33496f4c687036447138352b7133347a317233773441654c48765a396950483366742b4748387652322f482f3754577a36414464652f4451447776564d2f776664652b516e2b39654b76756a714746386175516e5465784d435a624e726d63687a41376430366468682f6238636645684b792b4341694d457443366c62447a416b5267624b6d4d36747445684652674872376231416d35666b537a6b427779745a455241577056485376734765396d2f705359614a4d6867315a5750646c476a7079475661573466462b726354633741796f6859624a6c6d6e434d6d757661326c554a7559464c2b6c6a5a4a6f474c4d73455936787578735574392b4e435143456e4f366462456978764e63796353434c4d5a457a343142706c324a4c6739435031666e464c385a646b2f697a6245566d4d31547845325773344741536e78686d67586474763373544178674467686474574e54334964726e2f75762b4976336e375a34715433314c6338704e4f45736266322f4533776d734d37316e3764767a4e327a2b442b362f376a3130666a33754f474e6a5972753033622b723038634c34657a762b6b4a4351785975644d3043324245774a71744d4d6c6d53352b43464a73484d477249774f4b3650447a7258705869624a475563545649365863656155783956706346704a4a424946597779476e6f65686534736d774d6b556c596f66786867304c594a6f4c49356f4c4136746b49317046576e62645157534636584e54594643646b354b3377364f6a44466f69744f684d572f4b597138664c32785a4c583555775242524f534971687972613636686a53344c5a704f6d43376a466549762f366e44485757414178346e7342702f51706147766a527579342b6c5a382f4c7271637661683563574a654d634c37385034657a742b48365342736a45562b6668313738614f71322f74366d44794b6131594375576575773454786f2b656a6a386b4a475378516f43644e7776474158554555496e346b61626c434b6453387748586b4b43646f5269316a312b4a314b32574d6c47564d4d3668465552517653616e70654a4843415752614b7a4d5049454c415656746233366b352f3037332b55796d5a5979555a5577787141575246416d582f76394c78552f676a754e5245737a4d4a777a4b47324b6f46776441565a4a4a6d2b316c496d717849395548675051395158764e77316468372f387a642f336647356f5256637a45574e4147483850782b2b4c576d503679392f38666477306446315878314a7972736136646377772f74364f5079516b5a50466935303149712f4545314e617459756c594a57524a534b4d4e36326a6233336f6b4d6d7a41394a37384578487366484e436a4445474c524946353358576f4253794b6f717151745738685935514643684b3633324d4c4e5030745234706e3876577a566256476c387458424845362b6758563273497a6f704f635a55497a736f63355a724674507974523872714e7649317a6a2b41706f5259777a56416a4c45526f507354344939636536657637665a7333304941514b414a41424e433876477650767055594c316877766a5a434e4337385a64792f31323372724f3533416c677a43304c394d4e487272327a712b74423348506c6e727567434f507637666844516b4b574c6f3073713632636755613333615670413579424b7932614a466b534d6d2b4231316950516f5a644e314d6b44617670556a716749414961434164484a4e58504753697143696b6c705053667a536a464e48526b3536763756726e6f2b527a30584f314d6b61707152594f445a6d434d6f64457071375363396b49527a68716b56724d7a756957426e49566b7a4475476e474658396579715048347a49717a754f37586e3769323367594335776662714731756850396d48663531377165627a4d794e3941414352743541386d305668516a496d4f653362765731306e4a6a632f3843686e7835725a7778682f4c3064763875753754647659735433536b59376e575378772f7a4b4f4f7a43463357397366596e2b344959526c504d445561516d744b78352b3474747833342f70473265695746386664322f4345684962324e4e4b3247347165347257453561334871544a624a6c6d564370577a745467305231456a38324c725a5676505765754d56516d6b6f666c78555659566570357a5150566170554a48534c74705a31784a42656a3558624754716656774e516d6c652f4a534f715261436f36483463564545673248562f3677776c4a73595343493379565a54424f554d47396b363732757a3467667736514958694f55785139486a6e616d4f687a73454c396f58566a7151335076692f36712f7635336c6a61494758356a433441755873474c7964544447646a4b496e6275323337773569456c7747482f76787239722b3832624f4d536b4f2b383974336b5a706a594f5947726a5946506a31566158652f4f37643976496b6f41746e55576d685270594d7532323635754473696b50342b2f742b454e43516b4b614568626b624f2f563034636b7763346231643976686379523042524843466b534d6d63573930476d446469317678546246542b4e7147776957672f474f595151525976727375634b35676d73536b77356d535054304546454d4130646d546c5a3741746b36506d364a676e74697039472b42552f514d4663674e584f416d6d4b56334e55426b6b45797949514842456b637961696862525533724a683168465672596766774b63416168616d4f6431386d536f572f725652472b6948715932446d4e6f3469466647317543716f3264773164477a594d51504172696d6f7766324949782f36635450694238454131346258596e58526c6442547765544453734b766871376379664578582b576247715261464345386664322f4345684953454e2f5955724946734346514a496d6e62394e554b5359427357524552313567733267657a47613049364c583441674a713849385539424a4151416f717131637930634d3668617047694347706b6e653353616645444e48382f6a6e4d47575346594f584f455373333447594f696f436830544974672b756876314b723441526f494944746e5449716f686f457a74644e75376d53585231566e6f75757a4956576e304e4d5276507975455763433347594e6642682f623863504c4b796a65506c6462652b7175654e79427562524f34457378363555357332366b324c336e4e6b3559374b74635954786a77433947333949534567495677587346746257414156375a7350794a31494b39746f69717671797335616d7a2f3232695757614c61327463664572556e6a426c63375138373773724956514f69352b414d4332715332444137386968544d47725343432f49677577644857754f702b77683538374e6b5a414e4d41454a317a664e355a564946495261417353304a623351393157524c4b51427738357238626236644a6e4b31745a39674d5966793948582b6e39396b4b544f48674d52584b514278713452776f79354951715168596f576261505663417067766e734733432b4873372f7043516b4e3646435136752b5377443436795934535a4a785a73317a6542616339654462466e546b53356f6e4c49306637324f6e464b32776e797055504c576a45685a634b5772503539794d6b62646155314341437966416c6953303554555257307951314f30356d377745735a513035484f4c773350436f456d4764685967697577563653756d456d75794674496e433350545053666d415541584858306a504d4159582b3778776e6a372b33345164675068723176476638337644627157423237426777756d5a4c46384a634472676c41457842774d67534a6a44506e4a564437642f2f442b4873372f7043516b424141584655674c566e584449454a4468355277426972766437484a336265724a734a61737479753558783242614572472b47594e74576c66697058752f54474d65567a696d486b7a58362f62526a7564304b74675134703772726757785a4c6e3638312f7330686a45475651436d7657434f55496b53774c4b4b786a6259594e4d41454d74616d417477386e756453434c466c5a702f5838576a7545704569332b6e6d494b4e69754f4b385963662f364b665178773863506a494a3973645a78682f6238642f34504352542b375a766d564579647337316b3663727276742f2f702f2f685141384949316a7a6c612b484a2b7a63376a4e626e67327a386e4c667a4b6e712f35647a737768534f57645937746e727432434f507637666844516b4a4358455245685a337a7a6f527756594272686379504c5a334771473261756451535153537072424672747a414e7664673474524c4c4e47465a7a7071646473535069797543764d726847474e6c6a56693768576b524e4d58624d633679435862686e4443676b4d5670586151555256434e636a6a65445145456f6f4e67624d63312f335143414a443357415373564e794e722f78377a65726c2b472f2f352f7662486d7a56304a7a654c36564d4f492f4c6730465a4949667839336a38414134634f764b65586474763373544164785165476974397672496e6a437655697252776f2b622f2b6e2b2f69394f766e692f2b6e566b5a683157535a616a383279553672634d39567941363250795271776e6a372b333451304a435167426e62614b49716d5869686e4547377271336f53422b6d6d7847576739624e7946694665594250746248644149696771486e7938534e744731596c6c6e4d314c676c624f314d2f6c3163496156584e44344e59742b74596c6f457455514553556d7735454b6d4a676a78342b4b557736484b566a756f3842734b6f414f486a3335727a2f597434307265787671442f39375351573463326444533678727874554e48622b2f496a6b7349342b2f742b46304b67736f56565a38726663357478686f6b4e3670707a4a31593643336a6c766331773448445237385631486a432b4873372f7043516b4b5650396b586e706f766f5736692b714d792b4d4d456859707054437364596d634f72552f59576e5068786467724976434f432f464936666d4168726b624d7a7a6a66733674484673786a533876614145634536586f656e484d5155566c324a6b6a785539776e35394330434178444c7874445055724844797a4531596758546a744641367347597358484b73766143493467596379375043306f38654e53576735584e6f67366c493466574969726b713473364c6a6a397074715076664b365850346b30392f47582f3438532f69794e486e757a476372685047333976786c334c6b365050347734392f45582f7936532f6a6c64506e616d3558377a31627a4954783933623849534568697775764e54694d4d61643561616e3449584979517832414a4d48574638714b6d6542416c35496730714f66442b43594856514b4556574c64435137773457416f69365545684152714d62616f4b43524e556f4e7579462b584467764e3149674e4261426667687335653661316376782f7666666951337231785966652f48344b52772f66677044512f3031582f6664687835484c75636f32384f502f68686252713850616b68644a59792f742b5033792b464866777741794f563066506568782f484a54337a51633775686f583573752b75337348373932717233394c76666661797350476f7845636266322f474868495173496a67443932686f366f5530374c6f47436531436c67315365464751635530704e70547546464a4b7a34616d5869697132744335725230555259573037574b706e576d6130434c42394b57726861546144553072455a773131544331575254684e4574316459396c4531536c76654d4663725a69735168323366652b73683971414e69776669323233665662645639372f506970347639505454586e324c706e322b6739546232675134547839323738752b36363564334e6246386159326e73586d79373637633833394e64393730507356686e762f6a3845736266322f4748684951735859546d3778343553514a5a6e62656b746b756333376a53326236446a765731336e68444f426d78627269794b657043476143554e6d77666a554a626859686757663446725349366e3549725059596b464530585769575154382b752b3935583979352f78324273664d2f324c642f722f6f484c436550767a666a33624e76795a633535317865614477333159396439372b763259617349342b2f742b454e4351705975504b4c34616b594b414f517a533949326b69424c684a614971454141626d42656d4962687538794b382b3434736e484f7934356c6d6b5a4e6d2b78327357782f7a556742707946704e2b434d6c5a3175797962494e6b726841686c323556334b5a6e6a72573963582f332f4e3675572b5872503345782f45687a2b3044594f442f514377592f663230632b3250494141434f50767666683362782f394c426a32786d49526a4e357950586239775874397661343078744c596d365764397a7749777668374f2f36516b4a436c43396355634b574a5358303354646b713776714c61504169794f6d2f34312f5564644f5672624c4d7a74447a6759736730354a4e566a4e324c2f374b393971305768644276675151455a316f6165382b2b4d6a4352426276662f2b64766c367a5966316162426d39667147386972437a51384e7a64682f4766364a542b31344d385874534f4f626f4c64666a49782f65686b32622f446e647554454f4476626a49782f6131726e68646643634f516349347764364f5036516b4a416c436465453733552f4c6e347a525a32414d6365614f36673575476b5976746639754e683264357579566d4c6f2b63424d455379375766465432796968573567577457534b344b2f416b2b4545674a4661547838352b6e7a4c6939666a3853672b2f786437796836376548454778353537455338655031566349482f3639446d734c3677707558724e436744416c7448723862642f6478694d735a706a433451772f68506f356667396349395a3674623179756c7a4f507a6f6a3348382b436d734b597778466f7467772f71313250545744526761367365473957754c445450626f61466a6e6e504f4f6b595950787342656a662b6b4a4351705164544f4c6a617644635745397a4a776e526a497579526257474d4f55316132375467746932724a544644524a42536474514559654659336b4c48714e4f6b31532b324a4e67743643694359356a5153524f4534724671434a334b2f6b522b384c6e434453667171657676507651594e717866473867366b4750485873514458332f5938376e6e6e6a754f30366650342f4e2f7351665a62423550546a7a543976463845636266322f485834656a547a78656475683534384f48694976665342653750505863633333336f63657a36672f66367a685455342b4c4647587a336f63667162735041764933764179614d66326e47663030793373374c467a323948762f7779705758657769586c5657447658662b6e63785037536b6853584b386a7974362f37674954516d2b4231416c4444577a5532352f496c733357784a695475616e747668687a4c463472757a3934324b5a42725249314f4f5677554631584f6e634a713271466d6c4a69466d326243682b474b76646739617943567162726d794e6f4471756447352f496c5742627948575441616f696c6773676b317633594452306573446d66792b655078557a636d76793954554450377734312b73664c697a433548442b4539345064777a3858747a454d414f31396259447739382f5748732f6351483231362f345336435033723065527837377356696c717755416b3232645a444768504833647677684953464c424b347064637665374c774a4b703064637759754f4a6771696e66636d6542676975696f4735794931486461593977706837507a7a596b67303944726c7232706d6759684671624c556b704932345a6c6d575750575a625a55546334307a547150752b4b4943305362556f454e56727a6f346a79506a795343464b57753741524f534b6f6b3235775a6331516132335468416a794a59415959784d67703237644c5857713745385242482f7a74346562327035414534777733756d4f353248387652322f4677634f48586e506e6d326a397844445467593235766431662f4f336836744b2f6c7068512f4839333162737477513470564654557a4d6732646b4a6342682f6238636645684b794e4f43522b6f5948746c3468666744486a55336167475644524e5353336a7a43615244616756493472766c7a7058505842456e647168353342593756745648583845425679385550344c71786351676879707a594c4e4d455a787863424f384b3578677a2b4b7450637a4a4231654f756849674b546d713174366b55503044426a553034376d2b6d76644362783559457a707a477055466a57724a6d39716c365734496955445875536e774a4944746e5449716f686e677330724376537a766b63766c6d587a4c526a636c764748397678312b4c41346550666d76333974455241474e2b58394e436a41335a55434a476a78313745564d41794c416d416a3951425748385379662b495657424c676b5a322b36716f564d33595141535169446938615059362f4833447737433048586b73396c414f7178666954444745493348505a7448397363564742596862797a7438782f5652466d5a6b6f6971645557464e43795156576653545535327943326659347942523154597566715a696d6268716f637867324267716c67775037414a5a44684378685642746d375748622b68352b742b336856466856427154354d5a3539416930624c794f644d306f50483231754a5559706c6d56595a4b556457794e542b575a554c503534733161715a68414370716a702b4948504f414f736356764672386c4d4959673661777376493530795a6f4c46686e50432b5270696f4d555555556c34535a64766e3161785779526258475430542b424e43446a7a30377333766236496e5472353466656648347159355a7350376e502f30596a6a3739664e6b647a586f51714374312f6d48387652312f6f7a4577482f597a62735a73394a62577a434c38384f4c78557a6a39366e6d414d506e675938383231315732526137492b4948704d50376d346c6335683871425a44505774307549586f39665556556f716f70344d6e6d3568334a5a55415348496f4234704c664f66365834305659377065787a7a35542f2f6f712b2b6d7462704747444a434169536e6b5a5768426a564152345a554e5768594e4652506b6b57774151444a537a6f4a38702f2f704c33656a4d576249766e676451454653615569562b334c5676767a7032724f7a78315350583142326a716d6c67466f4e6c6d73554771716f5743555145574a5a5a566d726e48432b435744774f566c4c6d70716771464556465a6e344f46382b654c64762b756b32624141447a4d374d67497469574263737971385450706d734741514250506e2b6d375046564137473659315145423044466b6a6a544a7167694742466b3256545638445369634d536a6f717a4d545655415654444d355378636e43387643372f392b6c55416742644f547a7678463872332f4e74394d4977443250664167772f68502f2f70787a72532b484a6f71422f6237766f74654a6e44766d726e38616e7a6b306a39332f38434a572b44694d5a4a743859444830517477766a483063767831344479316a6846314d324d735a315756474475543334622f3250355a7177576e56304d57636e46697a4e34344d47486e4445783674716171437378666844477533586370524c2f4c2b666d6b565a564c49746f554c725930364b625745523458546377625a7034553670386f742f72385a392f395656453433456b2b2f6f365572357a4a534274472f4f7a7338686e7331692b656e585a63394d5a4535724345645634563579734c67655343486c447772416b306f6e6731366d515a634d4746557669754b5a414775335a517a4f465130537178512b50656b39646d65424172506130566b6c555a2f2b43516c46554d4c4269535a786c476c4331396f356e5778597373317238314c70526f6167716b716d2b4b67486b6b73396c32787050505254427746696867577068505a446170696d43343070584c5836534e633678716e4430785a5571416553696d2b585a514e3843364775486a6e3575393762526e626d6350764c73463864785938486d745a52394833676a4d6a552b6d4144776e714e6e6365734c35546674767a6536416b3974484768342f46395a383568544c567739756770724a303644675858744c693951502f34443731714c58362b733778717a2b35394f3451316e717a39385379482b5368703944684a35433538362b444b536558765278462b4c42783937646d62507469335441504461364371386f756278753950503444716c385a335557312b34685063635056663232464d62302f6a6561473048706b546577723776764654312b4c4f6e7a794758303046454a3735322b4f6a6e6d6f326a56594b4f4877442b66575563583374582f537a6a7457657a32504e50433363704b2b4c2f5a4c4e7874456f5138633948426637486a6d7361586a4e653539326c3366687a746b544f316e456d372f334430516d6d644f65486662444277755a7563446e692f38352f2b43414134414d2f2b4862586a6c6b4c307a42674767626d70727558564d2f6e63674341614b7a2b33655675594574437a7243524d7a7133674c2b5332617a7a5765754c64323553336d33496b704463416c635854425661465546756c71614d4f754c4835584c324a424b4b416749746c4b77564d6b477459466c6d552b4b6e644179584338465a4d634d6979566d336f7971746e5939616d5a39613473644661654c382b33366e3772767a686e3747324567457746747a426f7a6a723551392f38504e512f69526d4166715a44312f2b57594644332b2f2f485733766649612f6e58484e546a695978494d414b2b4e7273546169644d6730413441585a766f33482f587265736b6f3672346a34326b384939444b3445473768782f39516267532f2f795374586a697a332b5372772b427a65716164796f70674555684577552b4b73334d487a713443754c4a7635364547674841384e7242654579527861654d6574504a4c613863416d334858775a52723738422f652f766d38596d58717646634368524262766e4c78593976426241667741674d37597948313333744466545845595a50774138466476767737504e586a394d3050416a6559304e703259413141652f2f3133336272757134382b64624b6c5946716733666a2f7169422b556b77704371646e7a4f6e7966645134377936584d2f36516b4a415146326e59594a773757614157525a436e4b35334b7753757a5156636769714a4332684a5332724274473979796d68496c5241544c72473747366b663858416b6f676b4f5359316a51696769715a63775156546b534463527630325031757948546c4445413246696a337632356b6236797630742f7a48396c7a574f4f4c475369437037616d43374c4169587a4e765a3935795638623352467737752b41474248466379766a434e354e74765648336f4c396d594f5868582f39305a585948643842487669493858485a71574676382b6678742f6e546d4f4f6e41752f3876317857657a78562f4c506d34654c2f353969436a3664654350654861334f614c78362b30626b6e2f38756b76392b5a6c484558777458474d36766a4d5032655848752f7164546e706d50707a616d69316d4130757348574c6947414f657a354455523367694759794433576e326b2b5769614a386a34585571766c525254384875784e666939364272303859583948386965775064474c785546454c4151767756374d344375664337616a542f2f686c5834354f33625055766d48736d66785a63794c7858502b7a3976487134706749444c45333949534568494a644b774947496141445174676a794e475a544649583563684242465a7a6e544e4a77463932726a5444644a436350517139596d4c52627834364a7756725373626b594531544a6d69436a4269782b6747514845325759417146583464433774664e68763134627865374531754b6c7778392f6c436630437670523543636447556c566c634144776e71506e734f5746532f69377364566c6b3267765a6b66366b44796237656f50765666384c426242373939796531577366567a426e766749626c5454754866476e787674596f792f45582b52334967374974367872425a52474e742f42314e662b51634156333738745843463457774e6756764b4f79597634454d5472324c6c74486532384e6849436c66784b443664654b506e2b375a333975643430726851764e59716363394e345678315251414647623858582b35375339583142514237346950343131765359492b384169723077566d4d38562b312f58656731566776394f376f53715359676b2f4f2f647a58574335482f43456849534656564b796a4b6f6f6730304974327a456d484a46547458426573495a6c62316363465346596c676b693661775471744766783759737a7a342f693947637050495575794c495753666b665250646c6c52306269744645617868325675724e4c50584d51425957536344554a6b4a4b65574f7944437555354c34777171706d7139664f573367557764667871634f766f796e4e715a786243534658362b4d56325650724d4c46304d306665675a48414a5447502f694a44324346782b544d355359316a55386e336f67765a563743745237726679705a62504637736149777562754b5232754b48786574776b3375536f362f467134777444792b6f4e3936596862586e73316930346b3554394666796575722b76434e2f746f4c365066337651554873696677464a377a664e34354e775134313270583167454647622f4c7457657a2b50584b4f443664654b4f6e2b484735535533442f4d51486350474c6a684f3647372f375765304737635a6665513155636b646b4746646c6f6e684e356f765856693075522f7768495345686c586974772b477141464e3456583865706e42775264526375384d575565624852586f305662567470795375736a3950305a484e793436624d6354696955344f74534e496a385a436b6744446f7a2b504c516d32375733487a51416b4f79682b4139767a445761737076687857533269324230664166434c68767537395958706d704f474830486952383050735330496c43363175343364386d616f61355933664e3248596d767739376e54654f646b5931766e557137302b4776786a736b4c2b4f664e773768647179392b476e476c78642b4944302b386974736d7a6a54657341363734794d4e33635032784565676d3866624f6b346e43434a2b6c33644f5873442f766d7344506852623033426264633179784735354d334a504c33796e454b693261756f5151635a66796533614d50342b667872766d4c7a67612f764c4558394953456749414943686576324f2b3153685034397646413757676161616e59536b7246712f5534707047444468727770435662564635387049564c2b78716d56375a33713830425465734a6c704f77526d6c2f462f5850517579366c6b6f7739337043754e2b2b36386f5a38524b35745569435a736f4e656269752f4a53314d51647537655076725a344864636a6c663874646830596735765054474c46472b737266504832707a4964796e2b62754833327642377258576133647448507776437a7144332b34374a433168762b72383355336b744d6d4c702b2b36384958696639677143694e2f504e5a44694374353659725a7376564d397568562f53456849534355695146664878535a2b414869577362554b7634794f647131692b6851336675416450762b427662734477304f2b74724d767a675a31794b3677612f764e6d3052554f7747477a51437162506e38384f474a31354430634c747146643069364261424d54624377506274336a62367a634232586b457238582f324f793935576e35586b703134747155786454502b62754c3332764237725857533364744776386e41396a484752747a7a4552544a7649305054377a57394f754b6e3032477a534b716e6469312f655a4e675132716771446939334d4e764f467346702b74593448743073333451304a43516970686455725a577147305647347859466b6d704178757a4a55323246633662672b676f444137665036622f71546d617a777568686f76416761417a4a50504e48764979386239643932366a6b4e4d414569667a6468343971794238345a7a6475324c2f6c79475a54615074632b6343485263463349537831343363584c476769554a6a4c47646537614e3368506f5156412f2f6e6f6b387a5a47662b6264694d736c662b783454537674526e51722f6d376a3939727765363131696a3362527539686a4f32304a4f486b6a49566a723575346b417632693272744d7963677337572b626370787238577353586a327249477a47527341306878696f684f5a6b43446a4e34362f306a414c4e50717a733735756f4654477a34676644444e42495345685859477a3671616c37574c546f684642547650545941574c567950554b78564a315831376649494469414141456f5a4a524546553273577943616256756650667a4b6431417344594f5a436e46624a352b6e7a44426231662b756f6a2b4c666e54674d41726c455933703151734b4b464a6b6b6e4373756c534a492f6937555773626e6379634453723264746e4a70314a6941766d784a626f674b35703338425a6331794a47362f736562725a546150755965654b4c70554263587a68764f424f4a655673416d344e713241674830417668586b6365724633346a63307a394838713562776550656131726d486e7169355846314b33342f6b4b524a78686c4f674842624336382f5a306b386b724877736b5841786450346a61382b676b2f662f2b3636727a46506e2f64382f475468756d44454f6e7064454c4350415467316178636e2f753435436577594f52317a447a32423150767571506b5a41687a52364b372f6564357750672b6e5a6d30494269794c697a5350716e73527343464530504850506651456f707657657a346e73336e6b6e76626e417563522f30696a2b48383237575164543252797a5138384947617439727246743450626a44545a312f3262436d374236364866336433315933396e316a6e76772b63634f2f617031312f762b6868637376507a5854396d4e4f34304c752b626338715654707a33563137614361626d6779755a386b3871324e33784a7466324e41486c4c434375426c634f78774277426752597169576c684b4837753248584c4a6e354f6154362b674e6243385134422b6538376a716c5a7045462b2b704f4d4a657a304a39514131734c784a6c54576d66623546384175524f394632704d39484a4866313558444f7a376e342f67304d537834742f504738442f7a7472347930454e313065614f374875306e6775654b6662566f3842774b58387775546d534e37474231504f68543733304a504950766b736c44584c717777526a4f4f7677447839506e44784177446e53693763437a6d4a61394d415932776b38414d31694c38656c4e4d782f66574453502f426a72494a7243734b37616e575379473747483944754f44546f4958505a444d38723976345431506c503337505033454d47516e732b3650614969683331487443664c626f6f394c5a47775075653132613954675834492b4a532b37705879442f3345745131797948747637717375664d302b64686e543566396a6e3674626b776e6b7435695756784158544145532f6f2b4f327057637a3837614e56596b396d38356a2b2b6b486633794864696a386b4a47534a6f4841676744767351764f777277345179707041516d333747457754594e724366465056496a434e78742b765246547a3245546b617838745134533532526e3039616472576d6a374a52715049784b4e46662b4f6e4f6651665a7a2f527646624852492f674f4e724f354d786b553671344732652f33684549465a792f76304c494d4f61514653625067656b7a344b71374a437456312f487051652f682f34502f5966696a2f687235366478364d6c6a2b5036546b7a6a7a756e664a324f637647666a4c6f51697556663264324b4f514b4f78702b7344336a335455444979425663317258375949767a5a6c63627a3231437a7371566e6f7a7a577530512b43527a49577a70644d74754a4b353735302f4d5266442b50344b356a36796e655175503147694b452b6d4b6650492f766b7332324a6e32374737346344337a2f796f7a336274307a50414f6d6a6b426a31575658366131506938356538372f77646d6a69475a333978416e666676686e6262392b45713559372f684d796d38664d332f30413171765664327650676e436938502b632b45514c6f54524e58474849467237347a747545527a49573370304974675343636a714d343638304c4a663874536b39737a42656e2b476743444c2b334e4f2f6748483846635276767748716d7557774c383469382b517a6e7566616931626a6631753639637a486c4f36555a677747754f693557386433343337626b554d41466a4a687a65426d6a7a3777673238332f646f67614f66346276782f7666765041414435624f4d316d35586b633037574d42714c4e6469794d37527a2f4b4556547465736f5a52724b4e4e38526d5132363078362b2b4b52706c3862424f3063333432625278575159594f4d2b746b4145644e6736796138374c3038473564574945304c56447252356878634530304a477371615469616f6a556c77716667426748677943543076476e372b445430505659754156776751496f4b683537307472457351516f456f7965424961724a6372694343556e333962596d6755764544414d6d59416d4859794f72317a3739684556514656514b6b56755053536751766636326b3574625475794b6f50394765434970566e482f6676395950507662737a4f37746f2f735a324c352f674d5476676c654a495032356c33442b4d2f3854415043375a3350492b4967765138433335307a382b57446a692f676f4a48376b6c722b4239767364653873514851526a4f31596d424f594d713567782f66776c4133382b6f506b5762554878534d6243742b664b4c3572567163494a4a51542f66675151762f587136356a35757838454d707975782b38544175316e595075637a36592f456654744f6250753958486d39526b382b49382f776f502f2b434d6b475041504b32762f794a3846345238676f54746a3266665652343930746a6b73595438593971354f4352792f7446432b354a36626f455651493177783662366667674572452b376e676734476673414f785739507a574c756f536562666c33583477384a43566b794d453041444b41366b324447475a5359426d6e6149436d644753786a336f314c5379416932466d5047333353686d335a767354547773344b35584378594c4e4e6b57674d6a44486b4d706e6168793449485345454f48654547344667476f334c463933396c3849686f43677144443376327a5342704d543833437953716236324d30476c78445142786f424d6733576d706b58676a4d41354b33596339474e6e72536e567a5538356e41616e686956396d795a49416d617a467672695374755a494a656d66716d2f64756a6f35335a764878326241527437454c56503271777566596b666c364f3678462b6769587045777154557a593550654138635076717450647532374f324c384d3358707058695a4f653854666a6a433934707a355447384b616839753649766a706e3439583578752f486349786a49467249524f6e477672594f366b4776782b38586d54663369346932513266592f454d5166756a6a732f785433582f5a51596141542b6b6d2b694c31762f51494e504731513063375875356b3638592b45645832446b5135686d4f3857417157496544727379612b506c74395a327431556979493152623535555554637a354d4f4b354e4b383537525a67386350686f344f7643656a332b6b4a435170515772374e736a7974664957426b6453694a53364f2f6a3733754d62416b37587a2f4c5965644e634532703254656f436b6c4f4a6969713142524f3573554b49534d615435613153506b36553835356d54444a5a544b494a524c465a715a2b5949784269305472696a5574456f5670364c37334b57306263374d7a53435254454972333948337166506b61346371736c52665269766466345178575359596d6231694961676f6b41644a6e755463446f4871496e31493068634f305a4e322b516158596b6a43544d5a474b4b5642716e50384c732b567a5536584f3271476d6231562b37644452322f6473322f4a6c4d4f774545456a4450522b6654774141455a3067527673664f507a3056344934726839733352675455653345514a536e337a7a736949414732654b75734c5a506c4e376c33666e6759382f36733656726b6c365033772b46592f2f6d7275323366494952322b746e50564c463730753754494d772f725844527a385a32423772384f426a7a383773325461364534794e5835745745466358544449754a3449356b2f2b434b4a36577a4e375a69655030657677684953464c4436594b6b4757444b514a4b4b67707265734559785a374e77353574665a472f364b74745a434d4e437941433178616d6f2f715a3448374f6c5a527a624c4c71663064726b53696b6c4f43634935354d596e35326f5377324f7a2f666c6c6e4836704672616a366e616845773034526c4c596a466977567a6b6c706367502b6d322f476b593766534b4e4d555651563030305a454655676e745449686b5456735a4e75592b4b3061714633426f696f636c6c3375494463315833394e315955352f327575306b6d6e33464d33713866665571334767634e4850676d6761724a312f31323372704f63547652464f46496138335733456e416d7330447844766274725979705578516d4f3376423250364579744e76475662783670794e63316e7644354f374a6f5749787239322b4f68486d7a6d572b2f37463154714b575144724278516b314d496b52387164447a7a363943504e484b635a656a332b5a6e6a67304e4e66415642586e4f2f6550766f6b4178746232796677386f792f4c355355786f725a4879375a794663666661717a4a57342b4f484434364c64323358584c4e4f64386647564370464d617179754f692b65556147657a57596e643230612f79526a62475664716636634d787a6a5739676e33627338306950592b6350696e787a7733446f42656a7a386b4a475470515461424b5941796c436754514a31476d6a61494b4e416d7169374b55414b414531736a334c553866594f445a514b6f3079697173375970794361714c6e324467774451634a3053414269574934425770754e566d5a524f6f676747787679563144584c7972546a2b476834434f4241463746383964476e547270724d64343070474c3967494c684750664d384767435742486e65504f7734726f565155692b4d386a78424d57427730652f4a5747506754437063495a312f516f324c564d78484f4d6f58564f566a7242696d51766a624c7a5a3478516d74744d445559375679664b553548434d592b4f676773334c4e535255446949364957475064575079332b767842346e374756385746336a7a734949566365646d67655a52675441633431672f6f42524c436f6c6f2f456f51507934505050723049784c3247424764534b67636d35647232446a6f58504f6c7245364b596c61696c5a497339374f304f6957516a6978386d576a43655938324c564e78625670784a762b4553516c377242756c5837306566306849794e4a43466b7257314946345554683043374b6b59375151494d7051417571414d774757656d4f3766637430746b6e3139364e7659434451735452434b41705556577538595250304451776731652b3067704d2b2b696e4e5a6877427471772f6970587037707162434d36672b43304838386e4b64417a4c2b70304d344879752b724d562b47726c4134655066484c333974467042725a76494c717752714d65524853436362627a5370726356664c416f5a3865412f436254734e4e746a6569734d3358706d75386659544a41346461633669545575376b6e4239636e6171355a6d43616941354b33647a627a624b76586f382f4b4c373636464d6e393979395a59776b6a53645550704c6f393363506f70574d576a6434344e42506a39313335773262655554647a786a623052666836623449783755657862465379703274484f504139342f38614d2b324c5a4d4b5a357333444e6134513069594247682f7479662b5379562b6a544d594154657875314c5250477243657a312b6f5369774c324d2f706d376974586243586454644339536259704a6877353758495a4952784b345a67684658595a7962612b67534678526b5364686f58775178545542626b594b32776e45376c4c70545a7463495138394432685a69695152577256324c5343794753362b2f3372566d704c58573954534c6f716f59574c594d67387557415944764e55595a336362724d336b7336342f695456656e6b5979704f483068673778482b56676e434b72585431515657444f63774e58446a6f696679786d65726e4d643952446573323330486d4c5979634447616d787945455148462b5064796a313362376d4e4a4f316b6a4f30736558676168504643695744722b393432656738422b347072535169547847696151416370623431664352502f586f382f43505a73473730486a4f3067554e72724769485142434f4d4c35627234373437622b686e5557556e41397642694b584273426b6f334f41413972556268396661517949615a35794e64396f5333772b4c4d583536332b3150416d7a73337a4e5a7a4a6a4e54594158717731327636726744596b34414a7077486d6b742f7356716731306176324f447a6359756e6a765874425832597258426a73626a425274736d686a3579526641774d5a6d633262546a52775871773232716a443078565151614f4b50662f75364d6664784d75326945357849787941537757556a736939364e2b2b7552587a44387359622b55546d7a5a725a6e772b6533464c3866305050463533676b76333967583675353265614b36644c396766586d4e6b797a5a726162387570685a2b6b76476b586e654375486b35674d425863352f7146303831316f3969344a68423741514441584e62416a4a635449546f7367454a43516b4a43726c7a6f76586438475178373579774c782b65626d7741765667473050686c48536c455772504e626a482b78437144532b4c2b7835382f41674c31364c6f634c5a383832745a2f464b6f4347563635454a425944416675762f636b5841474276366554504c34745641435769776e583932763948763731684c314175666c79594a694353456642592b39663335524241647461417a4267677777614c6947716e4f7977496f464c7834364a715771467861473044423739634467466b364470796d51784d773041736b61687975674d57424a4458357a3852566243734c34722b41495477355242414d316b4472382f6b6b636c62705a2f354d72726273434d6b4a43516b35457069503443394b5558426f4b5a697975684f7163666c596c42546e636d2f6739744b6f57666a5a787941784e35494c495a344d746d5730395669494a354d496c49515449786a502b4f554a736e32526c554279794c6f6c762f3242497552694d4b4c4530484761527a4158716c6267466b644e786b32724b6e474e7758736e41454b75487a5546557769726a58732b5750725a6e6d545652664f6e4435446a446e696a716f626f514b4f78625768567a76636d595942307a4177313243736d685942467a357476483079507a4d4c496f4b65623278456f6171615a2b6d636c4c4c59707969587959434971687168416b416d627948766366347a6551755a664f5076413155773849424b313178654f443374394637796b5a565642504d736e5a4f464a713241302b4f49714c6f52616e6337655961456849534558444777683538344363492b4142694a783741754876566349374c5930546a44756e6755492f4843424943776a7a3338784d6c656a2f2f65725674504d6a6a78447978626876547763474472454b346b684b49675054794d67634b61434162737533667231704e332f4a636e6a364567684a4d784254464e59416d65666e446d54503653735158786538642f65664b597a48754c48373949307770632f4a5474763435784152453534717557614a57463577754c2f386d774966506c2b38764f7a33754b483738496f515175666c7759593143312b746b585459765576463435353469553943484b5a374e564e7a6a6d6339376978792b434933447834384a5959314d45745962344151444f6d4e4f4574664233567263786e79732f2f3076775567384a43516b4a61515a36377833664c4b777638733350707032796a72656c6736745837387278436550733453664b444556616966387a57375944415037376b55504e48543867576a362b522f7a662b4f486a546366763969707831744e306e3561505478692f39353162792b4a2f664e3864337753616a482f4f7562732b6c4172577561734c78782f66757338352f7873662b5669316568454d544f456f4b6b4762514b627436524a685a66574f753065496d415a574d636b6c4971664a716b2f784a614a716565505551694f2b75342b2f70587062525945576959427a523968596c676c4431304565665851696b5369596a30616a3761446e63353457316c6f6b3671764a4b52484230504d4c2b32414d51676a59746f3262586e756f616e74464d455255446c455154715a4e3045336238363357476a513644514b39686b4254465162753439685579415335773263416847437762516f7a5143456849534739446e76346959394330686941673564374c42336b4943534e5655372b6754442b65392b3539614f6332426841537a682b4f73694a6a56574b4877445975752b4a6a7a4a4f593753457a7a384242786d6e4d566638654349595746514255775759344d342f5459423572414d69573362464f732b7267616e554c642f694277447376466b75496d72306d31465546596c6b436c6f6b436b56566f6167716f7245346b716b2b6f474b797a546e76755067426e4378544a61716d2b52492f674a4e4a4b56762f512b5134506e71494b6c5668534d5555524655425665465146593534524b4176726c5a6c5378684478385550344f304d7077682f3467636f5a4e4b556857304a547238684176442f417a6955425578717956666e4141414141456c46546b5375516d4343222c22706172656e74223a7b22506b67223a22222c224e616d65223a22227d7d7d2c2268657265223a7b22446972223a222f726f6f742f6d6f64756c65222c22496d706f727450617468223a22222c224e616d65223a226d6f64756c65222c22446f63223a22222c22546172676574223a22222c22526f6f74223a22222c224d61746368223a6e756c6c2c225374616c65223a66616c73652c225374616c65526561736f6e223a22222c22476f46696c6573223a6e756c6c2c22496d706f727473223a6e756c6c2c2244657073223a6e756c6c2c2254657374476f46696c6573223a6e756c6c2c2254657374496d706f727473223a6e756c6c2c224d6f64756c65223a7b2250617468223a226769746875622e636f6d2f637369787465656e2f736f6b6f62616e222c224d61696e223a747275652c22446972223a222f726f6f742f6d6f64756c65222c22476f4d6f64223a222f726f6f742f6d6f64756c652f676f2e6d6f64222c22476f56657273696f6e223a22312e3135227d7d7d000000ffff0300ad4d8cc480f50100`)))
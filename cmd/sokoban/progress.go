@@ -0,0 +1,115 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package main
+
+import (
+	"log"
+	"time"
+
+	"github.com/csixteen/sokoban/pkg/game"
+	"github.com/csixteen/sokoban/pkg/progress"
+)
+
+var (
+	saved       *progress.Progress
+	savedLevels *progress.Collection
+)
+
+// loadProgress reads the progress saved on previous runs on the given
+// levels. If it can't be read, the game starts from scratch and
+// progress won't be saved.
+func loadProgress(name string) {
+	path, err := progress.DefaultPath()
+	if err != nil {
+		log.Printf("Progress won't be saved: %v", err)
+		return
+	}
+
+	p, err := progress.Load(path)
+	if err != nil {
+		log.Printf("Progress won't be saved: %v", err)
+		return
+	}
+
+	saved = p
+	savedLevels = saved.Collection(name)
+}
+
+// resumeLevel returns the level that was being played when the game
+// was last quit.
+func resumeLevel() int {
+	if savedLevels == nil || savedLevels.Current >= len(allLevels) {
+		return 0
+	}
+
+	return savedLevels.Current
+}
+
+// resumeMoves replays on the board the moves that had been made on
+// the level being resumed, and returns the time spent on it so far.
+func resumeMoves(board *game.Board) time.Duration {
+	if savedLevels == nil || savedLevels.InProgress == "" {
+		return 0
+	}
+
+	if _, err := game.Replay(board, savedLevels.InProgress); err != nil {
+		log.Printf("Can't resume level %d: %v", currentLevel+1, err)
+		board.Reset()
+		return 0
+	}
+
+	return savedLevels.Elapsed
+}
+
+// recordVictory saves the results of a solved level, and moves the
+// progress on to the next one.
+func recordVictory(level int, board *game.Board, elapsed time.Duration) {
+	if savedLevels == nil {
+		return
+	}
+
+	savedLevels.Record(level, board.Moves(), board.Pushes(), elapsed)
+	savedLevels.Current = level + 1
+	savedLevels.InProgress = ""
+	savedLevels.Elapsed = 0
+	saveProgress()
+}
+
+// recordQuit saves the state of the level being played, so that it
+// can be resumed later.
+func recordQuit(level int, board *game.Board, elapsed time.Duration) {
+	if savedLevels == nil {
+		return
+	}
+
+	savedLevels.Current = level
+	savedLevels.InProgress = board.LURD()
+	savedLevels.Elapsed = elapsed
+	saveProgress()
+}
+
+func saveProgress() {
+	if err := saved.Save(); err != nil {
+		log.Printf("Can't save progress: %v", err)
+	}
+}
@@ -23,54 +23,33 @@
 package main
 
 import (
-	"bufio"
-	"strings"
+	"log"
+	"path/filepath"
 
-	"github.com/markbates/pkger"
+	"github.com/csixteen/sokoban/pkg/levels"
 )
 
-func readLinesFromFile(path string) ([]string, error) {
-	file, err := pkger.Open(path)
-	if err != nil {
-		return []string{}, err
-	}
-	defer file.Close()
-
-	var res []string
-	scanner := bufio.NewScanner(file)
-
-	for scanner.Scan() {
-		res = append(res, scanner.Text())
-	}
-
-	if err := scanner.Err(); err != nil {
-		return []string{}, err
-	}
-
-	return res, nil
-}
-
-func loadLevels(path string) [][]string {
-	data, _ := readLinesFromFile(path)
-
-	var allLevels = make([][]string, 0)
-
-	var levelData = make([]string, 0)
-	for _, line := range data {
-		line = strings.TrimSpace(line)
-		if len(line) == 0 {
-			if len(levelData) > 0 {
-				allLevels = append(allLevels, levelData)
-				levelData = make([]string, 0)
+// openLevels reads the levels to play from a file or directory on disk,
+// falling back to the embedded ones if no path is given or there are no
+// levels to be read from it. It also returns the name of the levels,
+// under which the progress on them is saved.
+func openLevels(path string) ([]*levels.Level, string, error) {
+	if path != "" {
+		c, err := levels.Load(path)
+		switch {
+		case err != nil:
+			log.Printf("Can't read levels, playing the embedded ones: %v", err)
+		case len(c.Levels) == 0:
+			log.Printf("There are no levels in %s, playing the embedded ones", path)
+		default:
+			if abs, err := filepath.Abs(path); err == nil {
+				path = abs
 			}
-		} else {
-			levelData = append(levelData, line)
+			return c.Levels, path, nil
 		}
 	}
 
-	if len(levelData) > 0 {
-		allLevels = append(allLevels, levelData)
-	}
+	allLevels, err := levels.Embedded()
 
-	return allLevels
+	return allLevels, LevelsPath, err
 }
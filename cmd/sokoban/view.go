@@ -0,0 +1,69 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package main
+
+import (
+	"math"
+
+	"github.com/csixteen/sokoban/pkg/game"
+	"github.com/faiface/pixel"
+)
+
+// view maps the cells of a board to positions on the window, and back.
+// The board is drawn just like it's written on the levels file, with
+// row 0 at the top of the window and column 0 on the left.
+type view struct {
+	width, height int // Bounds of the board, in cells
+}
+
+func newView(board *game.Board) view {
+	width, height := board.Bounds()
+	return view{width: width, height: height}
+}
+
+// size returns the size of the board on the window, in pixels.
+func (v view) size() pixel.Vec {
+	return pixel.V(float64(v.width*TileSize), float64(v.height*TileSize))
+}
+
+// cellCenter returns the position on the window of the center of
+// cell (row, col) of the board.
+func (v view) cellCenter(row, col int) pixel.Vec {
+	return pixel.V(
+		float64(col*TileSize)+TileSize/2,
+		float64((v.height-1-row)*TileSize)+TileSize/2,
+	)
+}
+
+// cellAt returns the cell of the board drawn at position p of the
+// window, and whether there's such a cell.
+func (v view) cellAt(p pixel.Vec) (int, int, bool) {
+	col := int(math.Floor(p.X / TileSize))
+	row := v.height - 1 - int(math.Floor(p.Y/TileSize))
+
+	if row < 0 || row >= v.height || col < 0 || col >= v.width {
+		return -1, -1, false
+	}
+
+	return row, col, true
+}
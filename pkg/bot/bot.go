@@ -0,0 +1,299 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package bot lets programs written in any language play the game,
+// through a simple line-based text protocol.
+//
+// For each level, the game sends its description:
+//
+//	level 1
+//	title Corridor
+//	author Someone
+//	size 7 3
+//	board
+//	#######
+//	#@ $. #
+//	#######
+//	end
+//
+// The title and author lines are only sent when the level has them, and
+// the board is in the XSB format. The agent answers with "ready" once
+// it has read the level: anything it sends before that is ignored, as
+// it was meant for an earlier level. Then it sends one command per
+// line, which is either a sequence of moves in LURD (u, d, l or r, in
+// either case), "undo", "reset" or "quit". The game replies to each of
+// them with the state of the board: the number of moves and pushes so
+// far, followed by one of "playing", "deadlocked" or "solved".
+//
+//	state 2 1 playing
+//	#######
+//	#  @$.#
+//	#######
+//	end
+//
+// Commands that can't be understood get "error" followed by why, and
+// empty lines are ignored. The level ends with "done" followed by how
+// it ended: "solved", "timeout" or "quit".
+package bot
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/csixteen/sokoban/pkg/game"
+	"github.com/csixteen/sokoban/pkg/levels"
+)
+
+// How a level can end.
+const (
+	Solved  = "solved"
+	Timeout = "timeout"
+	Quit    = "quit"
+)
+
+var errTimeout = errors.New("Agent ran out of time")
+
+var directions = map[rune]game.Direction{
+	'u': game.Up,
+	'd': game.Down,
+	'l': game.Left,
+	'r': game.Right,
+}
+
+// Config sets the time limits of each level. A limit of 0 means there's
+// none.
+type Config struct {
+	MoveTime  time.Duration // Time the agent has to send each command
+	TotalTime time.Duration // Time the agent has to solve the level
+}
+
+// Result tells how a level ended.
+type Result struct {
+	End     string // Solved, Timeout or Quit
+	Moves   int
+	Pushes  int
+	LURD    string // Moves on the board when the level ended
+	Elapsed time.Duration
+}
+
+// Conn is a connection to an agent, which can play several levels in a
+// row. It isn't safe for concurrent use.
+type Conn struct {
+	lines <-chan string
+	err   error // Why there are no more lines, once lines is closed
+	w     *bufio.Writer
+}
+
+// NewConn creates a connection to an agent whose commands are read from
+// r and whose replies are written to w. Commands are read in the
+// background as they arrive, so that the time limits can be enforced.
+func NewConn(r io.Reader, w io.Writer) *Conn {
+	lines := make(chan string)
+	c := &Conn{lines: lines, w: bufio.NewWriter(w)}
+
+	go func() {
+		scanner := bufio.NewScanner(r)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+		c.err = scanner.Err()
+		close(lines)
+	}()
+
+	return c
+}
+
+// Play has the agent play a level until it's solved, or the agent runs
+// out of time or quits. An agent that hangs up is taken as quitting. It
+// fails if the level can't be played, or if the agent can't be talked
+// to.
+func (c *Conn) Play(level *levels.Level, cfg Config) (*Result, error) {
+	board, err := levels.NewBoard(level)
+	if err != nil {
+		return nil, err
+	}
+
+	c.sendLevel(level, board)
+	if err := c.w.Flush(); err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	result := func(end string) *Result {
+		return &Result{
+			End:     end,
+			Moves:   board.Moves(),
+			Pushes:  board.Pushes(),
+			LURD:    board.LURD(),
+			Elapsed: time.Since(start),
+		}
+	}
+
+	ready := false
+	for {
+		line, err := c.readLine(start, cfg)
+		switch {
+		case err == io.EOF:
+			return result(Quit), nil
+		case err == errTimeout:
+			fmt.Fprintf(c.w, "done %s\n", Timeout)
+			return result(Timeout), c.w.Flush()
+		case err != nil:
+			return nil, err
+		}
+
+		cmd := strings.TrimSpace(line)
+		if !ready {
+			// Commands sent late for an earlier level, such as one
+			// that ran out of time, mustn't be played on this one.
+			ready = cmd == "ready"
+			continue
+		}
+		if cmd == "" {
+			continue
+		}
+		if cmd == "quit" {
+			fmt.Fprintf(c.w, "done %s\n", Quit)
+			return result(Quit), c.w.Flush()
+		}
+
+		if err := run(board, cmd); err != nil {
+			fmt.Fprintf(c.w, "error %v\n", err)
+		} else {
+			c.sendState(board)
+		}
+
+		if board.IsVictory() {
+			fmt.Fprintf(c.w, "done %s\n", Solved)
+			return result(Solved), c.w.Flush()
+		}
+
+		if err := c.w.Flush(); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// readLine waits for the next command of the agent, for as long as the
+// time limits allow.
+func (c *Conn) readLine(start time.Time, cfg Config) (string, error) {
+	limit := cfg.MoveTime
+	if cfg.TotalTime > 0 {
+		left := cfg.TotalTime - time.Since(start)
+		if left <= 0 {
+			return "", errTimeout
+		}
+		if limit == 0 || left < limit {
+			limit = left
+		}
+	}
+
+	var timeout <-chan time.Time
+	if limit > 0 {
+		timer := time.NewTimer(limit)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	select {
+	case line, ok := <-c.lines:
+		if !ok {
+			if c.err != nil {
+				return "", c.err
+			}
+			return "", io.EOF
+		}
+		return line, nil
+	case <-timeout:
+		return "", errTimeout
+	}
+}
+
+// run carries out a command on the board. Moves are only made if they
+// can all be understood, and stop as soon as the level is solved.
+func run(board *game.Board, cmd string) error {
+	switch cmd {
+	case "undo":
+		board.Undo()
+		return nil
+	case "reset":
+		board.Reset()
+		return nil
+	}
+
+	var moves []game.Direction
+	for _, c := range cmd {
+		d, ok := directions[unicode.ToLower(c)]
+		if !ok {
+			return fmt.Errorf("unknown command %q", cmd)
+		}
+		moves = append(moves, d)
+	}
+
+	for _, d := range moves {
+		if board.IsVictory() {
+			break
+		}
+		board.Move(d)
+	}
+
+	return nil
+}
+
+func (c *Conn) sendLevel(level *levels.Level, board *game.Board) {
+	width, height := board.Bounds()
+
+	fmt.Fprintf(c.w, "level %d\n", level.ID)
+	if level.Title != "" {
+		fmt.Fprintf(c.w, "title %s\n", level.Title)
+	}
+	if level.Author != "" {
+		fmt.Fprintf(c.w, "author %s\n", level.Author)
+	}
+	fmt.Fprintf(c.w, "size %d %d\n", width, height)
+	fmt.Fprintln(c.w, "board")
+	c.sendBoard(board)
+}
+
+func (c *Conn) sendState(board *game.Board) {
+	status := "playing"
+	if board.IsVictory() {
+		status = Solved
+	} else if board.IsDeadlocked() {
+		status = "deadlocked"
+	}
+
+	fmt.Fprintf(c.w, "state %d %d %s\n", board.Moves(), board.Pushes(), status)
+	c.sendBoard(board)
+}
+
+func (c *Conn) sendBoard(board *game.Board) {
+	for _, row := range board.XSB() {
+		fmt.Fprintln(c.w, row)
+	}
+	fmt.Fprintln(c.w, "end")
+}
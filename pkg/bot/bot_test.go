@@ -0,0 +1,173 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package bot
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/csixteen/sokoban/pkg/levels"
+	"github.com/stretchr/testify/assert"
+)
+
+var corridor = &levels.Level{
+	ID:    1,
+	Title: "Corridor",
+	Lines: []string{"#######", "#@ $. #", "#######"},
+}
+
+func TestPlaySolved(t *testing.T) {
+	var out bytes.Buffer
+	c := NewConn(strings.NewReader("R\nready\nl\n\nx\nR\nundo\nrR\n"), &out)
+
+	res, err := c.Play(corridor, Config{})
+	assert.NoError(t, err)
+	assert.Equal(t, Solved, res.End)
+	assert.Equal(t, 2, res.Moves)
+	assert.Equal(t, 1, res.Pushes)
+	assert.Equal(t, "rR", res.LURD)
+
+	expected := `level 1
+title Corridor
+size 7 3
+board
+#######
+#@ $. #
+#######
+end
+state 0 0 playing
+#######
+#@ $. #
+#######
+end
+error unknown command "x"
+state 1 0 playing
+#######
+# @$. #
+#######
+end
+state 0 0 playing
+#######
+#@ $. #
+#######
+end
+state 2 1 solved
+#######
+#  @* #
+#######
+end
+done solved
+`
+	assert.Equal(t, expected, out.String())
+}
+
+func TestPlayQuit(t *testing.T) {
+	var out bytes.Buffer
+	c := NewConn(strings.NewReader("ready\nreset\nquit\nr\nready\nr\n"), &out)
+
+	res, err := c.Play(corridor, Config{})
+	assert.NoError(t, err)
+	assert.Equal(t, Quit, res.End)
+	assert.True(t, strings.HasSuffix(out.String(), "done quit\n"))
+
+	// The agent can still play the next level
+	res, err = c.Play(corridor, Config{})
+	assert.NoError(t, err)
+	assert.Equal(t, Quit, res.End, "Hanging up should be taken as quitting")
+	assert.Equal(t, 1, res.Moves)
+}
+
+func TestPlayDeadlocked(t *testing.T) {
+	level := &levels.Level{
+		ID:    2,
+		Lines: []string{"######", "#@   #", "# $  #", "#  . #", "######"},
+	}
+
+	var out bytes.Buffer
+	c := NewConn(strings.NewReader("ready\nddrU\n"), &out)
+
+	res, err := c.Play(level, Config{})
+	assert.NoError(t, err)
+	assert.Equal(t, Quit, res.End)
+	assert.Contains(t, out.String(), "state 4 1 deadlocked\n")
+}
+
+func TestPlayTimeout(t *testing.T) {
+	r, w := io.Pipe()
+	defer w.Close()
+
+	var out bytes.Buffer
+	c := NewConn(r, &out)
+
+	res, err := c.Play(corridor, Config{MoveTime: 10 * time.Millisecond})
+	assert.NoError(t, err)
+	assert.Equal(t, Timeout, res.End)
+	assert.True(t, strings.HasSuffix(out.String(), "done timeout\n"))
+
+	go func() {
+		io.WriteString(w, "ready\n")
+		for {
+			if _, err := io.WriteString(w, "l\n"); err != nil {
+				return
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}()
+
+	res, err = c.Play(corridor, Config{MoveTime: time.Second, TotalTime: 50 * time.Millisecond})
+	assert.NoError(t, err)
+	assert.Equal(t, Timeout, res.End, "Every move should count towards the total time")
+	assert.True(t, res.Elapsed < time.Second)
+}
+
+func TestPlayLateCommands(t *testing.T) {
+	r, w := io.Pipe()
+	defer w.Close()
+
+	var out bytes.Buffer
+	c := NewConn(r, &out)
+
+	res, err := c.Play(corridor, Config{MoveTime: 10 * time.Millisecond})
+	assert.NoError(t, err)
+	assert.Equal(t, Timeout, res.End)
+
+	// The agent only answers once the first level is over
+	go io.WriteString(w, "rR\nready\n")
+
+	res, err = c.Play(corridor, Config{MoveTime: 50 * time.Millisecond})
+	assert.NoError(t, err)
+	assert.Equal(t, Timeout, res.End, "Moves for the previous level shouldn't be played")
+	assert.Equal(t, 0, res.Moves)
+}
+
+func TestPlayBrokenLevel(t *testing.T) {
+	var out bytes.Buffer
+	c := NewConn(strings.NewReader(""), &out)
+
+	_, err := c.Play(&levels.Level{ID: 1, Lines: []string{"#####", "#$.##", "#####"}}, Config{})
+	assert.Error(t, err)
+	assert.Empty(t, out.String())
+}
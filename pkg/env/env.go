@@ -0,0 +1,293 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package env wraps the game in an environment for training agents,
+// in the style of OpenAI Gym: an episode starts with Reset, and goes on
+// with one Step per action until it's done.
+package env
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/csixteen/sokoban/pkg/game"
+	"github.com/csixteen/sokoban/pkg/levels"
+)
+
+var (
+	ErrNoLevels      = errors.New("There are no levels")
+	ErrNoSuchLevel   = errors.New("There's no such level")
+	ErrNotReset      = errors.New("Episode is done, or was never started")
+	ErrInvalidAction = errors.New("Invalid action")
+)
+
+// Action is a move of the player. Actions are numbered from 0 to
+// NumActions-1.
+type Action int
+
+const (
+	ActionUp Action = iota
+	ActionDown
+	ActionLeft
+	ActionRight
+	NumActions
+)
+
+var directions = map[Action]game.Direction{
+	ActionUp:    game.Up,
+	ActionDown:  game.Down,
+	ActionLeft:  game.Left,
+	ActionRight: game.Right,
+}
+
+// Rewards given for what happens on each step. They all add up.
+type Rewards struct {
+	Step       float64 // Given on every step, usually a penalty
+	BoxOnGoal  float64 // For each block pushed onto a goal
+	BoxOffGoal float64 // For each block pushed off a goal, usually a penalty
+	Solved     float64 // When the level is solved
+	Deadlock   float64 // Once, when the level can no longer be solved
+}
+
+// Config sets how the environment behaves.
+type Config struct {
+	Rewards       Rewards
+	EndOnDeadlock bool  // Whether episodes end as soon as the level can no longer be solved
+	MaxSteps      int   // Steps after which episodes end, or 0 for no limit
+	Seed          int64 // Seed for choosing random levels
+}
+
+// DefaultConfig returns a configuration with the rewards commonly used
+// for Sokoban, which ends episodes on deadlocks.
+func DefaultConfig() Config {
+	return Config{
+		Rewards: Rewards{
+			Step:       -0.1,
+			BoxOnGoal:  1,
+			BoxOffGoal: -1,
+			Solved:     10,
+			Deadlock:   -10,
+		},
+		EndOnDeadlock: true,
+		MaxSteps:      500,
+		Seed:          1,
+	}
+}
+
+// Info tells what happened on a step, besides the reward.
+type Info struct {
+	Level      int  // ID of the level being played
+	Steps      int  // Steps taken so far in the episode
+	Moved      bool // Whether the player could move at all
+	Pushed     bool // Whether the player pushed any block
+	Solved     bool
+	Deadlocked bool
+	Truncated  bool // Whether the episode ended because of MaxSteps
+}
+
+// Env is an environment in which the levels of a collection are played.
+// It isn't safe for concurrent use.
+type Env struct {
+	levels []*levels.Level
+	cfg    Config
+	rng    *rand.Rand
+	board  *game.Board
+	level  int // ID of the level being played
+	steps  int
+	done   bool
+
+	deadlocked bool // Whether the board was deadlocked before the last step
+}
+
+// New creates an environment for the given levels.
+func New(allLevels []*levels.Level, cfg Config) (*Env, error) {
+	if len(allLevels) == 0 {
+		return nil, ErrNoLevels
+	}
+
+	return &Env{
+		levels: allLevels,
+		cfg:    cfg,
+		rng:    rand.New(rand.NewSource(cfg.Seed)),
+		done:   true,
+	}, nil
+}
+
+// Reset starts a new episode on the level with the given ID, starting
+// at 1, or on a random one if the ID is 0, and returns the first
+// observation.
+func (e *Env) Reset(levelID int) (Observation, error) {
+	if levelID == 0 {
+		levelID = e.rng.Intn(len(e.levels)) + 1
+	}
+	if levelID < 1 || levelID > len(e.levels) {
+		return Observation{}, fmt.Errorf("%w: %d", ErrNoSuchLevel, levelID)
+	}
+
+	board, err := levels.NewBoard(e.levels[levelID-1])
+	if err != nil {
+		return Observation{}, err
+	}
+
+	e.board = board
+	e.level = levelID
+	e.steps = 0
+	e.done = false
+	e.deadlocked = false
+
+	return e.observe(), nil
+}
+
+// Step makes the player take an action, and returns what the board
+// looks like after it, the reward for it, whether the episode is done
+// and what happened. It fails if the episode is done.
+func (e *Env) Step(a Action) (Observation, float64, bool, Info, error) {
+	if e.done {
+		return Observation{}, 0, true, Info{}, ErrNotReset
+	}
+
+	d, ok := directions[a]
+	if !ok {
+		return Observation{}, 0, false, Info{}, fmt.Errorf("%w: %d", ErrInvalidAction, a)
+	}
+
+	moves, pushes := e.board.Moves(), e.board.Pushes()
+	before := e.blocksOnGoals()
+	e.board.Move(d)
+	after := e.blocksOnGoals()
+	e.steps++
+
+	info := Info{
+		Level:      e.level,
+		Steps:      e.steps,
+		Moved:      e.board.Moves() > moves,
+		Pushed:     e.board.Pushes() > pushes,
+		Solved:     e.board.IsVictory(),
+		Deadlocked: e.board.IsDeadlocked(),
+	}
+
+	r := e.cfg.Rewards
+	reward := r.Step
+	if after > before {
+		reward += float64(after-before) * r.BoxOnGoal
+	} else {
+		reward += float64(before-after) * r.BoxOffGoal
+	}
+
+	switch {
+	case info.Solved:
+		reward += r.Solved
+		e.done = true
+	case info.Deadlocked:
+		if !e.deadlocked {
+			reward += r.Deadlock
+		}
+		e.done = e.cfg.EndOnDeadlock
+	}
+	e.deadlocked = info.Deadlocked
+
+	if !e.done && e.cfg.MaxSteps > 0 && e.steps >= e.cfg.MaxSteps {
+		info.Truncated = true
+		e.done = true
+	}
+
+	return e.observe(), reward, e.done, info, nil
+}
+
+// Board returns the board being played. It shouldn't be changed, other
+// than through Step.
+func (e *Env) Board() *game.Board {
+	return e.board
+}
+
+func (e *Env) blocksOnGoals() int {
+	n := 0
+	for _, c := range e.board.Snapshot().Blocks {
+		if e.board.IsGoal(c.Row, c.Col) {
+			n++
+		}
+	}
+
+	return n
+}
+
+// Channels of the grid of an observation.
+const (
+	ChannelWall = iota
+	ChannelGoal
+	ChannelBlock
+	ChannelPlayer
+	NumChannels
+)
+
+// Observation is what the board looks like, both as a grid of channels
+// meant for neural networks, and as text.
+type Observation struct {
+	Width, Height int
+
+	// Grid has NumChannels layers of Height rows by Width columns, in
+	// this order, with 1 where the channel has something and 0 elsewhere.
+	Grid []float32
+
+	// ASCII is the board in the XSB format, with one line per row.
+	ASCII string
+}
+
+// At returns the value of a channel on cell (row, col).
+func (o Observation) At(channel, row, col int) float32 {
+	return o.Grid[(channel*o.Height+row)*o.Width+col]
+}
+
+func (e *Env) observe() Observation {
+	width, height := e.board.Bounds()
+	o := Observation{
+		Width:  width,
+		Height: height,
+		Grid:   make([]float32, NumChannels*width*height),
+		ASCII:  strings.Join(e.board.XSB(), "\n"),
+	}
+
+	set := func(channel, row, col int) {
+		o.Grid[(channel*height+row)*width+col] = 1
+	}
+
+	for row := 0; row < height; row++ {
+		for col := 0; col < width; col++ {
+			if e.board.IsWall(row, col) {
+				set(ChannelWall, row, col)
+			}
+			if e.board.IsGoal(row, col) {
+				set(ChannelGoal, row, col)
+			}
+			if e.board.IsBlock(row, col) {
+				set(ChannelBlock, row, col)
+			}
+		}
+	}
+
+	pRow, pCol := e.board.Player()
+	set(ChannelPlayer, pRow, pCol)
+
+	return o
+}
@@ -0,0 +1,197 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package env
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/csixteen/sokoban/pkg/levels"
+	"github.com/stretchr/testify/assert"
+)
+
+const testLevels = `; Corridor
+#######
+#@ $. #
+#######
+
+; Open room
+######
+#@   #
+# $  #
+#  . #
+######
+`
+
+func newTestEnv(t *testing.T, cfg Config) *Env {
+	c, err := levels.ParseCollection(strings.NewReader(testLevels))
+	assert.NoError(t, err)
+
+	e, err := New(c.Levels, cfg)
+	assert.NoError(t, err)
+
+	return e
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(nil, DefaultConfig())
+	assert.Equal(t, ErrNoLevels, err)
+}
+
+func TestResetObservation(t *testing.T) {
+	e := newTestEnv(t, DefaultConfig())
+
+	obs, err := e.Reset(1)
+	assert.NoError(t, err)
+	assert.Equal(t, 7, obs.Width)
+	assert.Equal(t, 3, obs.Height)
+	assert.Len(t, obs.Grid, NumChannels*7*3)
+	assert.Equal(t, "#######\n#@ $. #\n#######", obs.ASCII)
+
+	assert.Equal(t, float32(1), obs.At(ChannelWall, 0, 0))
+	assert.Equal(t, float32(0), obs.At(ChannelWall, 1, 1))
+	assert.Equal(t, float32(1), obs.At(ChannelPlayer, 1, 1))
+	assert.Equal(t, float32(1), obs.At(ChannelBlock, 1, 3))
+	assert.Equal(t, float32(1), obs.At(ChannelGoal, 1, 4))
+	assert.Equal(t, float32(0), obs.At(ChannelGoal, 1, 3))
+
+	_, err = e.Reset(3)
+	assert.True(t, errors.Is(err, ErrNoSuchLevel))
+}
+
+func TestStepSolved(t *testing.T) {
+	e := newTestEnv(t, DefaultConfig())
+	_, err := e.Reset(1)
+	assert.NoError(t, err)
+
+	_, reward, done, info, err := e.Step(ActionLeft)
+	assert.NoError(t, err)
+	assert.InDelta(t, -0.1, reward, 1e-9)
+	assert.False(t, done)
+	assert.False(t, info.Moved)
+
+	_, reward, done, info, err = e.Step(ActionRight)
+	assert.NoError(t, err)
+	assert.InDelta(t, -0.1, reward, 1e-9)
+	assert.False(t, done)
+	assert.True(t, info.Moved)
+	assert.False(t, info.Pushed)
+
+	obs, reward, done, info, err := e.Step(ActionRight)
+	assert.NoError(t, err)
+	assert.InDelta(t, -0.1+1+10, reward, 1e-9)
+	assert.True(t, done)
+	assert.True(t, info.Pushed)
+	assert.True(t, info.Solved)
+	assert.Equal(t, 1, info.Level)
+	assert.Equal(t, 3, info.Steps)
+	assert.Equal(t, float32(1), obs.At(ChannelBlock, 1, 4))
+	assert.Equal(t, float32(1), obs.At(ChannelPlayer, 1, 3))
+
+	_, _, _, _, err = e.Step(ActionLeft)
+	assert.Equal(t, ErrNotReset, err)
+
+	_, err = e.Reset(1)
+	assert.NoError(t, err)
+	_, _, _, _, err = e.Step(NumActions)
+	assert.True(t, errors.Is(err, ErrInvalidAction))
+}
+
+func TestStepDeadlock(t *testing.T) {
+	moves := []Action{ActionDown, ActionDown, ActionRight, ActionUp}
+
+	e := newTestEnv(t, DefaultConfig())
+	_, err := e.Reset(2)
+	assert.NoError(t, err)
+
+	var reward float64
+	var done bool
+	var info Info
+	for _, a := range moves {
+		_, reward, done, info, err = e.Step(a)
+		assert.NoError(t, err)
+	}
+	assert.True(t, info.Deadlocked)
+	assert.True(t, done)
+	assert.InDelta(t, -0.1-10, reward, 1e-9)
+
+	cfg := DefaultConfig()
+	cfg.EndOnDeadlock = false
+	e = newTestEnv(t, cfg)
+	_, err = e.Reset(2)
+	assert.NoError(t, err)
+	for _, a := range moves {
+		_, reward, done, info, err = e.Step(a)
+		assert.NoError(t, err)
+	}
+	assert.True(t, info.Deadlocked)
+	assert.False(t, done)
+	assert.InDelta(t, -0.1-10, reward, 1e-9)
+
+	_, reward, done, info, err = e.Step(ActionLeft)
+	assert.NoError(t, err)
+	assert.True(t, info.Deadlocked)
+	assert.False(t, done)
+	assert.InDelta(t, -0.1, reward, 1e-9, "Deadlocks should only be penalised once")
+}
+
+func TestStepMaxSteps(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.MaxSteps = 2
+
+	e := newTestEnv(t, cfg)
+	_, err := e.Reset(2)
+	assert.NoError(t, err)
+
+	_, _, done, _, err := e.Step(ActionRight)
+	assert.NoError(t, err)
+	assert.False(t, done)
+
+	_, _, done, info, err := e.Step(ActionLeft)
+	assert.NoError(t, err)
+	assert.True(t, done)
+	assert.True(t, info.Truncated)
+}
+
+func TestResetRandom(t *testing.T) {
+	pick := func(seed int64) []int {
+		cfg := DefaultConfig()
+		cfg.Seed = seed
+		e := newTestEnv(t, cfg)
+
+		var ids []int
+		for i := 0; i < 20; i++ {
+			_, err := e.Reset(0)
+			assert.NoError(t, err)
+			ids = append(ids, e.level)
+		}
+
+		return ids
+	}
+
+	ids := pick(42)
+	assert.Equal(t, ids, pick(42), "The same seed should pick the same levels")
+	assert.Contains(t, ids, 1)
+	assert.Contains(t, ids, 2)
+}
@@ -0,0 +1,224 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package game
+
+import (
+	"math/bits"
+	"strings"
+)
+
+// Bitboard is a compact version of Board, meant for running through
+// lots of moves quickly, as solvers and level generators do. Cells are
+// numbered row by row, and walls, goals and blocks are kept as sets of
+// bits. It follows the same rules as Board, including pushing lines of
+// blocks all at once, but keeps no history of moves.
+type Bitboard struct {
+	width, height int
+	walls         bitset
+	goals         bitset
+	blocks        bitset
+	player        int // Cell the player is on
+}
+
+// bitset holds one bit per cell of a board.
+type bitset []uint64
+
+func newBitset(n int) bitset {
+	return make(bitset, (n+63)/64)
+}
+
+func (s bitset) has(i int) bool {
+	return s[i/64]&(1<<uint(i%64)) != 0
+}
+
+func (s bitset) set(i int) {
+	s[i/64] |= 1 << uint(i%64)
+}
+
+func (s bitset) clear(i int) {
+	s[i/64] &^= 1 << uint(i%64)
+}
+
+func (s bitset) clone() bitset {
+	c := make(bitset, len(s))
+	copy(c, s)
+	return c
+}
+
+// NewBitboard creates a bitboard with the same layout and state as the
+// given board.
+func NewBitboard(b *Board) *Bitboard {
+	n := b.width * b.height
+	bb := &Bitboard{
+		width:  b.width,
+		height: b.height,
+		walls:  newBitset(n),
+		goals:  newBitset(n),
+		blocks: newBitset(n),
+		player: b.pRow*b.width + b.pCol,
+	}
+
+	for row := 0; row < b.height; row++ {
+		for col := 0; col < b.width; col++ {
+			i := row*b.width + col
+			if b.IsWall(row, col) {
+				bb.walls.set(i)
+			}
+			if b.IsGoal(row, col) {
+				bb.goals.set(i)
+			}
+			if b.IsBlock(row, col) {
+				bb.blocks.set(i)
+			}
+		}
+	}
+
+	return bb
+}
+
+// Board creates a board with the same layout and state as the
+// bitboard, with the player facing down.
+func (bb *Bitboard) Board() (*Board, error) {
+	return NewBoardFromXSB(bb.XSB())
+}
+
+// XSB returns the bitboard in the XSB format.
+func (bb *Bitboard) XSB() []string {
+	lines := make([]string, bb.height)
+	for row := range lines {
+		var sb strings.Builder
+		for col := 0; col < bb.width; col++ {
+			i := row*bb.width + col
+			switch {
+			case bb.walls.has(i):
+				sb.WriteRune('#')
+			case bb.blocks.has(i) && bb.goals.has(i):
+				sb.WriteRune('*')
+			case bb.blocks.has(i):
+				sb.WriteRune('$')
+			case i == bb.player && bb.goals.has(i):
+				sb.WriteRune('+')
+			case i == bb.player:
+				sb.WriteRune('@')
+			case bb.goals.has(i):
+				sb.WriteRune('.')
+			default:
+				sb.WriteRune(' ')
+			}
+		}
+		lines[row] = strings.TrimRight(sb.String(), " ")
+	}
+
+	return lines
+}
+
+// Clone returns a copy of the bitboard that shares nothing with it.
+func (bb *Bitboard) Clone() *Bitboard {
+	c := *bb
+	c.blocks = bb.blocks.clone()
+	return &c
+}
+
+// Player returns a pair (row, col) representing the location of the
+// player on the board.
+func (bb *Bitboard) Player() (int, int) {
+	return bb.player / bb.width, bb.player % bb.width
+}
+
+// State returns the current state of the bitboard.
+func (bb *Bitboard) State() State {
+	row, col := bb.Player()
+	s := State{Player: Cell{row, col}}
+
+	for w, word := range bb.blocks {
+		for word != 0 {
+			i := w*64 + bits.TrailingZeros64(word)
+			s.Blocks = append(s.Blocks, Cell{i / bb.width, i % bb.width})
+			word &= word - 1
+		}
+	}
+
+	return s
+}
+
+// IsVictory tells whether there's a block on every goal.
+func (bb *Bitboard) IsVictory() bool {
+	for i := range bb.goals {
+		if bb.goals[i]&^bb.blocks[i] != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Move moves the player one cell towards direction d, pushing along
+// every block lined up in front of it. It returns the number of blocks
+// pushed, and whether the player could move at all.
+func (bb *Bitboard) Move(d Direction) (int, bool) {
+	row, col := bb.Player()
+
+	// Find the first cell past the player and the blocks in front of it.
+	pushed := 0
+	for {
+		row, col = step(row, col, d)
+		if row < 0 || row >= bb.height || col < 0 || col >= bb.width {
+			return 0, false
+		}
+
+		i := row*bb.width + col
+		if bb.walls.has(i) {
+			return 0, false
+		}
+		if !bb.blocks.has(i) {
+			break
+		}
+		pushed++
+	}
+
+	next := bb.next(bb.player, d)
+	if pushed > 0 {
+		// Pushing a line of blocks is the same as moving its first
+		// block right past its last one.
+		bb.blocks.clear(next)
+		bb.blocks.set(row*bb.width + col)
+	}
+	bb.player = next
+
+	return pushed, true
+}
+
+// next returns the cell next to cell i in direction d, which must be
+// within the bounds of the board.
+func (bb *Bitboard) next(i int, d Direction) int {
+	switch d {
+	case Up:
+		return i - bb.width
+	case Down:
+		return i + bb.width
+	case Left:
+		return i - 1
+	default:
+		return i + 1
+	}
+}
@@ -0,0 +1,157 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package game
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var benchLevel = []string{
+	"wwwwwwww",
+	"wffwfffw",
+	"wjbggbfw",
+	"wfbgbffw",
+	"wffggbfw",
+	"wffffffw",
+	"wfwwfwww",
+	"wwwwwwww",
+}
+
+const benchSolution = "RldddrrrrruuuulldDrruLdlldlluRddrrrruL"
+
+func TestBitboardConversion(t *testing.T) {
+	board, err := NewBoard(benchLevel)
+	assert.NoError(t, err)
+
+	bb := NewBitboard(board)
+	assert.True(t, board.Snapshot().Equal(bb.State()))
+	assert.Equal(t, board.XSB(), bb.XSB())
+
+	back, err := bb.Board()
+	assert.NoError(t, err)
+	assert.True(t, board.Snapshot().Equal(back.Snapshot()))
+}
+
+func TestBitboardSolution(t *testing.T) {
+	board, err := NewBoard(benchLevel)
+	assert.NoError(t, err)
+	bb := NewBitboard(board)
+
+	for _, c := range benchSolution {
+		d, push, err := parseLURD(c)
+		assert.NoError(t, err)
+
+		pushed, ok := bb.Move(d)
+		assert.True(t, ok, string(c))
+		assert.Equal(t, push, pushed > 0, string(c))
+	}
+	assert.True(t, bb.IsVictory())
+}
+
+// TestBitboardMatchesBoard makes the same random moves on both kinds
+// of boards, which should always end up in the same state.
+func TestBitboardMatchesBoard(t *testing.T) {
+	levels := [][]string{
+		benchLevel,
+		{
+			"  #####",
+			"###   #",
+			"#@$$ .#",
+			"# $ ..#",
+			"#     #",
+			"#######",
+		},
+	}
+	dirs := []Direction{Up, Down, Left, Right}
+	rng := rand.New(rand.NewSource(1))
+
+	for _, data := range levels {
+		board, err := newBoard(data, decoderFor(data))
+		assert.NoError(t, err)
+		bb := NewBitboard(board)
+		clone := bb.Clone()
+
+		for i := 0; i < 2000; i++ {
+			d := dirs[rng.Intn(len(dirs))]
+			moves, pushes := board.Moves(), board.Pushes()
+
+			pushed, ok := bb.Move(d)
+			board.Move(d)
+
+			assert.Equal(t, ok, board.Moves() > moves)
+			assert.Equal(t, pushed > 0, board.Pushes() > pushes)
+			if !assert.True(t, board.Snapshot().Equal(bb.State()), "move %d", i) {
+				break
+			}
+			assert.Equal(t, board.IsVictory(), bb.IsVictory())
+		}
+
+		assert.NotEqual(t, clone.State(), bb.State(), "Clones shouldn't share blocks")
+	}
+}
+
+func decoderFor(data []string) func(rune) ([]rune, bool) {
+	if IsXSB(data) {
+		return decodeXSB
+	}
+	return decodeCell
+}
+
+func BenchmarkBoardReplay(b *testing.B) {
+	board, _ := NewBoard(benchLevel)
+	dirs := benchDirections()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		board.Reset()
+		for _, d := range dirs {
+			board.Move(d)
+		}
+	}
+}
+
+func BenchmarkBitboardReplay(b *testing.B) {
+	board, _ := NewBoard(benchLevel)
+	start := NewBitboard(board)
+	dirs := benchDirections()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bb := start.Clone()
+		for _, d := range dirs {
+			bb.Move(d)
+		}
+	}
+}
+
+func benchDirections() []Direction {
+	var dirs []Direction
+	for _, c := range benchSolution {
+		d, _, _ := parseLURD(c)
+		dirs = append(dirs, d)
+	}
+	return dirs
+}
@@ -24,10 +24,14 @@ package game
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 
 	u "github.com/csixteen/sokoban/pkg/utils"
 )
 
+var ErrOutOfBounds = errors.New("Position is out of the board")
+
 type Direction int
 
 const (
@@ -37,12 +41,31 @@ const (
 	Right
 )
 
+// Cell is a position on the board.
+type Cell struct {
+	Row, Col int
+}
+
 type Board struct {
-	data          []string
+	start         State // Initial state, restored by Reset
+	startFacing   rune  // Initial player character
 	matrix        [][]*u.Stack
 	width, height int
 	pRow, pCol    int // Player coordinates on the board
 	goals         int
+	dead          [][]bool // Cells from which a block can never reach a goal
+	deadlocked    []Cell   // Blocks that can never reach a goal
+	pushes        int      // Number of moves in history that pushed blocks
+	history       []move   // Moves that can be undone, oldest first
+	undone        []move   // Moves that can be redone, most recent last
+}
+
+// move records a single successful step of the player, with enough
+// information to revert it.
+type move struct {
+	d      Direction
+	facing rune // Player character before the move
+	pushed int  // Number of blocks pushed along with the player
 }
 
 // NewBoard generates a new Board from an array of strings, where
@@ -57,39 +80,80 @@ type Board struct {
 //  l - player facing right
 //  f - floor
 //  g - goal (where you must place a block onto)
-//  o - block on top of goal (becomes unmovable)
-func NewBoard(data []string) *Board {
+//  o - block on top of goal
+//
+// It fails with a *LevelError if there are no rows, if there are
+// characters other than these, or if there isn't exactly one player.
+func NewBoard(data []string) (*Board, error) {
+	return newBoard(data, decodeCell)
+}
+
+// newBoard generates a new Board from an array of strings, using
+// `decode` to find out which elements are stacked on top of the floor
+// of each cell, after checking that it can be played at all.
+func newBoard(data []string, decode func(rune) ([]rune, bool)) (*Board, error) {
+	if len(data) == 0 {
+		return nil, &LevelError{-1, -1, ErrEmptyLevel}
+	}
+
+	for row, line := range data {
+		for col, c := range []rune(line) {
+			if _, ok := decode(c); !ok {
+				return nil, &LevelError{row, col, fmt.Errorf("%w %q", ErrUnknownChar, c)}
+			}
+		}
+	}
+
+	b, players := layoutBoard(data, decode)
+	if players != 1 {
+		return nil, &LevelError{-1, -1, fmt.Errorf("%w, found %d", ErrPlayerCount, players)}
+	}
+
+	return b, nil
+}
+
+// layoutBoard generates a new Board from an array of strings, as is,
+// and returns it along with the number of players found on it. Rows
+// shorter than the longest one are padded with floor.
+func layoutBoard(data []string, decode func(rune) ([]rune, bool)) (*Board, int) {
 	rows := len(data)
-	cols := len(data[0])
+	cols := 0
+	for _, line := range data {
+		if n := len([]rune(line)); n > cols {
+			cols = n
+		}
+	}
 
-	var goals, pRow, pCol int
+	var goals, players, pRow, pCol int
 
 	m := make([][]*u.Stack, rows)
 	for row := 0; row < rows; row++ {
 		m[row] = make([]*u.Stack, cols)
-		for col, c := range data[row] {
+		for col := range m[row] {
 			m[row][col] = u.NewStack()
 			m[row][col].Push('f')
+		}
 
-			if isFloor(c) {
-				continue
-			}
+		for col, c := range []rune(data[row]) {
+			elems, _ := decode(c)
+			for _, elem := range elems {
+				m[row][col].Push(elem)
 
-			if isGoal(c) {
-				goals++
+				if isPlayer(elem) {
+					pCol = col
+					pRow = row
+					players++
+				}
 			}
 
-			m[row][col].Push(c)
-
-			if isPlayer(c) {
-				pCol = col
-				pRow = row
+			// Only goals that don't have a block on them are counted.
+			if top, _ := m[row][col].Top(); m[row][col].Contains('g') && !isBlock(top) {
+				goals++
 			}
 		}
 	}
 
-	return &Board{
-		data:   data,
+	b := &Board{
 		matrix: m,
 		width:  cols,
 		height: rows,
@@ -97,6 +161,32 @@ func NewBoard(data []string) *Board {
 		pCol:   pCol,
 		goals:  goals,
 	}
+	b.dead = b.findDeadSquares()
+	b.deadlocked = b.findDeadlocks()
+	b.start = b.Snapshot()
+	b.startFacing, _ = b.Get(pRow, pCol)
+
+	return b, players
+}
+
+// decodeCell returns the elements to be stacked on top of the floor
+// for a character of the board format used by NewBoard, and whether
+// it's part of the format at all.
+func decodeCell(c rune) ([]rune, bool) {
+	if !strings.ContainsRune(internalChars, c) {
+		return nil, false
+	}
+
+	if isFloor(c) {
+		return nil, true
+	}
+
+	// Blocks can be pushed off goals, which must then be left behind.
+	if c == 'o' {
+		return []rune{'g', 'o'}, true
+	}
+
+	return []rune{c}, true
 }
 
 ///-------------------------------
@@ -110,13 +200,9 @@ func (b *Board) IsVictory() bool {
 ///                 Board manipulation
 
 // Reset resets the board to its initial state.
-// TODO: figure out a better way of doing this.
 func (b *Board) Reset() {
-	n := NewBoard(b.data)
-	b.matrix = n.matrix
-	b.pRow = n.pRow
-	b.pCol = n.pCol
-	b.goals = n.goals
+	b.Restore(b.start)
+	b.setPlayerChar(b.startFacing)
 }
 
 // Bounds returns a pair (width, height) representing the
@@ -125,14 +211,44 @@ func (b *Board) Bounds() (int, int) {
 	return b.width, b.height
 }
 
+// IsWall tells whether there's a wall on position (row, col) of the board
+func (b *Board) IsWall(row, col int) bool {
+	elem, _ := b.Get(row, col)
+	return isUnmovable(elem)
+}
+
+// IsGoal tells whether there's a goal on position (row, col) of the
+// board, regardless of having a block or the player on top of it.
+func (b *Board) IsGoal(row, col int) bool {
+	return b.inBounds(row, col) && b.matrix[row][col].Contains('g')
+}
+
+// IsBlock tells whether there's a block on position (row, col) of the board
+func (b *Board) IsBlock(row, col int) bool {
+	elem, _ := b.Get(row, col)
+	return isBlock(elem)
+}
+
 // Get returns the rune that's on position (row, col) of the board
 func (b *Board) Get(row, col int) (rune, error) {
+	if !b.inBounds(row, col) {
+		return -1, ErrOutOfBounds
+	}
+
 	return b.matrix[row][col].Top()
 }
 
-// Put puts the rune `elem` on the position (row, col) in the board
+// Put puts the rune `elem` on the position (row, col) in the board.
+// Positions out of the board are left alone.
 func (b *Board) Put(row, col int, elem rune) {
-	b.matrix[row][col].Push(elem)
+	if b.inBounds(row, col) {
+		b.matrix[row][col].Push(elem)
+	}
+}
+
+// inBounds tells whether (row, col) is a position of the board.
+func (b *Board) inBounds(row, col int) bool {
+	return row >= 0 && row < b.height && col >= 0 && col < b.width
 }
 
 // Remove removes the rune that's on the position (row, col) of the board
@@ -155,44 +271,84 @@ func (b *Board) Remove(row, col int) (rune, error) {
 // floor or goal) or contains a movable object. If it contains a movable
 // object, it will try to move it as well, in a recursive fashion.
 func (b *Board) moveFrom(sRow, sCol int, d Direction) (int, int, error) {
-	elem, _ := b.Get(sRow, sCol)
+	elem, err := b.Get(sRow, sCol)
+	if err != nil {
+		return -1, -1, err
+	}
 	if !isMovable(elem) {
 		return -1, -1, errors.New("Cannot move unmovable element")
 	}
 
-	nextRow := sRow
-	nextCol := sCol
+	nextRow, nextCol := step(sRow, sCol, d)
 
-	switch d {
-	case Up:
-		nextRow--
-	case Down:
-		nextRow++
-	case Left:
-		nextCol--
-	case Right:
-		nextCol++
+	nextElem, err := b.Get(nextRow, nextCol)
+	if err != nil {
+		return -1, -1, err
 	}
-
-	nextElem, _ := b.Get(nextRow, nextCol)
 	if isWalkable(nextElem) {
-		elem, _ = b.Remove(sRow, sCol)
-		if isGoal(nextElem) && !isPlayer(elem) {
-			elem = 'o'
-			b.goals--
-		}
-		b.Put(nextRow, nextCol, elem)
+		b.transfer(sRow, sCol, nextRow, nextCol)
 		return nextRow, nextCol, nil
 	}
 
-	_, _, err := b.moveFrom(nextRow, nextCol, d)
-	if err != nil {
+	if _, _, err := b.moveFrom(nextRow, nextCol, d); err != nil {
 		return -1, -1, err
 	}
 
 	return b.moveFrom(sRow, sCol, d)
 }
 
+// transfer moves whatever is on top of (sRow, sCol) to (dRow, dCol),
+// without checking whether the move is legal. Blocks are turned into
+// blocks on a goal (and back) as they land on (or leave) a goal, and
+// the goals counter is kept up to date.
+func (b *Board) transfer(sRow, sCol, dRow, dCol int) {
+	elem, _ := b.matrix[sRow][sCol].Pop()
+
+	if isBlock(elem) {
+		if below, _ := b.Get(sRow, sCol); isGoal(below) {
+			b.goals++
+		}
+		elem = 'b'
+
+		if dest, _ := b.Get(dRow, dCol); isGoal(dest) {
+			elem = 'o'
+			b.goals--
+		}
+	}
+
+	b.Put(dRow, dCol, elem)
+}
+
+// step returns the coordinates of the cell adjacent to (row, col)
+// in direction d.
+func step(row, col int, d Direction) (int, int) {
+	switch d {
+	case Up:
+		row--
+	case Down:
+		row++
+	case Left:
+		col--
+	case Right:
+		col++
+	}
+
+	return row, col
+}
+
+func (d Direction) opposite() Direction {
+	switch d {
+	case Up:
+		return Down
+	case Down:
+		return Up
+	case Left:
+		return Right
+	default:
+		return Left
+	}
+}
+
 ///-------------------------------------------------------------
 ///         Board elements assertions and predicates
 
@@ -200,16 +356,20 @@ func isPlayer(c rune) bool {
 	return c == 'h' || c == 'j' || c == 'k' || c == 'l'
 }
 
+func isBlock(c rune) bool {
+	return c == 'b' || c == 'o'
+}
+
 func isGoal(c rune) bool {
 	return c == 'g'
 }
 
 func isMovable(c rune) bool {
-	return c == 'b' || isPlayer(c)
+	return isBlock(c) || isPlayer(c)
 }
 
 func isUnmovable(c rune) bool {
-	return c == 'w' || c == 'o'
+	return c == 'w'
 }
 
 func isFloor(c rune) bool {
@@ -223,6 +383,12 @@ func isWalkable(c rune) bool {
 ///-----------------------------------------
 ///          Player manipulation
 
+// Player returns a pair (row, col) representing the location
+// of the player on the board.
+func (b *Board) Player() (int, int) {
+	return b.findPlayer()
+}
+
 // FindPlayer returns a pair (row, col) representing the location
 // of the player on the board.
 func (b *Board) findPlayer() (int, int) {
@@ -240,7 +406,10 @@ func (b *Board) setPlayerChar(c rune) {
 	b.matrix[row][col].Push(c)
 }
 
-func (b *Board) movePlayer(d Direction) {
+func (b *Board) movePlayer(d Direction) error {
+	r, c := b.findPlayer()
+	facing, _ := b.Get(r, c)
+
 	switch d {
 	case Up:
 		b.setPlayerChar('k')
@@ -252,11 +421,36 @@ func (b *Board) movePlayer(d Direction) {
 		b.setPlayerChar('l')
 	}
 
-	r, c := b.findPlayer()
+	// Every block lined up in front of the player gets pushed
+	// along, should the move succeed.
+	pushed := 0
+	for nr, nc := step(r, c, d); ; nr, nc = step(nr, nc, d) {
+		if elem, _ := b.Get(nr, nc); !isBlock(elem) {
+			break
+		}
+		pushed++
+	}
+
 	row, col, err := b.moveFrom(r, c, d)
-	if err == nil {
-		b.setPlayerPos(row, col)
+	if err != nil {
+		return err
+	}
+	b.setPlayerPos(row, col)
+
+	if pushed > 0 {
+		b.pushes++
+		b.deadlocked = b.findDeadlocks()
 	}
+	b.history = append(b.history, move{d: d, facing: facing, pushed: pushed})
+	b.undone = nil
+
+	return nil
+}
+
+// Move moves the player one cell towards direction d, pushing any
+// blocks in the way, if possible.
+func (b *Board) Move(d Direction) {
+	b.movePlayer(d)
 }
 
 func (b *Board) MoveRight() {
@@ -274,3 +468,73 @@ func (b *Board) MoveUp() {
 func (b *Board) MoveDown() {
 	b.movePlayer(Down)
 }
+
+///-----------------------------------------
+///          Move history
+
+// Moves returns the number of steps taken by the player, including
+// the ones that pushed blocks. Undone moves are not counted.
+func (b *Board) Moves() int {
+	return len(b.history)
+}
+
+// Pushes returns the number of steps in which the player pushed
+// blocks. Undone moves are not counted.
+func (b *Board) Pushes() int {
+	return b.pushes
+}
+
+// CanUndo tells whether there are moves that can be undone.
+func (b *Board) CanUndo() bool {
+	return len(b.history) > 0
+}
+
+// CanRedo tells whether there are undone moves that can be redone.
+func (b *Board) CanRedo() bool {
+	return len(b.undone) > 0
+}
+
+// Undo reverts the last move of the player, pulling back any blocks
+// that were pushed by it. It does nothing if there is no move to undo.
+func (b *Board) Undo() {
+	if !b.CanUndo() {
+		return
+	}
+
+	m := b.history[len(b.history)-1]
+	b.history = b.history[:len(b.history)-1]
+
+	row, col := b.findPlayer()
+	pRow, pCol := step(row, col, m.d.opposite())
+	b.transfer(row, col, pRow, pCol)
+	b.setPlayerPos(pRow, pCol)
+	b.setPlayerChar(m.facing)
+
+	// Pull the blocks back, starting from the one closest to the player.
+	dRow, dCol := row, col
+	for i := 0; i < m.pushed; i++ {
+		sRow, sCol := step(dRow, dCol, m.d)
+		b.transfer(sRow, sCol, dRow, dCol)
+		dRow, dCol = sRow, sCol
+	}
+	if m.pushed > 0 {
+		b.pushes--
+		b.deadlocked = b.findDeadlocks()
+	}
+
+	b.undone = append(b.undone, m)
+}
+
+// Redo replays the last undone move. It does nothing if there is
+// no move to redo.
+func (b *Board) Redo() {
+	if !b.CanRedo() {
+		return
+	}
+
+	m := b.undone[len(b.undone)-1]
+	undone := b.undone[:len(b.undone)-1]
+
+	b.movePlayer(m.d)
+	b.undone = undone
+}
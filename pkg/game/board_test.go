@@ -23,6 +23,7 @@
 package game
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -40,7 +41,8 @@ func TestNewBoard(t *testing.T) {
 		"wwwwwwww",
 	}
 
-	board := NewBoard(data)
+	board, err := NewBoard(data)
+	assert.NoError(t, err)
 	w, h := board.Bounds()
 
 	assert.Equal(t, 8, w, "Width should be 8")
@@ -52,13 +54,20 @@ func TestNewBoard(t *testing.T) {
 
 	board.Put(1, 1, 'o')
 	v, _ = board.Get(1, 1)
-	assert.Equal(t, 'o', v, "There should be a `c` in cell (1, 1)")
-	assert.True(t, isUnmovable(v))
+	assert.Equal(t, 'o', v, "There should be an `o` in cell (1, 1)")
+	assert.True(t, isMovable(v))
 
-	v, err := board.Remove(1, 1)
-	assert.Error(t, err)
+	v, err = board.Remove(1, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 'o', v)
 	v, _ = board.Get(1, 1)
-	assert.Equal(t, 'o', v, "There should be an `o` in cell (1, 1)")
+	assert.Equal(t, 'f', v, "There should be an `f` in cell (1, 1)")
+
+	board.Put(1, 0, 'w')
+	_, err = board.Remove(1, 0)
+	assert.Error(t, err)
+	v, _ = board.Get(1, 0)
+	assert.Equal(t, 'w', v, "There should be a `w` in cell (1, 0)")
 
 	r, c := board.findPlayer()
 	assert.Equal(t, 2, r)
@@ -85,7 +94,8 @@ func TestMovePlayer(t *testing.T) {
 		"wwwwwwww",
 	}
 
-	board := NewBoard(data)
+	board, err := NewBoard(data)
+	assert.NoError(t, err)
 	board.MoveDown()
 	v, _ := board.Get(2, 1)
 	assert.True(t, isFloor(v))
@@ -102,13 +112,14 @@ func TestMovePlayer(t *testing.T) {
 	v, _ = board.Get(3, 2)
 	assert.True(t, isPlayer(v))
 	v, _ = board.Get(3, 3)
-	assert.True(t, isUnmovable(v))
+	assert.Equal(t, 'o', v)
 }
 
 func TestMovePlayerTwoBlocks(t *testing.T) {
 	data := []string{"jbbgw"}
 
-	board := NewBoard(data)
+	board, err := NewBoard(data)
+	assert.NoError(t, err)
 
 	board.MoveRight()
 	r, c := board.findPlayer()
@@ -123,3 +134,246 @@ func TestMovePlayerTwoBlocks(t *testing.T) {
 	v, _ = board.Get(0, 3)
 	assert.Equal(t, 'o', v)
 }
+
+func TestUndoRedo(t *testing.T) {
+	data := []string{
+		"wwwwww",
+		"wlbfgw",
+		"wwwwww",
+	}
+
+	board, err := NewBoard(data)
+	assert.NoError(t, err)
+	assert.False(t, board.CanUndo())
+	assert.False(t, board.CanRedo())
+
+	board.MoveRight()
+	board.MoveRight()
+	assert.True(t, board.IsVictory())
+	assert.Equal(t, 2, board.Moves())
+	assert.Equal(t, 2, board.Pushes())
+
+	v, _ := board.Get(1, 4)
+	assert.Equal(t, 'o', v)
+
+	board.Undo()
+	assert.False(t, board.IsVictory())
+	r, c := board.findPlayer()
+	assert.Equal(t, 1, r)
+	assert.Equal(t, 2, c)
+	v, _ = board.Get(1, 3)
+	assert.Equal(t, 'b', v)
+	v, _ = board.Get(1, 4)
+	assert.Equal(t, 'g', v)
+	assert.True(t, board.CanRedo())
+	assert.Equal(t, 1, board.Moves())
+	assert.Equal(t, 1, board.Pushes())
+
+	board.Undo()
+	r, c = board.findPlayer()
+	assert.Equal(t, 1, r)
+	assert.Equal(t, 1, c)
+	v, _ = board.Get(1, 1)
+	assert.Equal(t, 'l', v)
+	v, _ = board.Get(1, 2)
+	assert.Equal(t, 'b', v)
+	assert.False(t, board.CanUndo())
+
+	board.Redo()
+	board.Redo()
+	assert.True(t, board.IsVictory())
+	assert.False(t, board.CanRedo())
+
+	board.Undo()
+	board.MoveLeft()
+	assert.False(t, board.CanRedo(), "A new move should discard undone moves")
+}
+
+func TestUndoRestoresFacing(t *testing.T) {
+	data := []string{"wjfw"}
+
+	board, err := NewBoard(data)
+	assert.NoError(t, err)
+	board.MoveLeft()
+	assert.False(t, board.CanUndo(), "Blocked moves shouldn't be recorded")
+
+	board.MoveRight()
+	v, _ := board.Get(0, 2)
+	assert.Equal(t, 'l', v)
+
+	board.Undo()
+	v, _ = board.Get(0, 1)
+	assert.Equal(t, 'h', v, "Facing should be restored to the one before the move")
+}
+
+func TestUndoTwoBlocks(t *testing.T) {
+	data := []string{"jbbgw"}
+
+	board, err := NewBoard(data)
+	assert.NoError(t, err)
+	board.MoveRight()
+	board.Undo()
+
+	v, _ := board.Get(0, 0)
+	assert.Equal(t, 'j', v)
+	v, _ = board.Get(0, 1)
+	assert.Equal(t, 'b', v)
+	v, _ = board.Get(0, 2)
+	assert.Equal(t, 'b', v)
+	v, _ = board.Get(0, 3)
+	assert.Equal(t, 'g', v)
+	assert.False(t, board.IsVictory())
+}
+
+func TestMovesAndPushes(t *testing.T) {
+	data := []string{
+		"wwwwww",
+		"wffffw",
+		"wlbfgw",
+		"wwwwww",
+	}
+
+	board, err := NewBoard(data)
+	assert.NoError(t, err)
+	board.MoveUp()
+	board.MoveUp()
+	board.MoveDown()
+	board.MoveRight()
+	assert.Equal(t, 3, board.Moves(), "Blocked moves shouldn't be counted")
+	assert.Equal(t, 1, board.Pushes())
+
+	board.Reset()
+	assert.Equal(t, 0, board.Moves())
+	assert.Equal(t, 0, board.Pushes())
+}
+
+func TestPushBlockOffGoal(t *testing.T) {
+	data := []string{
+		"wwwwwww",
+		"wlbgffw",
+		"wwwwwww",
+	}
+
+	board, err := NewBoard(data)
+	assert.NoError(t, err)
+	assert.False(t, board.IsVictory())
+
+	board.MoveRight()
+	v, _ := board.Get(1, 3)
+	assert.Equal(t, 'o', v)
+	assert.True(t, board.IsVictory())
+
+	board.MoveRight()
+	v, _ = board.Get(1, 3)
+	assert.True(t, isPlayer(v), "The player should be standing on the goal")
+	v, _ = board.Get(1, 4)
+	assert.Equal(t, 'b', v, "The block should no longer be on a goal")
+	assert.False(t, board.IsVictory())
+
+	board.MoveRight()
+	v, _ = board.Get(1, 3)
+	assert.Equal(t, 'g', v, "The goal should be left behind by the player")
+
+	board.Undo()
+	v, _ = board.Get(1, 3)
+	assert.True(t, isPlayer(v))
+	board.Undo()
+	v, _ = board.Get(1, 3)
+	assert.Equal(t, 'o', v)
+	assert.True(t, board.IsVictory())
+}
+
+func TestPushBlockAcrossGoals(t *testing.T) {
+	data := []string{
+		"wwwwwww",
+		"wlogffw",
+		"wwwwwww",
+	}
+
+	board, err := NewBoard(data)
+	assert.NoError(t, err)
+	assert.False(t, board.IsVictory())
+
+	board.MoveRight()
+	v, _ := board.Get(1, 2)
+	assert.True(t, isPlayer(v))
+	v, _ = board.Get(1, 3)
+	assert.Equal(t, 'o', v)
+	assert.False(t, board.IsVictory())
+
+	board.MoveLeft()
+	v, _ = board.Get(1, 2)
+	assert.Equal(t, 'g', v)
+	board.MoveRight()
+	board.MoveRight()
+	v, _ = board.Get(1, 4)
+	assert.Equal(t, 'b', v)
+	assert.Equal(t, 2, board.goals)
+}
+
+func TestBoardLayout(t *testing.T) {
+	data := []string{
+		"wwwwww",
+		"wlbfow",
+		"wwwwww",
+	}
+
+	board, err := NewBoard(data)
+	assert.NoError(t, err)
+	assert.True(t, board.IsWall(0, 0))
+	assert.False(t, board.IsWall(1, 3))
+	assert.True(t, board.IsBlock(1, 2))
+	assert.True(t, board.IsBlock(1, 4))
+	assert.False(t, board.IsGoal(1, 2))
+	assert.True(t, board.IsGoal(1, 4))
+
+	r, c := board.Player()
+	assert.Equal(t, 1, r)
+	assert.Equal(t, 1, c)
+}
+
+func TestNewBoardErrors(t *testing.T) {
+	cases := []struct {
+		data []string
+		err  error
+	}{
+		{nil, ErrEmptyLevel},
+		{[]string{"wwwww", "wfbgw", "wwwww"}, ErrPlayerCount},
+		{[]string{"wwwww", "wjbgl", "wwwww"}, ErrPlayerCount},
+		{[]string{"wwwww", "wjbxw", "wwwww"}, ErrUnknownChar},
+	}
+
+	for _, c := range cases {
+		board, err := NewBoard(c.data)
+		assert.Nil(t, board)
+
+		var le *LevelError
+		assert.True(t, errors.As(err, &le), "%v", c.data)
+		assert.True(t, errors.Is(err, c.err), "%v", c.data)
+	}
+
+	_, err := NewBoardFromXSB([]string{"#####", "#@$.#", "##w##"})
+	assert.True(t, errors.Is(err, ErrUnknownChar))
+}
+
+func TestMoveOffBoard(t *testing.T) {
+	// No walls around the board
+	board, err := NewBoard([]string{"jbg", "fff"})
+	assert.NoError(t, err)
+
+	board.MoveUp()
+	board.MoveLeft()
+	r, c := board.Player()
+	assert.Equal(t, 0, r)
+	assert.Equal(t, 0, c)
+	assert.Equal(t, 0, board.Moves())
+
+	board.MoveRight()
+	assert.True(t, board.IsVictory())
+	board.MoveRight()
+	assert.Equal(t, 1, board.Moves(), "The block can't be pushed off the board")
+
+	_, err = board.Get(-1, 0)
+	assert.Equal(t, ErrOutOfBounds, err)
+	assert.False(t, board.IsGoal(5, 5))
+}
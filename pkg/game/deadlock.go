@@ -0,0 +1,183 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package game
+
+// A block is deadlocked when it can never be pushed onto a goal. That
+// happens when it's on a dead square, a cell from which no goal can be
+// reached, or when it's frozen away from a goal, with walls and other
+// frozen blocks keeping it from moving in any direction.
+
+// IsDeadSquare tells whether a block on position (row, col) could never
+// be pushed onto a goal, even if there were no other blocks on the board.
+// Positions outside the board aren't dead squares.
+func (b *Board) IsDeadSquare(row, col int) bool {
+	return b.inBounds(row, col) && b.dead[row][col]
+}
+
+// DeadlockedBlocks returns the blocks that can never be pushed onto a
+// goal anymore, be it because they're on a dead square, or because
+// they're frozen away from a goal.
+func (b *Board) DeadlockedBlocks() []Cell {
+	return b.deadlocked
+}
+
+// IsDeadlocked tells whether the level can no longer be solved, because
+// too many blocks are deadlocked to cover every goal.
+func (b *Board) IsDeadlocked() bool {
+	blocks, goals := 0, 0
+	for row := 0; row < b.height; row++ {
+		for col := 0; col < b.width; col++ {
+			if b.IsBlock(row, col) {
+				blocks++
+			}
+			if b.IsGoal(row, col) {
+				goals++
+			}
+		}
+	}
+
+	return blocks-len(b.deadlocked) < goals
+}
+
+// findDeadSquares walks backwards from every goal, pulling a block
+// around: a block can be pushed from a cell into the next one if
+// neither of them, nor the cell behind the first one (where the player
+// stands), is a wall. Cells that a block can't be pulled to are dead.
+func (b *Board) findDeadSquares() [][]bool {
+	dead := make([][]bool, b.height)
+	var queue []Cell
+
+	for row := range dead {
+		dead[row] = make([]bool, b.width)
+		for col := range dead[row] {
+			dead[row][col] = !b.IsGoal(row, col)
+			if b.IsGoal(row, col) {
+				queue = append(queue, Cell{row, col})
+			}
+		}
+	}
+
+	for len(queue) > 0 {
+		cell := queue[0]
+		queue = queue[1:]
+
+		for _, d := range []Direction{Up, Down, Left, Right} {
+			fRow, fCol := step(cell.Row, cell.Col, d.opposite())
+			pRow, pCol := step(fRow, fCol, d.opposite())
+			if b.isWallAt(fRow, fCol) || b.isWallAt(pRow, pCol) || !dead[fRow][fCol] {
+				continue
+			}
+
+			dead[fRow][fCol] = false
+			queue = append(queue, Cell{fRow, fCol})
+		}
+	}
+
+	return dead
+}
+
+// findDeadlocks returns the blocks that are either on a dead square, or
+// frozen away from a goal.
+func (b *Board) findDeadlocks() []Cell {
+	var res []Cell
+
+	for row := 0; row < b.height; row++ {
+		for col := 0; col < b.width; col++ {
+			if !b.IsBlock(row, col) || b.IsGoal(row, col) {
+				continue
+			}
+
+			if b.dead[row][col] || b.isFrozen(row, col) {
+				res = append(res, Cell{row, col})
+			}
+		}
+	}
+
+	return res
+}
+
+// axis identifies a block being checked along a direction, to avoid
+// going around in circles.
+type axis struct {
+	cell     Cell
+	vertical bool
+}
+
+func (b *Board) isFrozen(row, col int) bool {
+	checking := make(map[axis]bool)
+	return b.isBlockedAlong(row, col, Up, checking) && b.isBlockedAlong(row, col, Left, checking)
+}
+
+// isBlockedAlong tells whether the block on (row, col) can never be
+// pushed along the axis of direction d.
+//
+// Since a line of blocks is pushed all at once, a block is only stuck
+// when the line it's in, along the axis, ends at a wall on one side
+// and every block between it and the wall is stuck across the axis.
+// Then it can't be pushed towards the wall, and it can't be pushed away
+// from it either, since the player can't get behind it.
+//
+// A block whose check depends on itself is considered free to move, so
+// that deadlocks are never reported for positions that aren't.
+func (b *Board) isBlockedAlong(row, col int, d Direction, checking map[axis]bool) bool {
+	vertical := d == Up || d == Down
+	key := axis{Cell{row, col}, vertical}
+	if checking[key] {
+		return false
+	}
+	checking[key] = true
+	defer delete(checking, key)
+
+	across := Left
+	if !vertical {
+		across = Up
+	}
+
+	for _, dir := range []Direction{d, d.opposite()} {
+		r, c := step(row, col, dir)
+		stuck := true
+		for !b.isWallAt(r, c) && b.IsBlock(r, c) {
+			if !b.isBlockedAlong(r, c, across, checking) {
+				stuck = false
+				break
+			}
+			r, c = step(r, c, dir)
+		}
+
+		if stuck && b.isWallAt(r, c) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isWallAt tells whether there's a wall on (row, col), taking anything
+// beyond the bounds of the board as a wall.
+func (b *Board) isWallAt(row, col int) bool {
+	if row < 0 || row >= b.height || col < 0 || col >= b.width {
+		return true
+	}
+
+	return b.IsWall(row, col)
+}
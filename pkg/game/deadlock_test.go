@@ -0,0 +1,111 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package game
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeadSquares(t *testing.T) {
+	board, err := NewBoardFromXSB([]string{
+		"#######",
+		"#     #",
+		"# $ . #",
+		"#@    #",
+		"#######",
+	})
+	assert.NoError(t, err)
+
+	assert.True(t, board.IsDeadSquare(1, 1), "Corners should be dead")
+	assert.True(t, board.IsDeadSquare(1, 3), "Walls without goals should be dead")
+	assert.True(t, board.IsDeadSquare(3, 5))
+	assert.False(t, board.IsDeadSquare(-1, 0), "Positions outside the board shouldn't be dead")
+	assert.False(t, board.IsDeadSquare(2, 2))
+	assert.False(t, board.IsDeadSquare(2, 4), "Goals are never dead")
+	assert.False(t, board.IsDeadlocked())
+}
+
+func TestPushIntoDeadSquare(t *testing.T) {
+	board, err := NewBoardFromXSB([]string{
+		"######",
+		"#    #",
+		"#@$ .#",
+		"#    #",
+		"######",
+	})
+	assert.NoError(t, err)
+
+	board.MoveDown()
+	board.MoveRight()
+	board.MoveUp()
+	assert.Equal(t, []Cell{{1, 2}}, board.DeadlockedBlocks())
+	assert.True(t, board.IsDeadlocked())
+
+	board.Undo()
+	assert.Empty(t, board.DeadlockedBlocks())
+	assert.False(t, board.IsDeadlocked())
+}
+
+func TestLineOfBlocksAgainstWall(t *testing.T) {
+	// Both blocks can still be pushed to the right all at once
+	board, err := NewBoardFromXSB([]string{
+		"#######",
+		"# $$..#",
+		"#@    #",
+		"#######",
+	})
+	assert.NoError(t, err)
+
+	assert.Empty(t, board.DeadlockedBlocks())
+	assert.False(t, board.IsDeadlocked())
+}
+
+func TestFrozenBlock(t *testing.T) {
+	board, err := NewBoardFromXSB([]string{
+		"########",
+		"##*$  .#",
+		"# @    #",
+		"########",
+	})
+	assert.NoError(t, err)
+
+	assert.False(t, board.IsDeadSquare(1, 3))
+	assert.Equal(t, []Cell{{1, 3}}, board.DeadlockedBlocks())
+	assert.True(t, board.IsDeadlocked())
+}
+
+func TestDeadlockWithSpareBlocks(t *testing.T) {
+	board, err := NewBoardFromXSB([]string{
+		"######",
+		"#$   #",
+		"# $ .#",
+		"#@   #",
+		"######",
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []Cell{{1, 1}}, board.DeadlockedBlocks())
+	assert.False(t, board.IsDeadlocked(), "The other block can still reach the goal")
+}
@@ -0,0 +1,139 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package game
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+var (
+	ErrInvalidMove    = errors.New("Invalid move character")
+	ErrBlocked        = errors.New("Move is blocked")
+	ErrUnexpectedPush = errors.New("Move pushes a block, but is not a push")
+	ErrMissingPush    = errors.New("Push doesn't move any block")
+)
+
+// ReplayError is returned by Replay when a move can't be applied.
+type ReplayError struct {
+	Step int  // Number of moves successfully applied before this one
+	Move rune // The offending move
+	Err  error
+}
+
+func (e *ReplayError) Error() string {
+	return fmt.Sprintf("move %d (%c): %v", e.Step+1, e.Move, e.Err)
+}
+
+func (e *ReplayError) Unwrap() error {
+	return e.Err
+}
+
+// LURD returns the moves made so far in LURD notation, where each
+// step is one of the letters l, u, r or d (left, up, right, down).
+// Steps that push a block are written in uppercase. Undone moves
+// are not included.
+func (b *Board) LURD() string {
+	var sb strings.Builder
+	for _, m := range b.history {
+		sb.WriteRune(m.lurd())
+	}
+
+	return sb.String()
+}
+
+func (m move) lurd() rune {
+	var c rune
+	switch m.d {
+	case Up:
+		c = 'u'
+	case Down:
+		c = 'd'
+	case Left:
+		c = 'l'
+	case Right:
+		c = 'r'
+	}
+
+	if m.pushed > 0 {
+		c = unicode.ToUpper(c)
+	}
+
+	return c
+}
+
+// parseLURD returns the direction of a LURD move and whether it
+// is supposed to be a push.
+func parseLURD(c rune) (Direction, bool, error) {
+	switch unicode.ToLower(c) {
+	case 'u':
+		return Up, unicode.IsUpper(c), nil
+	case 'd':
+		return Down, unicode.IsUpper(c), nil
+	case 'l':
+		return Left, unicode.IsUpper(c), nil
+	case 'r':
+		return Right, unicode.IsUpper(c), nil
+	}
+
+	return Up, false, ErrInvalidMove
+}
+
+// Replay applies the moves in LURD notation to the board, starting
+// from its current state, and tells whether the board ends up solved.
+// Whitespace between moves is ignored. If a move can't be applied,
+// a *ReplayError is returned and the board keeps the moves that
+// came before it.
+func Replay(b *Board, moves string) (bool, error) {
+	applied := 0
+	for _, c := range moves {
+		if unicode.IsSpace(c) {
+			continue
+		}
+
+		d, push, err := parseLURD(c)
+		if err != nil {
+			return false, &ReplayError{Step: applied, Move: c, Err: err}
+		}
+
+		if err := b.movePlayer(d); err != nil {
+			return false, &ReplayError{Step: applied, Move: c, Err: ErrBlocked}
+		}
+
+		if pushed := b.history[len(b.history)-1].pushed > 0; pushed != push {
+			b.Undo()
+			b.undone = nil
+			err := ErrUnexpectedPush
+			if push {
+				err = ErrMissingPush
+			}
+			return false, &ReplayError{Step: applied, Move: c, Err: err}
+		}
+
+		applied++
+	}
+
+	return b.IsVictory(), nil
+}
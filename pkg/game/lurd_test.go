@@ -0,0 +1,106 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package game
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLURD(t *testing.T) {
+	data := []string{
+		"wwwwww",
+		"wffffw",
+		"wlbfgw",
+		"wwwwww",
+	}
+
+	board, err := NewBoard(data)
+	assert.NoError(t, err)
+	board.MoveUp()
+	board.MoveDown()
+	board.MoveRight()
+	board.MoveRight()
+	assert.Equal(t, "udRR", board.LURD())
+
+	board.Undo()
+	assert.Equal(t, "udR", board.LURD())
+
+	board.Reset()
+	assert.Equal(t, "", board.LURD())
+}
+
+func TestReplay(t *testing.T) {
+	data := []string{
+		"wwwwww",
+		"wffffw",
+		"wlbfgw",
+		"wwwwww",
+	}
+
+	board, err := NewBoard(data)
+	assert.NoError(t, err)
+	solved, err := Replay(board, "ud RR")
+	assert.NoError(t, err)
+	assert.True(t, solved)
+	assert.Equal(t, "udRR", board.LURD())
+
+	board.Reset()
+	solved, err = Replay(board, "ud")
+	assert.NoError(t, err)
+	assert.False(t, solved)
+}
+
+func TestReplayErrors(t *testing.T) {
+	data := []string{
+		"wwwwww",
+		"wffffw",
+		"wlbfgw",
+		"wwwwww",
+	}
+
+	tests := []struct {
+		moves string
+		step  int
+		err   error
+	}{
+		{"uX", 1, ErrInvalidMove},
+		{"uu", 1, ErrBlocked},
+		{"r", 0, ErrUnexpectedPush},
+		{"U", 0, ErrMissingPush},
+	}
+
+	for _, tt := range tests {
+		board, err := NewBoard(data)
+		assert.NoError(t, err)
+		_, err = Replay(board, tt.moves)
+
+		var re *ReplayError
+		assert.True(t, errors.As(err, &re), tt.moves)
+		assert.Equal(t, tt.step, re.Step, tt.moves)
+		assert.True(t, errors.Is(err, tt.err), tt.moves)
+		assert.Equal(t, tt.moves[:tt.step], board.LURD(), tt.moves)
+	}
+}
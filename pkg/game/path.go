@@ -0,0 +1,182 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package game
+
+// Reachable returns a matrix, with the same bounds as the board, telling
+// which cells the player can walk to without pushing any block.
+func (b *Board) Reachable() [][]bool {
+	reachable := make([][]bool, b.height)
+	for row := range reachable {
+		reachable[row] = make([]bool, b.width)
+	}
+
+	b.walk(Cell{b.pRow, b.pCol}, b.isWalkableAt, func(cell Cell, _ []Direction) bool {
+		reachable[cell.Row][cell.Col] = true
+		return true
+	})
+
+	return reachable
+}
+
+// PathTo returns the shortest sequence of moves that takes the player to
+// cell (row, col) without pushing any block, and whether there's one.
+func (b *Board) PathTo(row, col int) ([]Direction, bool) {
+	var path []Direction
+	found := false
+
+	b.walk(Cell{b.pRow, b.pCol}, b.isWalkableAt, func(cell Cell, p []Direction) bool {
+		if cell.Row == row && cell.Col == col {
+			path, found = p, true
+			return false
+		}
+		return true
+	})
+
+	return path, found
+}
+
+// walk visits every cell the player can walk to from start, stepping
+// only on cells for which free returns true, closest ones first, along
+// with the path that leads there. It stops as soon as visit returns
+// false.
+func (b *Board) walk(start Cell, free func(row, col int) bool, visit func(Cell, []Direction) bool) {
+	paths := map[Cell][]Direction{start: nil}
+	queue := []Cell{start}
+
+	for len(queue) > 0 {
+		cell := queue[0]
+		queue = queue[1:]
+
+		if !visit(cell, paths[cell]) {
+			return
+		}
+
+		for _, d := range []Direction{Up, Down, Left, Right} {
+			row, col := step(cell.Row, cell.Col, d)
+			next := Cell{row, col}
+			if _, seen := paths[next]; seen || !free(row, col) {
+				continue
+			}
+
+			path := make([]Direction, len(paths[cell]), len(paths[cell])+1)
+			copy(path, paths[cell])
+			paths[next] = append(path, d)
+			queue = append(queue, next)
+		}
+	}
+}
+
+// PushPath returns the sequence of moves with the fewest pushes that
+// takes the block on (row, col) to cell (toRow, toCol), walking the
+// player around it as needed, and whether there's one. Only that block
+// is ever pushed: every other block stays where it is.
+func (b *Board) PushPath(row, col, toRow, toCol int) ([]Direction, bool) {
+	if b.isWallAt(row, col) || !b.IsBlock(row, col) {
+		return nil, false
+	}
+
+	// The search goes over the positions of the block, along with the
+	// position of the player, one push at a time.
+	type state struct {
+		block, player Cell
+	}
+
+	origin := Cell{row, col}
+	start := state{origin, Cell{b.pRow, b.pCol}}
+	paths := map[state][]Direction{start: nil}
+	queue := []state{start}
+
+	for len(queue) > 0 {
+		s := queue[0]
+		queue = queue[1:]
+
+		if s.block.Row == toRow && s.block.Col == toCol {
+			return paths[s], true
+		}
+
+		// The block may be somewhere else by now, leaving its original
+		// cell free to walk on.
+		free := func(r, c int) bool {
+			if r == s.block.Row && c == s.block.Col {
+				return false
+			}
+			return (r == origin.Row && c == origin.Col) || b.isWalkableAt(r, c)
+		}
+
+		walks := make(map[Cell][]Direction)
+		b.walk(s.player, free, func(cell Cell, p []Direction) bool {
+			walks[cell] = p
+			return true
+		})
+
+		for _, d := range []Direction{Up, Down, Left, Right} {
+			pRow, pCol := step(s.block.Row, s.block.Col, d.opposite())
+			nRow, nCol := step(s.block.Row, s.block.Col, d)
+
+			w, ok := walks[Cell{pRow, pCol}]
+			if !ok || !free(nRow, nCol) {
+				continue
+			}
+
+			next := state{Cell{nRow, nCol}, s.block}
+			if _, seen := paths[next]; seen {
+				continue
+			}
+
+			path := make([]Direction, 0, len(paths[s])+len(w)+1)
+			path = append(path, paths[s]...)
+			path = append(path, w...)
+			paths[next] = append(path, d)
+			queue = append(queue, next)
+		}
+	}
+
+	return nil, false
+}
+
+// PushTo pushes the block on (row, col) to cell (toRow, toCol), as
+// found by PushPath, and tells whether it could. The moves are added
+// to the history, so that they can be undone one by one.
+func (b *Board) PushTo(row, col, toRow, toCol int) bool {
+	path, ok := b.PushPath(row, col, toRow, toCol)
+	if !ok {
+		return false
+	}
+
+	for _, d := range path {
+		b.movePlayer(d)
+	}
+
+	return true
+}
+
+// isWalkableAt tells whether the player could step on (row, col), were
+// it next to it. The cell the player is on counts as walkable too.
+func (b *Board) isWalkableAt(row, col int) bool {
+	if b.isWallAt(row, col) {
+		return false
+	}
+
+	elem, _ := b.Get(row, col)
+	return isWalkable(elem) || isPlayer(elem)
+}
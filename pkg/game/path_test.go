@@ -0,0 +1,147 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package game
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReachable(t *testing.T) {
+	board, err := NewBoardFromXSB([]string{
+		"######",
+		"#@ # #",
+		"# $# #",
+		"#  $.#",
+		"######",
+	})
+	assert.NoError(t, err)
+
+	reachable := board.Reachable()
+	assert.True(t, reachable[1][1])
+	assert.True(t, reachable[3][2])
+	assert.False(t, reachable[2][2], "Blocks can't be walked over")
+	assert.False(t, reachable[1][3], "Walls can't be walked over")
+	assert.False(t, reachable[1][4], "Cells behind blocks can't be reached")
+}
+
+func TestPathTo(t *testing.T) {
+	board, err := NewBoardFromXSB([]string{
+		"######",
+		"#@ # #",
+		"# $# #",
+		"#  $.#",
+		"######",
+	})
+	assert.NoError(t, err)
+
+	path, ok := board.PathTo(3, 2)
+	assert.True(t, ok)
+	assert.Len(t, path, 3)
+
+	for _, d := range path {
+		board.Move(d)
+	}
+	r, c := board.Player()
+	assert.Equal(t, 3, r)
+	assert.Equal(t, 2, c)
+	assert.Equal(t, 0, board.Pushes())
+
+	path, ok = board.PathTo(3, 2)
+	assert.True(t, ok, "The player can always stay where it is")
+	assert.Empty(t, path)
+
+	_, ok = board.PathTo(2, 4)
+	assert.False(t, ok)
+}
+
+func TestPushPath(t *testing.T) {
+	board, err := NewBoardFromXSB([]string{
+		"#######",
+		"#@    #",
+		"# $   #",
+		"#   # #",
+		"#    .#",
+		"#######",
+	})
+	assert.NoError(t, err)
+
+	path, ok := board.PushPath(2, 2, 4, 5)
+	assert.True(t, ok)
+
+	pushes := 0
+	for _, d := range path {
+		before := board.Pushes()
+		board.Move(d)
+		pushes += board.Pushes() - before
+	}
+	assert.Equal(t, 5, pushes, "The block should be pushed the least possible")
+	assert.True(t, board.IsVictory())
+
+	_, ok = board.PushPath(4, 5, 1, 1)
+	assert.False(t, ok, "Blocks can't be pulled out of corners")
+
+	_, ok = board.PushPath(1, 1, 2, 2)
+	assert.False(t, ok, "There's no block on (1, 1)")
+}
+
+func TestPushPathAroundPlayer(t *testing.T) {
+	// The block has to go through the cell the player starts on
+	board, err := NewBoardFromXSB([]string{
+		"######",
+		"# $@.#",
+		"#    #",
+		"######",
+	})
+	assert.NoError(t, err)
+
+	path, ok := board.PushPath(1, 2, 1, 4)
+	assert.True(t, ok)
+	assert.Equal(t, []Direction{Down, Left, Left, Up, Right, Right}, path)
+}
+
+func TestPushToOnlyMovesOneBlock(t *testing.T) {
+	board, err := NewBoardFromXSB([]string{
+		"#######",
+		"#@$ $.#",
+		"#    .#",
+		"#######",
+	})
+	assert.NoError(t, err)
+
+	assert.False(t, board.PushTo(1, 2, 1, 4), "The other block is in the way")
+	assert.Equal(t, 0, board.Moves())
+
+	assert.True(t, board.PushTo(1, 4, 1, 5))
+	assert.True(t, board.PushTo(1, 2, 1, 4))
+	assert.True(t, board.IsBlock(1, 4))
+	assert.True(t, board.IsBlock(1, 5))
+	assert.Equal(t, 3, board.Pushes())
+
+	for board.CanUndo() {
+		board.Undo()
+	}
+	assert.True(t, board.IsBlock(1, 2))
+	assert.True(t, board.IsBlock(1, 4))
+}
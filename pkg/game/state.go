@@ -0,0 +1,166 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package game
+
+import (
+	"errors"
+	"sort"
+)
+
+var ErrInvalidState = errors.New("State doesn't fit the board")
+
+// State is the part of a board that changes as the game is played:
+// the position of the player and of every block. Walls and goals never
+// move, so they're left out.
+type State struct {
+	Player Cell
+	Blocks []Cell // Sorted by row, then by column
+}
+
+// NewState creates a state with the player and the blocks on the given
+// positions. The blocks are copied and sorted.
+func NewState(player Cell, blocks []Cell) State {
+	s := State{Player: player, Blocks: make([]Cell, len(blocks))}
+	copy(s.Blocks, blocks)
+	sort.Slice(s.Blocks, func(i, j int) bool {
+		return s.Blocks[i].less(s.Blocks[j])
+	})
+
+	return s
+}
+
+// Clone returns a copy of the state that shares nothing with it.
+func (s State) Clone() State {
+	return NewState(s.Player, s.Blocks)
+}
+
+// Equal tells whether both states have the player and the blocks on
+// the same positions.
+func (s State) Equal(o State) bool {
+	if s.Player != o.Player || len(s.Blocks) != len(o.Blocks) {
+		return false
+	}
+
+	for i := range s.Blocks {
+		if s.Blocks[i] != o.Blocks[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Hash returns a Zobrist hash of the state: each position of the player
+// and of a block has its own random key, and the hash is the XOR of the
+// keys of the state. Equal states have the same hash.
+func (s State) Hash() uint64 {
+	h := zobristKey(playerKey, s.Player)
+	for _, c := range s.Blocks {
+		h ^= zobristKey(blockKey, c)
+	}
+
+	return h
+}
+
+const (
+	playerKey = iota + 1
+	blockKey
+)
+
+// zobristKey returns the random key of a kind of element on a cell.
+// Keys are derived from the cell with splitmix64 rather than kept in a
+// table, so that they're the same for boards of any size.
+func zobristKey(kind uint64, c Cell) uint64 {
+	return splitmix64(kind<<48 ^ uint64(uint32(c.Row))<<24 ^ uint64(uint32(c.Col)))
+}
+
+func splitmix64(x uint64) uint64 {
+	x += 0x9e3779b97f4a7c15
+	x = (x ^ (x >> 30)) * 0xbf58476d1ce4e5b9
+	x = (x ^ (x >> 27)) * 0x94d049bb133111eb
+	return x ^ (x >> 31)
+}
+
+func (c Cell) less(o Cell) bool {
+	return c.Row < o.Row || (c.Row == o.Row && c.Col < o.Col)
+}
+
+// Snapshot returns the current state of the board.
+func (b *Board) Snapshot() State {
+	s := State{Player: Cell{b.pRow, b.pCol}}
+	for row := 0; row < b.height; row++ {
+		for col := 0; col < b.width; col++ {
+			if b.IsBlock(row, col) {
+				s.Blocks = append(s.Blocks, Cell{row, col})
+			}
+		}
+	}
+
+	return s
+}
+
+// Restore puts the player and the blocks on the positions of a state,
+// as if the level had started that way: the history of moves is
+// cleared. It fails if any of them would end up on a wall, out of the
+// board or on top of each other, leaving the board as it was.
+func (b *Board) Restore(s State) error {
+	taken := make(map[Cell]bool)
+	for _, c := range append([]Cell{s.Player}, s.Blocks...) {
+		if taken[c] || b.isWallAt(c.Row, c.Col) {
+			return ErrInvalidState
+		}
+		taken[c] = true
+	}
+
+	facing, _ := b.Get(b.pRow, b.pCol)
+
+	b.goals = 0
+	for row := 0; row < b.height; row++ {
+		for col := 0; col < b.width; col++ {
+			if top, _ := b.Get(row, col); isBlock(top) || isPlayer(top) {
+				b.matrix[row][col].Pop()
+			}
+			if b.IsGoal(row, col) {
+				b.goals++
+			}
+		}
+	}
+
+	for _, c := range s.Blocks {
+		if b.IsGoal(c.Row, c.Col) {
+			b.Put(c.Row, c.Col, 'o')
+			b.goals--
+		} else {
+			b.Put(c.Row, c.Col, 'b')
+		}
+	}
+	b.Put(s.Player.Row, s.Player.Col, facing)
+	b.setPlayerPos(s.Player.Row, s.Player.Col)
+
+	b.deadlocked = b.findDeadlocks()
+	b.pushes = 0
+	b.history = nil
+	b.undone = nil
+
+	return nil
+}
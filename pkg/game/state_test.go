@@ -0,0 +1,103 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package game
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshot(t *testing.T) {
+	board, err := NewBoardFromXSB([]string{
+		"#######",
+		"#@$ * #",
+		"#  $..#",
+		"#######",
+	})
+	assert.NoError(t, err)
+
+	s := board.Snapshot()
+	assert.Equal(t, Cell{1, 1}, s.Player)
+	assert.Equal(t, []Cell{{1, 2}, {1, 4}, {2, 3}}, s.Blocks)
+
+	board.MoveRight()
+	moved := board.Snapshot()
+	assert.False(t, s.Equal(moved))
+	assert.NotEqual(t, s.Hash(), moved.Hash())
+
+	board.Undo()
+	assert.True(t, s.Equal(board.Snapshot()))
+	assert.Equal(t, s.Hash(), board.Snapshot().Hash())
+}
+
+func TestStateCloneAndEqual(t *testing.T) {
+	s := NewState(Cell{1, 1}, []Cell{{2, 3}, {1, 2}})
+	assert.Equal(t, []Cell{{1, 2}, {2, 3}}, s.Blocks, "Blocks should be sorted")
+
+	c := s.Clone()
+	assert.True(t, s.Equal(c))
+	assert.Equal(t, s.Hash(), c.Hash())
+
+	c.Blocks[0] = Cell{3, 3}
+	assert.Equal(t, Cell{1, 2}, s.Blocks[0], "Clones shouldn't share blocks")
+	assert.False(t, s.Equal(c))
+
+	// Swapping the player and a block makes a different state
+	o := NewState(Cell{1, 2}, []Cell{{1, 1}, {2, 3}})
+	assert.False(t, s.Equal(o))
+	assert.NotEqual(t, s.Hash(), o.Hash())
+}
+
+func TestRestore(t *testing.T) {
+	board, err := NewBoardFromXSB([]string{
+		"#######",
+		"#@$ . #",
+		"#     #",
+		"#######",
+	})
+	assert.NoError(t, err)
+
+	s := NewState(Cell{2, 2}, []Cell{{1, 3}})
+	assert.NoError(t, board.Restore(s))
+	assert.True(t, s.Equal(board.Snapshot()))
+	assert.Equal(t, 0, board.Moves())
+	assert.False(t, board.CanUndo())
+
+	board.MoveUp()
+	assert.False(t, board.IsVictory())
+	board.MoveRight()
+	assert.True(t, board.IsVictory())
+
+	assert.Equal(t, ErrInvalidState, board.Restore(NewState(Cell{0, 0}, nil)))
+	assert.Equal(t, ErrInvalidState, board.Restore(NewState(Cell{1, 1}, []Cell{{1, 1}})))
+	assert.Equal(t, ErrInvalidState, board.Restore(NewState(Cell{1, 1}, []Cell{{9, 9}})))
+	assert.True(t, board.IsVictory(), "Failed restores shouldn't change the board")
+
+	board.Reset()
+	r, c := board.Player()
+	assert.Equal(t, 1, r)
+	assert.Equal(t, 1, c)
+	assert.True(t, board.IsBlock(1, 2))
+	assert.False(t, board.IsVictory())
+}
@@ -0,0 +1,176 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package game
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrEmptyLevel       = errors.New("Level has no rows")
+	ErrRaggedRows       = errors.New("Row has a different length")
+	ErrUnknownChar      = errors.New("Unknown character")
+	ErrPlayerCount      = errors.New("Level must have exactly one player")
+	ErrBlockGoalCount   = errors.New("Number of blocks and goals doesn't match")
+	ErrPlayerEscapes    = errors.New("Player can walk off the board")
+	ErrUnreachableBlock = errors.New("Block can't be reached by the player")
+)
+
+// Characters allowed in the format used by NewBoard.
+const internalChars = "wfbgohjkl"
+
+// LevelError is a problem found on a level by Validate.
+type LevelError struct {
+	Row, Col int // Position of the problem, or -1 if it isn't about a row or column
+	Err      error
+}
+
+func (e *LevelError) Error() string {
+	switch {
+	case e.Row < 0:
+		return e.Err.Error()
+	case e.Col < 0:
+		return fmt.Sprintf("row %d: %v", e.Row+1, e.Err)
+	default:
+		return fmt.Sprintf("row %d, col %d: %v", e.Row+1, e.Col+1, e.Err)
+	}
+}
+
+func (e *LevelError) Unwrap() error {
+	return e.Err
+}
+
+// Validate checks a level, in XSB or in the format used by NewBoard,
+// and returns every problem found on it, or nil if there are none:
+//
+//   - rows of different lengths, which only XSB allows
+//   - characters that aren't part of the format
+//   - no player, or more than one
+//   - a different number of blocks and goals
+//   - a way for the player to walk off the board
+//   - blocks the player can't get to, even pushing other blocks around
+func Validate(data []string) []*LevelError {
+	if len(data) == 0 {
+		return []*LevelError{{-1, -1, ErrEmptyLevel}}
+	}
+
+	var errs []*LevelError
+	report := func(row, col int, err error) {
+		errs = append(errs, &LevelError{row, col, err})
+	}
+
+	xsb := IsXSB(data)
+	decode := decodeCell
+	if xsb {
+		decode = decodeXSB
+	}
+
+	width := len([]rune(data[0]))
+	for row, line := range data {
+		cells := []rune(line)
+		if !xsb && len(cells) != width {
+			report(row, -1, fmt.Errorf("%w: %d cells instead of %d", ErrRaggedRows, len(cells), width))
+		}
+
+		for col, c := range cells {
+			if _, ok := decode(c); !ok {
+				report(row, col, fmt.Errorf("%w %q", ErrUnknownChar, c))
+			}
+		}
+	}
+
+	b, players := layoutBoard(data, decode)
+
+	blocks, goals := 0, 0
+	for row := 0; row < b.height; row++ {
+		for col := 0; col < b.width; col++ {
+			if b.IsBlock(row, col) {
+				blocks++
+			}
+			if b.IsGoal(row, col) {
+				goals++
+			}
+		}
+	}
+
+	if players != 1 {
+		report(-1, -1, fmt.Errorf("%w, found %d", ErrPlayerCount, players))
+	}
+	if blocks != goals {
+		report(-1, -1, fmt.Errorf("%w: %d blocks and %d goals", ErrBlockGoalCount, blocks, goals))
+	}
+	if players == 1 {
+		errs = append(errs, b.checkReach()...)
+	}
+
+	return errs
+}
+
+// checkReach goes over every cell the player could get to, were it
+// able to push any block out of the way, looking for ways off the board
+// and for blocks out of reach.
+func (b *Board) checkReach() []*LevelError {
+	var errs []*LevelError
+
+	reached := make([][]bool, b.height)
+	for row := range reached {
+		reached[row] = make([]bool, b.width)
+	}
+
+	start := Cell{b.pRow, b.pCol}
+	reached[start.Row][start.Col] = true
+	queue := []Cell{start}
+	escaped := false
+
+	for len(queue) > 0 {
+		cell := queue[0]
+		queue = queue[1:]
+
+		edge := cell.Row == 0 || cell.Row == b.height-1 || cell.Col == 0 || cell.Col == b.width-1
+		if edge && !escaped {
+			errs = append(errs, &LevelError{cell.Row, cell.Col, ErrPlayerEscapes})
+			escaped = true
+		}
+
+		for _, d := range []Direction{Up, Down, Left, Right} {
+			row, col := step(cell.Row, cell.Col, d)
+			if b.isWallAt(row, col) || reached[row][col] {
+				continue
+			}
+
+			reached[row][col] = true
+			queue = append(queue, Cell{row, col})
+		}
+	}
+
+	for row := 0; row < b.height; row++ {
+		for col := 0; col < b.width; col++ {
+			if b.IsBlock(row, col) && !reached[row][col] {
+				errs = append(errs, &LevelError{row, col, ErrUnreachableBlock})
+			}
+		}
+	}
+
+	return errs
+}
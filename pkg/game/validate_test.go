@@ -0,0 +1,110 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package game
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	assert.Empty(t, Validate([]string{
+		"wwwww",
+		"wjbgw",
+		"wwwww",
+	}))
+
+	assert.Empty(t, Validate([]string{
+		"  ####",
+		"###  #",
+		"#@$. #",
+		"######",
+	}), "XSB rows can have different lengths")
+}
+
+func TestValidateProblems(t *testing.T) {
+	cases := []struct {
+		name string
+		data []string
+		want []*LevelError
+	}{
+		{
+			"empty",
+			nil,
+			[]*LevelError{{-1, -1, ErrEmptyLevel}},
+		},
+		{
+			"ragged rows",
+			[]string{"wwwww", "wjbgw", "wwww"},
+			[]*LevelError{{2, -1, ErrRaggedRows}},
+		},
+		{
+			"unknown character",
+			[]string{"wwwww", "wjbgw", "wwxww"},
+			[]*LevelError{{2, 2, ErrUnknownChar}, {2, 2, ErrPlayerEscapes}},
+		},
+		{
+			"no player",
+			[]string{"#####", "# $.#", "#####"},
+			[]*LevelError{{-1, -1, ErrPlayerCount}},
+		},
+		{
+			"two players",
+			[]string{"######", "#@$.@#", "######"},
+			[]*LevelError{{-1, -1, ErrPlayerCount}},
+		},
+		{
+			"more blocks than goals",
+			[]string{"######", "#@$$.#", "######"},
+			[]*LevelError{{-1, -1, ErrBlockGoalCount}},
+		},
+		{
+			"open walls",
+			[]string{"#####", "#@$. ", "#####"},
+			[]*LevelError{{1, 4, ErrPlayerEscapes}},
+		},
+		{
+			"unreachable block",
+			[]string{"#######", "#@$.#$#", "#  .###", "#######"},
+			[]*LevelError{{1, 5, ErrUnreachableBlock}},
+		},
+	}
+
+	for _, c := range cases {
+		errs := Validate(c.data)
+		assert.Len(t, errs, len(c.want), c.name)
+		for i := 0; i < len(errs) && i < len(c.want); i++ {
+			assert.Equal(t, c.want[i].Row, errs[i].Row, c.name)
+			assert.Equal(t, c.want[i].Col, errs[i].Col, c.name)
+			assert.True(t, errors.Is(errs[i], c.want[i].Err), "%s: %v", c.name, errs[i])
+		}
+	}
+}
+
+func TestLevelError(t *testing.T) {
+	assert.Equal(t, "Player can walk off the board", (&LevelError{-1, -1, ErrPlayerEscapes}).Error())
+	assert.Equal(t, "row 2: Row has a different length", (&LevelError{1, -1, ErrRaggedRows}).Error())
+	assert.Equal(t, "row 2, col 5: Player can walk off the board", (&LevelError{1, 4, ErrPlayerEscapes}).Error())
+}
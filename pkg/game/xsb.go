@@ -0,0 +1,113 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package game
+
+import (
+	"strings"
+)
+
+// xsbCells maps each character of the XSB format to the elements
+// stacked on top of the floor of the corresponding cell.
+var xsbCells = map[rune][]rune{
+	'#': {'w'},
+	'$': {'b'},
+	'.': {'g'},
+	'*': {'g', 'o'},
+	'@': {'j'},
+	'+': {'g', 'j'},
+	' ': nil,
+	'-': nil,
+	'_': nil,
+}
+
+// NewBoardFromXSB generates a new Board from a level in the standard
+// XSB format, where each character represents an element on the board.
+//
+// Types of characters:
+//  # - wall
+//  $ - box
+//  . - goal
+//  * - box on a goal
+//  @ - player
+//  + - player on a goal
+//  space, - or _ - floor
+//
+// Rows don't need to have the same length. It fails just like NewBoard
+// does.
+func NewBoardFromXSB(data []string) (*Board, error) {
+	return newBoard(data, decodeXSB)
+}
+
+func decodeXSB(c rune) ([]rune, bool) {
+	elems, ok := xsbCells[c]
+	return elems, ok
+}
+
+// IsXSB tells whether a level is written in the XSB format, as
+// opposed to the format used by NewBoard.
+func IsXSB(data []string) bool {
+	for _, line := range data {
+		if strings.ContainsAny(line, "#$.*@+") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// XSB returns the current state of the board in the XSB format.
+// Trailing floor on each row is left out.
+func (b *Board) XSB() []string {
+	res := make([]string, b.height)
+
+	for row := 0; row < b.height; row++ {
+		var sb strings.Builder
+		for col := 0; col < b.width; col++ {
+			sb.WriteRune(b.xsbCell(row, col))
+		}
+		res[row] = strings.TrimRight(sb.String(), " ")
+	}
+
+	return res
+}
+
+func (b *Board) xsbCell(row, col int) rune {
+	elem, _ := b.Get(row, col)
+
+	switch {
+	case elem == 'w':
+		return '#'
+	case elem == 'b':
+		return '$'
+	case elem == 'o':
+		return '*'
+	case isGoal(elem):
+		return '.'
+	case isPlayer(elem) && b.matrix[row][col].Contains('g'):
+		return '+'
+	case isPlayer(elem):
+		return '@'
+	}
+
+	return ' '
+}
@@ -0,0 +1,95 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package game
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewBoardFromXSB(t *testing.T) {
+	data := []string{
+		"  #####",
+		"###   #",
+		"#.@$  #",
+		"### $.#",
+		"#.##$ #",
+		"# # . ##",
+		"#$ *$$.#",
+		"#   .  #",
+		"########",
+	}
+
+	board, err := NewBoardFromXSB(data)
+	assert.NoError(t, err)
+	w, h := board.Bounds()
+	assert.Equal(t, 8, w, "Width should be the length of the longest row")
+	assert.Equal(t, 9, h)
+
+	v, _ := board.Get(0, 0)
+	assert.True(t, isFloor(v))
+	v, _ = board.Get(0, 7)
+	assert.True(t, isFloor(v), "Short rows should be padded with floor")
+	v, _ = board.Get(2, 1)
+	assert.Equal(t, 'g', v)
+	v, _ = board.Get(2, 3)
+	assert.Equal(t, 'b', v)
+	v, _ = board.Get(6, 3)
+	assert.Equal(t, 'o', v)
+
+	r, c := board.findPlayer()
+	assert.Equal(t, 2, r)
+	assert.Equal(t, 2, c)
+
+	assert.Equal(t, 6, board.goals)
+	assert.Equal(t, data, board.XSB())
+}
+
+func TestXSBPlayerOnGoal(t *testing.T) {
+	data := []string{
+		"#####",
+		"#+$.#",
+		"#####",
+	}
+
+	board, err := NewBoardFromXSB(data)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, board.goals)
+
+	board.MoveRight()
+	assert.Equal(t, []string{
+		"#####",
+		"#.@*#",
+		"#####",
+	}, board.XSB())
+	assert.False(t, board.IsVictory())
+
+	board.Reset()
+	assert.Equal(t, data, board.XSB())
+}
+
+func TestIsXSB(t *testing.T) {
+	assert.True(t, IsXSB([]string{"#####", "#@$.#", "#####"}))
+	assert.False(t, IsXSB([]string{"wwwww", "wjbgw", "wwwww"}))
+}
@@ -0,0 +1,221 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package levels
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// Collection is a set of levels read from a file, along with the
+// details given about it at the top of the file, before any level.
+type Collection struct {
+	Title   string
+	Author  string
+	Comment string
+	Levels  []*Level
+}
+
+// Level is a level of a collection, along with the details given
+// about it.
+type Level struct {
+	ID      int // Position of the level in its collection, starting at 1
+	Title   string
+	Author  string
+	Comment string
+	Lines   []string // The board, as written in the file
+	Source  string   // File the level was read from, if known
+	Line    int      // Line of the file where the board starts, starting at 1
+}
+
+// ParseCollection reads a collection of levels from r, following the
+// conventions of .sok and .txt files:
+//
+// - Levels are separated by blank lines, and can be either in XSB or in
+// the format used by game.NewBoard.
+// - Lines like `Title: ...` and `Author: ...` give the title and author
+// of the level right above them, or of the collection itself when
+// they come before any level.
+// - `Comment:` on a line of its own starts a comment block, which may
+// span several lines, blank ones included, and goes on until
+// `Comment-End:`.
+// - A single line right on top of a level, like `; Level 1`, is the
+// title of that level.
+// - Any other text, including lines starting with a `;`, is a comment.
+func ParseCollection(r io.Reader) (*Collection, error) {
+	c := &Collection{}
+
+	var (
+		level     *Level   // Level whose board is being read
+		pending   []string // Text read since the last blank line
+		inComment bool     // Whether pending ends in a comment block
+	)
+
+	// Text goes to the last level read, or to the collection if there
+	// isn't any yet.
+	flush := func() {
+		if len(c.Levels) == 0 {
+			describe(&c.Title, &c.Author, &c.Comment, pending, false)
+		} else {
+			last := c.Levels[len(c.Levels)-1]
+			describe(&last.Title, &last.Author, &last.Comment, pending, false)
+		}
+		pending = nil
+	}
+
+	lineNo := 0
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lineNo++
+
+		// Leading spaces are meaningful in XSB levels, so only
+		// trailing ones are trimmed for now.
+		line := strings.TrimRight(scanner.Text(), " \t\r")
+
+		switch {
+		case inComment:
+			pending = append(pending, line)
+			inComment = !isCommentEnd(line)
+
+		case isBoardLine(line, level == nil):
+			if level == nil {
+				level = &Level{ID: len(c.Levels) + 1, Line: lineNo}
+				describe(&level.Title, &level.Author, &level.Comment, pending, true)
+				pending = nil
+				c.Levels = append(c.Levels, level)
+			}
+			level.Lines = append(level.Lines, line)
+
+		case len(strings.TrimSpace(line)) == 0:
+			level = nil
+			flush()
+
+		default:
+			if level != nil {
+				level = nil
+				flush()
+			}
+			pending = append(pending, line)
+			key, value, _ := splitKey(line)
+			inComment = key == "comment" && value == ""
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return c, &Error{Line: lineNo + 1, Err: err}
+	}
+	flush()
+
+	for _, level := range c.Levels {
+		level.Lines = trimLevel(level.Lines)
+	}
+
+	return c, nil
+}
+
+// describe fills the title, author and comment from the given lines of
+// text. If asked to, a single line is taken as the title.
+func describe(title, author, comment *string, lines []string, titled bool) {
+	var comments []string
+	inComment := false
+
+	for _, line := range lines {
+		if inComment {
+			if isCommentEnd(line) {
+				inComment = false
+			} else {
+				comments = append(comments, line)
+			}
+			continue
+		}
+
+		key, value, ok := splitKey(line)
+		switch {
+		case ok && key == "title":
+			*title = value
+		case ok && key == "author":
+			*author = value
+		case ok && key == "comment":
+			inComment = value == ""
+			if value != "" {
+				comments = append(comments, value)
+			}
+		case titled && len(lines) == 1:
+			*title = textOf(line)
+		default:
+			comments = append(comments, textOf(line))
+		}
+	}
+
+	if len(comments) > 0 {
+		if *comment != "" {
+			*comment += "\n"
+		}
+		*comment += strings.Join(comments, "\n")
+	}
+}
+
+// textOf returns the text of a line, without the `;` marking it as a
+// comment.
+func textOf(line string) string {
+	return strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), ";"))
+}
+
+// splitKey splits lines like `Title: Some title` into their lowercase
+// key and value.
+func splitKey(line string) (string, string, bool) {
+	text := textOf(line)
+	i := strings.Index(text, ":")
+	if i <= 0 || strings.ContainsAny(text[:i], " \t") {
+		return "", "", false
+	}
+
+	return strings.ToLower(text[:i]), strings.TrimSpace(text[i+1:]), true
+}
+
+func isCommentEnd(line string) bool {
+	key, _, ok := splitKey(line)
+	return ok && (key == "comment-end" || key == "comment_end")
+}
+
+// isBoardLine tells whether line is a row of a level, in XSB or in the
+// format used by game.NewBoard. Rows in the latter are made of lowercase
+// letters, so they must be walled on both ends, and the first row of a
+// level must be all walls, not to be mistaken for words.
+func isBoardLine(line string, first bool) bool {
+	row := strings.TrimSpace(line)
+	if len(row) == 0 {
+		return false
+	}
+
+	xsb := strings.Trim(row, "#@+$*.-_ ") == "" && strings.Contains(row, "#")
+
+	own := strings.Trim(row, "wfbgohjkl") == "" &&
+		row[0] == 'w' && row[len(row)-1] == 'w'
+	if first {
+		own = strings.Trim(row, "w") == ""
+	}
+
+	return xsb || own
+}
@@ -0,0 +1,113 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package levels
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCollection(t *testing.T) {
+	data := `Title: Tiny levels
+Author: Someone
+A couple of levels to try things out.
+
+; First one
+#####
+#@$.#
+#####
+
+  ####
+###  #
+#@$. #
+######
+Title: Second one
+Author: Someone else
+Comment:
+Push the block to the right.
+
+Then you're done.
+Comment-End:
+`
+
+	c, err := ParseCollection(strings.NewReader(data))
+	assert.NoError(t, err)
+	assert.Equal(t, "Tiny levels", c.Title)
+	assert.Equal(t, "Someone", c.Author)
+	assert.Equal(t, "A couple of levels to try things out.", c.Comment)
+	assert.Len(t, c.Levels, 2)
+
+	assert.Equal(t, &Level{
+		ID:    1,
+		Title: "First one",
+		Lines: []string{"#####", "#@$.#", "#####"},
+		Line:  6,
+	}, c.Levels[0])
+
+	assert.Equal(t, &Level{
+		ID:      2,
+		Title:   "Second one",
+		Author:  "Someone else",
+		Comment: "Push the block to the right.\n\nThen you're done.",
+		Lines:   []string{"  ####", "###  #", "#@$. #", "######"},
+		Line:    10,
+	}, c.Levels[1])
+}
+
+func TestParseCollectionComments(t *testing.T) {
+	data := `wwwww
+wjbgw
+wwwww
+; Solved in one move
+Comment: Too easy
+
+wwwwww
+wjbfgw
+wwwwww
+`
+
+	c, err := ParseCollection(strings.NewReader(data))
+	assert.NoError(t, err)
+	assert.Len(t, c.Levels, 2)
+	assert.Empty(t, c.Levels[0].Title)
+	assert.Equal(t, "Solved in one move\nToo easy", c.Levels[0].Comment)
+	assert.Equal(t, []string{"wwwwww", "wjbfgw", "wwwwww"}, c.Levels[1].Lines)
+}
+
+func TestParseCollectionWords(t *testing.T) {
+	data := `flow
+wow
+wwwww
+wjbgw
+wwwww
+`
+
+	c, err := ParseCollection(strings.NewReader(data))
+	assert.NoError(t, err)
+	assert.Len(t, c.Levels, 1, "Words shouldn't be taken for levels")
+	assert.Equal(t, "flow\nwow", c.Levels[0].Comment)
+	assert.Equal(t, []string{"wwwww", "wjbgw", "wwwww"}, c.Levels[0].Lines)
+	assert.Equal(t, 3, c.Levels[0].Line)
+}
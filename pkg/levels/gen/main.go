@@ -0,0 +1,49 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Command gen copies the levels that come with the game into the levels
+// package, so that they're built into every binary that uses it. It's
+// run by go generate, from the directory of the levels package.
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+)
+
+const (
+	source = "../../assets/levels/levels.dat"
+	output = "embedded_levels.go"
+)
+
+func main() {
+	data, err := ioutil.ReadFile(source)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	code := fmt.Sprintf("// Code generated by gen from assets/levels/levels.dat; DO NOT EDIT.\n\npackage levels\n\nconst embeddedLevels = %q\n", data)
+	if err := ioutil.WriteFile(output, []byte(code), 0644); err != nil {
+		log.Fatal(err)
+	}
+}
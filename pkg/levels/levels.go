@@ -0,0 +1,117 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package levels reads collections of levels, in any of the formats
+// supported by the game.
+package levels
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/csixteen/sokoban/pkg/game"
+)
+
+// Parse reads all the levels from r, as ParseCollection does, leaving
+// out everything but the lines of their boards.
+func Parse(r io.Reader) ([][]string, error) {
+	c, err := ParseCollection(r)
+
+	allLevels := make([][]string, 0, len(c.Levels))
+	for _, level := range c.Levels {
+		allLevels = append(allLevels, level.Lines)
+	}
+
+	return allLevels, err
+}
+
+// trimLevel removes the leading spaces of levels that aren't in XSB.
+func trimLevel(levelData []string) []string {
+	if game.IsXSB(levelData) {
+		return levelData
+	}
+
+	for i, line := range levelData {
+		levelData[i] = strings.TrimSpace(line)
+	}
+
+	return levelData
+}
+
+// Error is a problem found on a level, or on the file it's read from.
+type Error struct {
+	Path  string // File where the problem is, if known
+	Line  int    // Line of the file, starting at 1
+	Col   int    // Column of the line, starting at 1, or 0 if it isn't about a column
+	Level int    // ID of the level, or 0 if it isn't about a level
+	Err   error
+}
+
+func (e *Error) Error() string {
+	var sb strings.Builder
+	if e.Path != "" {
+		sb.WriteString(e.Path + ":")
+	}
+	fmt.Fprintf(&sb, "%d:", e.Line)
+	if e.Col > 0 {
+		fmt.Fprintf(&sb, "%d:", e.Col)
+	}
+	if e.Level > 0 {
+		fmt.Fprintf(&sb, " level %d:", e.Level)
+	}
+	fmt.Fprintf(&sb, " %v", e.Err)
+
+	return sb.String()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// NewBoard creates a board for a level in any of the supported formats.
+// If the level can't be played, it fails with an *Error pointing at
+// where the problem is on the file.
+func NewBoard(level *Level) (*game.Board, error) {
+	newBoard := game.NewBoard
+	if game.IsXSB(level.Lines) {
+		newBoard = game.NewBoardFromXSB
+	}
+
+	b, err := newBoard(level.Lines)
+	if err == nil {
+		return b, nil
+	}
+
+	e := &Error{Path: level.Source, Line: level.Line, Level: level.ID, Err: err}
+	if le, ok := err.(*game.LevelError); ok {
+		e.Err = le.Err
+		if le.Row >= 0 {
+			e.Line += le.Row
+		}
+		if le.Col >= 0 {
+			e.Col = le.Col + 1
+		}
+	}
+
+	return nil, e
+}
@@ -0,0 +1,110 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package levels
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/csixteen/sokoban/pkg/game"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	data := `
+wwwww
+wjbgw
+wwwww
+
+; An XSB level
+  ####
+###  #
+#@$. #
+######
+`
+
+	allLevels, err := Parse(strings.NewReader(data))
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"wwwww", "wjbgw", "wwwww"},
+		{"  ####", "###  #", "#@$. #", "######"},
+	}, allLevels)
+}
+
+func TestParseTrimsOwnFormat(t *testing.T) {
+	data := "  wwwww  \r\n  wjbgw\r\n  wwwww\r\n"
+
+	allLevels, err := Parse(strings.NewReader(data))
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{"wwwww", "wjbgw", "wwwww"}}, allLevels)
+}
+
+func TestNewBoard(t *testing.T) {
+	board, err := NewBoard(&Level{Lines: []string{"#####", "#@$.#", "#####"}})
+	assert.NoError(t, err)
+	board.MoveRight()
+	assert.True(t, board.IsVictory())
+
+	board, err = NewBoard(&Level{Lines: []string{"wwwww", "wjbgw", "wwwww"}})
+	assert.NoError(t, err)
+	board.MoveRight()
+	assert.True(t, board.IsVictory())
+}
+
+func TestNewBoardErrors(t *testing.T) {
+	data := `; First
+#####
+#@$.#
+#####
+
+; Second
+#####
+# $.#
+#####
+`
+
+	c, err := ParseCollection(strings.NewReader(data))
+	assert.NoError(t, err)
+
+	_, err = NewBoard(c.Levels[0])
+	assert.NoError(t, err)
+
+	_, err = NewBoard(c.Levels[1])
+	var e *Error
+	assert.True(t, errors.As(err, &e))
+	assert.Equal(t, 7, e.Line)
+	assert.Equal(t, 0, e.Col)
+	assert.Equal(t, 2, e.Level)
+	assert.True(t, errors.Is(err, game.ErrPlayerCount))
+
+	level := &Level{
+		ID:     3,
+		Lines:  []string{"#####", "#@$x#", "#####"},
+		Source: "tiny.sok",
+		Line:   20,
+	}
+	_, err = NewBoard(level)
+	assert.True(t, errors.Is(err, game.ErrUnknownChar))
+	assert.Equal(t, "tiny.sok:21:4: level 3: Unknown character 'x'", err.Error())
+}
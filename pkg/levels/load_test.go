@@ -0,0 +1,78 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package levels
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "levels")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		"b.sok":     "; Second\n#####\n#@$.#\n#####\n",
+		"a.txt":     "Title: A\n\n; First\nwwwww\nwjbgw\nwwwww\n\n; Third\n######\n#@$ .#\n######\n",
+		".hidden":   "wwwww\nwjbgw\nwwwww\n",
+		"sub/c.sok": "wwwww\nwjbgw\nwwwww\n",
+	}
+	for name, data := range files {
+		path := filepath.Join(dir, name)
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		assert.NoError(t, ioutil.WriteFile(path, []byte(data), 0644))
+	}
+
+	c, err := Load(filepath.Join(dir, "a.txt"))
+	assert.NoError(t, err)
+	assert.Equal(t, "A", c.Title)
+	assert.Len(t, c.Levels, 2)
+	assert.Equal(t, filepath.Join(dir, "a.txt"), c.Levels[0].Source)
+	assert.Equal(t, 4, c.Levels[0].Line)
+
+	c, err = Load(dir)
+	assert.NoError(t, err)
+	assert.Len(t, c.Levels, 3)
+	for i, title := range []string{"First", "Third", "Second"} {
+		assert.Equal(t, i+1, c.Levels[i].ID)
+		assert.Equal(t, title, c.Levels[i].Title)
+	}
+
+	_, err = Load(filepath.Join(dir, "missing.sok"))
+	assert.Error(t, err)
+}
+
+func TestEmbedded(t *testing.T) {
+	data, err := ioutil.ReadFile("../../assets/levels/levels.dat")
+	assert.NoError(t, err)
+	assert.Equal(t, string(data), embeddedLevels, "Run go generate after changing the levels")
+
+	allLevels, err := Embedded()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, allLevels)
+}
@@ -0,0 +1,177 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package progress keeps track of the levels solved by the player, so
+// that the game can be resumed where it was left off.
+package progress
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Level holds the best results achieved on a level. Each of them may
+// come from a different attempt.
+type Level struct {
+	Solved     bool          `json:"solved"`
+	BestMoves  int           `json:"best_moves"`
+	BestPushes int           `json:"best_pushes"`
+	BestTime   time.Duration `json:"best_time"`
+}
+
+// Collection holds the progress on a collection of levels.
+type Collection struct {
+	Levels     map[int]*Level `json:"levels"`                // Indexed by level, starting at 0
+	Current    int            `json:"current"`               // Level being played
+	InProgress string         `json:"in_progress,omitempty"` // Moves made on the current level, in LURD
+	Elapsed    time.Duration  `json:"elapsed,omitempty"`     // Time spent on the current level
+}
+
+// Progress holds the progress on every collection of levels that
+// has been played, indexed by name.
+type Progress struct {
+	Collections map[string]*Collection `json:"collections"`
+
+	path string
+}
+
+// DefaultPath returns the path of the progress file, under the XDG
+// data directory ($XDG_DATA_HOME, or ~/.local/share if not set).
+func DefaultPath() (string, error) {
+	dir := os.Getenv("XDG_DATA_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		dir = filepath.Join(home, ".local", "share")
+	}
+
+	return filepath.Join(dir, "sokoban", "progress.json"), nil
+}
+
+// Load reads the progress saved on path. If there's no such file,
+// an empty Progress is returned, which will be saved on path.
+func Load(path string) (*Progress, error) {
+	p := &Progress{
+		Collections: make(map[string]*Collection),
+		path:        path,
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return p, nil
+	}
+	if err != nil {
+		return p, err
+	}
+
+	if err := json.Unmarshal(data, p); err != nil {
+		return p, err
+	}
+	if p.Collections == nil {
+		p.Collections = make(map[string]*Collection)
+	}
+
+	return p, nil
+}
+
+// Save writes the progress to its file. The file is replaced
+// atomically, so that it never ends up half written.
+func (p *Progress) Save() error {
+	data, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(p.path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(dir, ".progress-*.json")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), p.path)
+}
+
+// Collection returns the progress on the collection with the given
+// name, creating it if needed.
+func (p *Progress) Collection(name string) *Collection {
+	c, ok := p.Collections[name]
+	if !ok {
+		c = &Collection{}
+		p.Collections[name] = c
+	}
+	if c.Levels == nil {
+		c.Levels = make(map[int]*Level)
+	}
+
+	return c
+}
+
+// Level returns the progress on a level, or nil if it was never solved.
+func (c *Collection) Level(level int) *Level {
+	return c.Levels[level]
+}
+
+// Record marks a level as solved, keeping the best results so far.
+func (c *Collection) Record(level, moves, pushes int, elapsed time.Duration) {
+	l, ok := c.Levels[level]
+	if !ok || !l.Solved {
+		c.Levels[level] = &Level{
+			Solved:     true,
+			BestMoves:  moves,
+			BestPushes: pushes,
+			BestTime:   elapsed,
+		}
+		return
+	}
+
+	if moves < l.BestMoves {
+		l.BestMoves = moves
+	}
+	if pushes < l.BestPushes {
+		l.BestPushes = pushes
+	}
+	if elapsed < l.BestTime {
+		l.BestTime = elapsed
+	}
+}
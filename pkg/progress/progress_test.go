@@ -0,0 +1,98 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package progress
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultPath(t *testing.T) {
+	old, ok := os.LookupEnv("XDG_DATA_HOME")
+	defer func() {
+		if ok {
+			os.Setenv("XDG_DATA_HOME", old)
+		} else {
+			os.Unsetenv("XDG_DATA_HOME")
+		}
+	}()
+
+	os.Setenv("XDG_DATA_HOME", "/tmp/data")
+	path, err := DefaultPath()
+	assert.NoError(t, err)
+	assert.Equal(t, "/tmp/data/sokoban/progress.json", path)
+}
+
+func TestLoadMissingFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "progress")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	p, err := Load(filepath.Join(dir, "progress.json"))
+	assert.NoError(t, err)
+	assert.Empty(t, p.Collections)
+}
+
+func TestSaveAndLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "progress")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "sokoban", "progress.json")
+	p, _ := Load(path)
+
+	c := p.Collection("levels.dat")
+	c.Record(0, 20, 5, time.Minute)
+	c.Current = 1
+	c.InProgress = "lluR"
+	c.Elapsed = time.Second
+	assert.NoError(t, p.Save())
+
+	p, err = Load(path)
+	assert.NoError(t, err)
+	c = p.Collection("levels.dat")
+	assert.Equal(t, 1, c.Current)
+	assert.Equal(t, "lluR", c.InProgress)
+	assert.Equal(t, time.Second, c.Elapsed)
+	assert.Equal(t, &Level{true, 20, 5, time.Minute}, c.Level(0))
+	assert.Nil(t, c.Level(1))
+
+	files, _ := ioutil.ReadDir(filepath.Dir(path))
+	assert.Len(t, files, 1, "No temporary files should be left behind")
+}
+
+func TestRecord(t *testing.T) {
+	p, _ := Load("progress.json")
+	c := p.Collection("levels.dat")
+
+	c.Record(2, 20, 5, time.Minute)
+	c.Record(2, 18, 7, 2*time.Minute)
+	c.Record(2, 25, 4, 30*time.Second)
+
+	assert.Equal(t, &Level{true, 18, 4, 30 * time.Second}, c.Level(2))
+}
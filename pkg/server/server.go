@@ -0,0 +1,384 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package server serves the game over HTTP, with a JSON API meant for
+// dashboards and test harnesses:
+//
+//	GET    /levels                 lists the levels
+//	POST   /sessions               starts playing a level, given as {"level": 1}
+//	GET    /sessions/{id}          returns the state of a session
+//	DELETE /sessions/{id}          ends a session
+//	POST   /sessions/{id}/moves    makes moves in LURD, given as {"moves": "rrU"}
+//	POST   /sessions/{id}/undo     undoes the last move
+//	POST   /sessions/{id}/reset    starts the level over
+//
+// Sessions are kept in memory, and are lost when the server stops. Those
+// that aren't used for a while are ended, and there can only be so many
+// of them at once. Only requests for a loopback host, such as localhost,
+// are served, so that web pages can't reach the server by rebinding
+// their own domain name to it.
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/csixteen/sokoban/pkg/game"
+	"github.com/csixteen/sokoban/pkg/levels"
+)
+
+const (
+	// MaxBodySize is the largest request body that's accepted, in bytes.
+	MaxBodySize = 1 << 20
+
+	// MaxSessions is how many sessions there can be at once.
+	MaxSessions = 1000
+
+	// IdleTimeout is how long a session is kept without being used.
+	IdleTimeout = 30 * time.Minute
+)
+
+var (
+	ErrNoSuchLevel   = errors.New("There's no such level")
+	ErrNoSuchSession = errors.New("There's no such session")
+	ErrInvalidMove   = errors.New("Invalid move")
+	ErrNotFound      = errors.New("Not found")
+	ErrBadMethod     = errors.New("Method not allowed")
+	ErrBadHost       = errors.New("Only loopback hosts are allowed")
+	ErrTooMany       = errors.New("There are too many sessions")
+)
+
+var directions = map[rune]game.Direction{
+	'u': game.Up,
+	'd': game.Down,
+	'l': game.Left,
+	'r': game.Right,
+}
+
+// Level describes a level that can be played.
+type Level struct {
+	ID      int    `json:"id"`
+	Title   string `json:"title,omitempty"`
+	Author  string `json:"author,omitempty"`
+	Comment string `json:"comment,omitempty"`
+}
+
+// Position is a cell of the board.
+type Position struct {
+	Row int `json:"row"`
+	Col int `json:"col"`
+}
+
+// State is what a session looks like.
+type State struct {
+	ID         string   `json:"id"`
+	Level      int      `json:"level"`
+	Grid       []string `json:"grid"` // Rows of the board in the XSB format
+	Player     Position `json:"player"`
+	Moves      int      `json:"moves"`
+	Pushes     int      `json:"pushes"`
+	LURD       string   `json:"lurd"`
+	Solved     bool     `json:"solved"`
+	Deadlocked bool     `json:"deadlocked"`
+}
+
+// session is a level being played. Boards aren't safe for concurrent
+// use, so each one has its own lock.
+type session struct {
+	mu       sync.Mutex
+	id       string
+	level    int
+	board    *game.Board
+	lastUsed time.Time // Guarded by the lock of the server
+}
+
+// Server is an http.Handler for the API. It's safe for concurrent use.
+type Server struct {
+	levels []*levels.Level
+
+	mu       sync.Mutex
+	sessions map[string]*session
+	lastID   int
+
+	now func() time.Time // Tells the time, so that tests can change it
+}
+
+// New creates a server for the given levels.
+func New(allLevels []*levels.Level) *Server {
+	return &Server{
+		levels:   allLevels,
+		sessions: make(map[string]*session),
+		now:      time.Now,
+	}
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !isLoopback(r.Host) {
+		writeError(w, http.StatusForbidden, fmt.Errorf("%w: %q", ErrBadHost, r.Host))
+		return
+	}
+
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+
+	switch {
+	case len(parts) == 1 && parts[0] == "levels":
+		s.handleLevels(w, r)
+	case len(parts) == 1 && parts[0] == "sessions":
+		s.handleNewSession(w, r)
+	case len(parts) == 2 && parts[0] == "sessions":
+		s.handleSession(w, r, parts[1])
+	case len(parts) == 3 && parts[0] == "sessions":
+		s.handleAction(w, r, parts[1], parts[2])
+	default:
+		writeError(w, http.StatusNotFound, ErrNotFound)
+	}
+}
+
+func (s *Server) handleLevels(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, ErrBadMethod)
+		return
+	}
+
+	list := make([]Level, len(s.levels))
+	for i, l := range s.levels {
+		list[i] = Level{ID: l.ID, Title: l.Title, Author: l.Author, Comment: l.Comment}
+	}
+
+	writeJSON(w, http.StatusOK, list)
+}
+
+func (s *Server) handleNewSession(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, ErrBadMethod)
+		return
+	}
+
+	var req struct {
+		Level int `json:"level"`
+	}
+	if err := readJSON(w, r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if req.Level < 1 || req.Level > len(s.levels) {
+		writeError(w, http.StatusNotFound, fmt.Errorf("%w: %d", ErrNoSuchLevel, req.Level))
+		return
+	}
+
+	board, err := levels.NewBoard(s.levels[req.Level-1])
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	s.mu.Lock()
+	s.expire()
+	if len(s.sessions) >= MaxSessions {
+		s.mu.Unlock()
+		writeError(w, http.StatusServiceUnavailable, ErrTooMany)
+		return
+	}
+	s.lastID++
+	sess := &session{id: strconv.Itoa(s.lastID), level: req.Level, board: board, lastUsed: s.now()}
+	s.sessions[sess.id] = sess
+	s.mu.Unlock()
+
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	writeJSON(w, http.StatusCreated, sess.state())
+}
+
+func (s *Server) handleSession(w http.ResponseWriter, r *http.Request, id string) {
+	// Requests that are turned down mustn't keep the session alive.
+	if r.Method != http.MethodGet && r.Method != http.MethodDelete {
+		writeError(w, http.StatusMethodNotAllowed, ErrBadMethod)
+		return
+	}
+
+	sess, ok := s.session(id)
+	if !ok {
+		writeError(w, http.StatusNotFound, ErrNoSuchSession)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		sess.mu.Lock()
+		defer sess.mu.Unlock()
+		writeJSON(w, http.StatusOK, sess.state())
+	case http.MethodDelete:
+		s.mu.Lock()
+		delete(s.sessions, id)
+		s.mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (s *Server) handleAction(w http.ResponseWriter, r *http.Request, id, action string) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, ErrBadMethod)
+		return
+	}
+
+	var apply func(b *game.Board)
+	switch action {
+	case "moves":
+		var req struct {
+			Moves string `json:"moves"`
+		}
+		if err := readJSON(w, r, &req); err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		moves, err := parseMoves(req.Moves)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		apply = func(b *game.Board) {
+			for _, d := range moves {
+				if b.IsVictory() {
+					break
+				}
+				b.Move(d)
+			}
+		}
+	case "undo":
+		apply = (*game.Board).Undo
+	case "reset":
+		apply = (*game.Board).Reset
+	default:
+		writeError(w, http.StatusNotFound, ErrNotFound)
+		return
+	}
+
+	sess, ok := s.session(id)
+	if !ok {
+		writeError(w, http.StatusNotFound, ErrNoSuchSession)
+		return
+	}
+
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	apply(sess.board)
+	writeJSON(w, http.StatusOK, sess.state())
+}
+
+// session returns the session with the given id, unless it expired.
+func (s *Server) session(id string) (*session, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.expire()
+	sess, ok := s.sessions[id]
+	if ok {
+		sess.lastUsed = s.now()
+	}
+
+	return sess, ok
+}
+
+// expire ends the sessions that weren't used for too long. The server
+// must be locked.
+func (s *Server) expire() {
+	now := s.now()
+	for id, sess := range s.sessions {
+		if now.Sub(sess.lastUsed) > IdleTimeout {
+			delete(s.sessions, id)
+		}
+	}
+}
+
+// state returns the state of the session, which must be locked.
+func (sess *session) state() State {
+	row, col := sess.board.Player()
+
+	return State{
+		ID:         sess.id,
+		Level:      sess.level,
+		Grid:       sess.board.XSB(),
+		Player:     Position{Row: row, Col: col},
+		Moves:      sess.board.Moves(),
+		Pushes:     sess.board.Pushes(),
+		LURD:       sess.board.LURD(),
+		Solved:     sess.board.IsVictory(),
+		Deadlocked: sess.board.IsDeadlocked(),
+	}
+}
+
+// isLoopback tells whether host, as given in a request, is localhost or
+// a loopback address, with or without a port.
+func isLoopback(host string) bool {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.TrimSuffix(strings.TrimPrefix(host, "["), "]")
+
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(host)
+
+	return ip != nil && ip.IsLoopback()
+}
+
+// parseMoves turns moves in LURD, in either case, into directions.
+func parseMoves(moves string) ([]game.Direction, error) {
+	var dirs []game.Direction
+	for _, c := range moves {
+		d, ok := directions[unicode.ToLower(c)]
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidMove, c)
+		}
+		dirs = append(dirs, d)
+	}
+
+	return dirs, nil
+}
+
+func readJSON(w http.ResponseWriter, r *http.Request, v interface{}) error {
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, MaxBodySize))
+	dec.DisallowUnknownFields()
+
+	return dec.Decode(v)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,255 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/csixteen/sokoban/pkg/levels"
+	"github.com/stretchr/testify/assert"
+)
+
+var testLevels = []*levels.Level{
+	{ID: 1, Title: "Corridor", Lines: []string{"#######", "#@ $. #", "#######"}},
+	{ID: 2, Lines: []string{"#####", "#$.##", "#####"}},
+}
+
+func newTestServer(t *testing.T) *httptest.Server {
+	ts := httptest.NewServer(New(testLevels))
+	t.Cleanup(ts.Close)
+
+	return ts
+}
+
+// request sends a request to the server, and decodes its response into
+// v, if any. It returns the status code of the response.
+func request(t *testing.T, ts *httptest.Server, method, path, body string, v interface{}) int {
+	req, err := http.NewRequest(method, ts.URL+path, strings.NewReader(body))
+	assert.NoError(t, err)
+
+	resp, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	if v != nil {
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(v))
+	}
+
+	return resp.StatusCode
+}
+
+// serve has s handle a request right away, without going through the
+// network, and returns the status code of its response.
+func serve(s *Server, method, path, body string) int {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Host = "localhost"
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, req)
+
+	return w.Code
+}
+
+func TestLevels(t *testing.T) {
+	ts := newTestServer(t)
+
+	var list []Level
+	assert.Equal(t, http.StatusOK, request(t, ts, "GET", "/levels", "", &list))
+	assert.Equal(t, []Level{{ID: 1, Title: "Corridor"}, {ID: 2}}, list)
+
+	assert.Equal(t, http.StatusMethodNotAllowed, request(t, ts, "POST", "/levels", "", nil))
+}
+
+func TestSession(t *testing.T) {
+	ts := newTestServer(t)
+
+	var state State
+	assert.Equal(t, http.StatusCreated, request(t, ts, "POST", "/sessions", `{"level": 1}`, &state))
+	assert.Equal(t, State{
+		ID:     "1",
+		Level:  1,
+		Grid:   []string{"#######", "#@ $. #", "#######"},
+		Player: Position{Row: 1, Col: 1},
+	}, state)
+
+	path := "/sessions/" + state.ID
+	assert.Equal(t, http.StatusOK, request(t, ts, "POST", path+"/moves", `{"moves": "lr"}`, &state))
+	assert.Equal(t, Position{Row: 1, Col: 2}, state.Player)
+	assert.Equal(t, 1, state.Moves)
+	assert.Equal(t, "r", state.LURD)
+
+	assert.Equal(t, http.StatusOK, request(t, ts, "POST", path+"/moves", `{"moves": "R"}`, &state))
+	assert.Equal(t, []string{"#######", "#  @* #", "#######"}, state.Grid)
+	assert.Equal(t, 1, state.Pushes)
+	assert.True(t, state.Solved)
+
+	assert.Equal(t, http.StatusOK, request(t, ts, "POST", path+"/undo", "", &state))
+	assert.False(t, state.Solved)
+	assert.Equal(t, 1, state.Moves)
+
+	assert.Equal(t, http.StatusOK, request(t, ts, "POST", path+"/reset", "", &state))
+	assert.Equal(t, 0, state.Moves)
+
+	assert.Equal(t, http.StatusOK, request(t, ts, "GET", path, "", &state))
+	assert.Equal(t, Position{Row: 1, Col: 1}, state.Player)
+
+	assert.Equal(t, http.StatusNoContent, request(t, ts, "DELETE", path, "", nil))
+	assert.Equal(t, http.StatusNotFound, request(t, ts, "GET", path, "", nil))
+}
+
+func TestSessionErrors(t *testing.T) {
+	ts := newTestServer(t)
+
+	var body map[string]string
+	assert.Equal(t, http.StatusNotFound, request(t, ts, "POST", "/sessions", `{"level": 3}`, &body))
+	assert.Contains(t, body["error"], ErrNoSuchLevel.Error())
+
+	assert.Equal(t, http.StatusUnprocessableEntity, request(t, ts, "POST", "/sessions", `{"level": 2}`, nil))
+	assert.Equal(t, http.StatusBadRequest, request(t, ts, "POST", "/sessions", `{"lvl": 1}`, nil))
+	assert.Equal(t, http.StatusNotFound, request(t, ts, "GET", "/sessions/42", "", nil))
+	assert.Equal(t, http.StatusNotFound, request(t, ts, "GET", "/nothing", "", nil))
+
+	var state State
+	assert.Equal(t, http.StatusCreated, request(t, ts, "POST", "/sessions", `{"level": 1}`, &state))
+	path := "/sessions/" + state.ID
+
+	assert.Equal(t, http.StatusBadRequest, request(t, ts, "POST", path+"/moves", `{"moves": "rx"}`, &body))
+	assert.Contains(t, body["error"], ErrInvalidMove.Error())
+	assert.Equal(t, http.StatusMethodNotAllowed, request(t, ts, "GET", path+"/undo", "", nil))
+	assert.Equal(t, http.StatusNotFound, request(t, ts, "POST", path+"/jump", "", nil))
+
+	assert.Equal(t, http.StatusOK, request(t, ts, "GET", path, "", &state))
+	assert.Equal(t, 0, state.Moves, "Invalid moves shouldn't be made at all")
+}
+
+func TestSessionConcurrent(t *testing.T) {
+	ts := newTestServer(t)
+
+	var state State
+	assert.Equal(t, http.StatusCreated, request(t, ts, "POST", "/sessions", `{"level": 1}`, &state))
+	path := "/sessions/" + state.ID
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			request(t, ts, "POST", path+"/moves", `{"moves": "rl"}`, nil)
+			request(t, ts, "POST", "/sessions", `{"level": 1}`, nil)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, http.StatusOK, request(t, ts, "GET", path, "", &state))
+	assert.Equal(t, 20, state.Moves)
+	assert.Equal(t, http.StatusOK, request(t, ts, "GET", "/sessions/11", "", &state))
+}
+
+func TestNewSessionConcurrent(t *testing.T) {
+	s := New(testLevels)
+
+	// Sessions can be played as soon as they're made, since their ids
+	// are easy to guess.
+	var wg sync.WaitGroup
+	for i := 1; i <= 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			serve(s, "POST", "/sessions", `{"level": 1}`)
+		}()
+		go func(id int) {
+			defer wg.Done()
+			path := "/sessions/" + strconv.Itoa(id) + "/moves"
+			for serve(s, "POST", path, `{"moves": "rl"}`) == http.StatusNotFound {
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestSessionExpiry(t *testing.T) {
+	now := time.Now()
+	s := New(testLevels)
+	s.now = func() time.Time { return now }
+	ts := httptest.NewServer(s)
+	defer ts.Close()
+
+	var idle, used State
+	assert.Equal(t, http.StatusCreated, request(t, ts, "POST", "/sessions", `{"level": 1}`, &idle))
+	assert.Equal(t, http.StatusCreated, request(t, ts, "POST", "/sessions", `{"level": 1}`, &used))
+
+	now = now.Add(IdleTimeout / 2)
+	assert.Equal(t, http.StatusOK, request(t, ts, "GET", "/sessions/"+used.ID, "", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, request(t, ts, "PUT", "/sessions/"+idle.ID, "", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, request(t, ts, "GET", "/sessions/"+idle.ID+"/undo", "", nil))
+
+	now = now.Add(IdleTimeout/2 + time.Second)
+	assert.Equal(t, http.StatusNotFound, request(t, ts, "GET", "/sessions/"+idle.ID, "", nil))
+	assert.Equal(t, http.StatusOK, request(t, ts, "GET", "/sessions/"+used.ID, "", nil))
+}
+
+func TestSessionLimit(t *testing.T) {
+	now := time.Now()
+	s := New(testLevels)
+	s.now = func() time.Time { return now }
+
+	newSession := func() int {
+		return serve(s, "POST", "/sessions", `{"level": 1}`)
+	}
+
+	for i := 0; i < MaxSessions; i++ {
+		assert.Equal(t, http.StatusCreated, newSession())
+	}
+	assert.Equal(t, http.StatusServiceUnavailable, newSession())
+
+	now = now.Add(IdleTimeout + time.Second)
+	assert.Equal(t, http.StatusCreated, newSession(), "Idle sessions should make room for new ones")
+}
+
+func TestHost(t *testing.T) {
+	s := New(testLevels)
+
+	for host, status := range map[string]int{
+		"localhost":         http.StatusOK,
+		"LOCALHOST:8080":    http.StatusOK,
+		"127.0.0.1:8080":    http.StatusOK,
+		"[::1]:8080":        http.StatusOK,
+		"example.com":       http.StatusForbidden,
+		"example.com:8080":  http.StatusForbidden,
+		"localhost.evil.io": http.StatusForbidden,
+		"192.168.1.2":       http.StatusForbidden,
+		"":                  http.StatusForbidden,
+	} {
+		req := httptest.NewRequest("GET", "/levels", nil)
+		req.Host = host
+		w := httptest.NewRecorder()
+		s.ServeHTTP(w, req)
+		assert.Equal(t, status, w.Code, host)
+	}
+}
@@ -0,0 +1,584 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package solver finds solutions for Sokoban levels, searching over
+// the positions of the blocks rather than over single player steps.
+package solver
+
+import (
+	"container/heap"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/csixteen/sokoban/pkg/game"
+)
+
+var (
+	ErrNoSolution  = errors.New("Level has no solution")
+	ErrNodeLimit   = errors.New("Node limit reached")
+	ErrMemoryLimit = errors.New("Memory limit reached")
+	ErrTimeout     = errors.New("Time limit reached")
+	ErrStopped     = errors.New("Search was stopped")
+)
+
+// Options sets the limits of a search. Zero values mean no limit.
+type Options struct {
+	MaxNodes  int           // Maximum number of states to expand
+	MaxMemory int           // Approximate number of bytes taken by states
+	Timeout   time.Duration // Maximum duration of the search
+
+	// Stop stops the search as soon as it's closed
+	Stop <-chan struct{}
+}
+
+// Stats describes the work done by a search.
+type Stats struct {
+	Nodes   int           // Number of states expanded
+	Depth   int           // Pushes in the solution, or in the deepest state reached
+	Elapsed time.Duration // Duration of the search
+}
+
+// Rough number of bytes taken by each state kept in memory, besides
+// the positions of its blocks.
+const stateOverhead = 128
+
+var directions = []game.Direction{game.Up, game.Down, game.Left, game.Right}
+
+// Problem is a snapshot of the layout of a board, which can be solved
+// independently of the board it was taken from. Cells are numbered
+// row by row, starting at the top-left corner.
+type Problem struct {
+	width, height int
+	walls         []bool
+	goals         []bool
+	numGoals      int
+	boxes         []int // Cells with blocks, sorted
+	player        int
+	distance      []int // Minimum number of pushes from a cell to a goal, or -1
+	lineDistance  []int // Same, but pushing lines of blocks along, or 0
+}
+
+// NewProblem takes a snapshot of the current state of the board.
+func NewProblem(b *game.Board) *Problem {
+	w, h := b.Bounds()
+	p := &Problem{
+		width:  w,
+		height: h,
+		walls:  make([]bool, w*h),
+		goals:  make([]bool, w*h),
+	}
+
+	for row := 0; row < h; row++ {
+		for col := 0; col < w; col++ {
+			cell := row*w + col
+			p.walls[cell] = b.IsWall(row, col)
+			if b.IsGoal(row, col) {
+				p.goals[cell] = true
+				p.numGoals++
+			}
+			if b.IsBlock(row, col) {
+				p.boxes = append(p.boxes, cell)
+			}
+		}
+	}
+
+	row, col := b.Player()
+	p.player = row*w + col
+	p.distance = p.goalDistances()
+	p.lineDistance = p.lineDistances()
+
+	return p
+}
+
+// Solve searches for a solution of the current state of the board.
+// See Problem.Solve.
+func Solve(b *game.Board, opts Options) (string, Stats, error) {
+	return NewProblem(b).Solve(opts)
+}
+
+// Solve searches for the solution with the least number of pushes,
+// using A* over the positions of the blocks. Pushes are counted as the
+// board does, so pushing a line of blocks counts as one. The solution
+// is given in LURD notation, and can be applied to the board with
+// game.Replay.
+func (p *Problem) Solve(opts Options) (string, Stats, error) {
+	start := time.Now()
+	var stats Stats
+
+	// A block that can never reach a goal is never pushed anywhere by
+	// the search, but it may be there from the start.
+	for _, box := range p.boxes {
+		if p.distance[box] < 0 {
+			stats.Elapsed = time.Since(start)
+			return "", stats, ErrNoSolution
+		}
+	}
+
+	root := &node{boxes: p.boxes, player: p.player}
+	root.f = p.heuristic(root.boxes)
+
+	open := &queue{}
+	heap.Push(open, root)
+	seen := map[string]bool{}
+	memory := 0
+
+	for open.Len() > 0 {
+		n := heap.Pop(open).(*node)
+
+		reach := p.reachable(n.boxes, n.player)
+		key := p.key(n.boxes, reach)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		if n.g > stats.Depth {
+			stats.Depth = n.g
+		}
+		if p.solved(n.boxes) {
+			stats.Depth = n.g
+			stats.Elapsed = time.Since(start)
+			return p.solution(n), stats, nil
+		}
+
+		stats.Nodes++
+		if opts.MaxNodes > 0 && stats.Nodes > opts.MaxNodes {
+			stats.Elapsed = time.Since(start)
+			return "", stats, ErrNodeLimit
+		}
+		if opts.Timeout > 0 && stats.Nodes%256 == 0 && time.Since(start) > opts.Timeout {
+			stats.Elapsed = time.Since(start)
+			return "", stats, ErrTimeout
+		}
+		if stopped(opts.Stop) {
+			stats.Elapsed = time.Since(start)
+			return "", stats, ErrStopped
+		}
+
+		for _, child := range p.expand(n, reach) {
+			memory += stateOverhead + len(child.boxes)*8
+			if opts.MaxMemory > 0 && memory > opts.MaxMemory {
+				stats.Elapsed = time.Since(start)
+				return "", stats, ErrMemoryLimit
+			}
+			heap.Push(open, child)
+		}
+	}
+
+	stats.Elapsed = time.Since(start)
+	return "", stats, ErrNoSolution
+}
+
+// expand returns the states that can be reached from n with a single
+// push, given the cells that the player can walk to.
+func (p *Problem) expand(n *node, reach []bool) []*node {
+	var res []*node
+
+	for i, box := range n.boxes {
+		for _, d := range directions {
+			from := p.next(box, opposite(d))
+			if from < 0 || !reach[from] {
+				continue
+			}
+
+			// Just like on the board, every block lined up in front of
+			// the player is pushed along. Since blocks are all alike,
+			// that's the same as taking the first one to the free cell
+			// after the last one.
+			to := p.next(box, d)
+			for to >= 0 && hasBox(n.boxes, to) {
+				to = p.next(to, d)
+			}
+			if to < 0 || p.walls[to] {
+				continue
+			}
+			if p.distance[to] < 0 {
+				continue // A block on `to` could never reach a goal
+			}
+
+			boxes := make([]int, len(n.boxes))
+			copy(boxes, n.boxes)
+			boxes[i] = to
+			sort.Ints(boxes)
+
+			child := &node{
+				boxes:  boxes,
+				player: box,
+				parent: n,
+				dir:    d,
+				g:      n.g + 1,
+			}
+			child.f = child.g + p.heuristic(boxes)
+			res = append(res, child)
+		}
+	}
+
+	return res
+}
+
+// solution builds the moves leading from the initial state to n. The
+// player walks to the block before each push.
+func (p *Problem) solution(n *node) string {
+	var path []*node
+	for ; n.parent != nil; n = n.parent {
+		path = append(path, n)
+	}
+
+	var sb strings.Builder
+	for i := len(path) - 1; i >= 0; i-- {
+		n := path[i]
+		behind := p.next(n.player, opposite(n.dir))
+		sb.WriteString(p.walk(n.parent.boxes, n.parent.player, behind))
+		sb.WriteString(strings.ToUpper(string(lurd[n.dir])))
+	}
+
+	return sb.String()
+}
+
+func (p *Problem) solved(boxes []int) bool {
+	covered := 0
+	for _, box := range boxes {
+		if p.goals[box] {
+			covered++
+		}
+	}
+
+	return covered == p.numGoals
+}
+
+// heuristic is a lower bound of the number of pushes needed to solve
+// a state. Pushing a line of blocks moves all of them at once, so the
+// distances of the blocks can't just be added up. But since blocks are
+// all alike, such a push is the same as taking the last block of the
+// line to the cell in front of the first one, which is what
+// lineDistance counts as a single push. Each push then takes away at
+// most one from the sum of those distances. Besides, each block is
+// moved at most once per push, so the block farthest from a goal has
+// to be pushed at least that far.
+func (p *Problem) heuristic(boxes []int) int {
+	sum, farthest := 0, 0
+	for _, box := range boxes {
+		sum += p.lineDistance[box]
+		if p.distance[box] > farthest {
+			farthest = p.distance[box]
+		}
+	}
+
+	if farthest > sum {
+		return farthest
+	}
+	return sum
+}
+
+// goalDistances finds the minimum number of pushes needed to take a
+// block from each cell to a goal, ignoring all other blocks. Cells
+// from which no goal can be reached get -1.
+func (p *Problem) goalDistances() []int {
+	dist := make([]int, len(p.walls))
+
+	// With more blocks than goals, some of them don't need to reach a
+	// goal at all, so neither the distances nor the dead cells apply.
+	if len(p.boxes) != p.numGoals {
+		return dist
+	}
+
+	var q []int
+	for cell := range dist {
+		dist[cell] = -1
+		if p.goals[cell] {
+			dist[cell] = 0
+			q = append(q, cell)
+		}
+	}
+
+	// Walk backwards from the goals: a block can be pushed from `from`
+	// into `cell` if both `from` and the cell behind it are free.
+	for len(q) > 0 {
+		cell := q[0]
+		q = q[1:]
+
+		for _, d := range directions {
+			from := p.next(cell, opposite(d))
+			if from < 0 || p.walls[from] || dist[from] >= 0 {
+				continue
+			}
+			if behind := p.next(from, opposite(d)); behind < 0 || p.walls[behind] {
+				continue
+			}
+			dist[from] = dist[cell] + 1
+			q = append(q, from)
+		}
+	}
+
+	return dist
+}
+
+// lineDistances finds the minimum number of pushes needed to take a
+// block from each cell to a goal, when a push can also move it past as
+// many cells as there are other blocks, as if they were lined up in
+// front of it. Cells from which no goal can be reached get 0, as blocks
+// are never pushed there.
+func (p *Problem) lineDistances() []int {
+	dist := make([]int, len(p.walls))
+	if len(p.boxes) != p.numGoals {
+		return dist
+	}
+
+	var q []int
+	for cell := range dist {
+		dist[cell] = -1
+		if p.goals[cell] {
+			dist[cell] = 0
+			q = append(q, cell)
+		}
+	}
+
+	for len(q) > 0 {
+		cell := q[0]
+		q = q[1:]
+
+		for _, d := range directions {
+			from := cell
+			for n := 0; n < len(p.boxes); n++ {
+				if from = p.next(from, opposite(d)); from < 0 || p.walls[from] {
+					break
+				}
+				if behind := p.next(from, opposite(d)); behind < 0 || p.walls[behind] {
+					continue
+				}
+				if dist[from] < 0 {
+					dist[from] = dist[cell] + 1
+					q = append(q, from)
+				}
+			}
+		}
+	}
+
+	for cell := range dist {
+		if dist[cell] < 0 {
+			dist[cell] = 0
+		}
+	}
+
+	return dist
+}
+
+// reachable returns the cells the player can walk to from `start`
+// without pushing any block.
+func (p *Problem) reachable(boxes []int, start int) []bool {
+	// This runs for every state, so blocks are marked once rather than
+	// looked up for every cell.
+	blocked := make([]bool, len(p.walls))
+	copy(blocked, p.walls)
+	for _, box := range boxes {
+		blocked[box] = true
+	}
+
+	reach := make([]bool, len(p.walls))
+	reach[start] = true
+	q := make([]int, 1, len(p.walls))
+	q[0] = start
+
+	for len(q) > 0 {
+		cell := q[0]
+		q = q[1:]
+
+		for _, d := range directions {
+			next := p.next(cell, d)
+			if next < 0 || reach[next] || blocked[next] {
+				continue
+			}
+			reach[next] = true
+			q = append(q, next)
+		}
+	}
+
+	return reach
+}
+
+// walk returns the shortest sequence of moves that takes the player
+// from `from` to `to` without pushing any block.
+func (p *Problem) walk(boxes []int, from, to int) string {
+	prev := make([]int, len(p.walls))
+	for i := range prev {
+		prev[i] = -1
+	}
+	prev[from] = from
+	q := []int{from}
+
+	for len(q) > 0 && prev[to] < 0 {
+		cell := q[0]
+		q = q[1:]
+
+		for _, d := range directions {
+			next := p.next(cell, d)
+			if next < 0 || prev[next] >= 0 || p.walls[next] || hasBox(boxes, next) {
+				continue
+			}
+			prev[next] = cell
+			q = append(q, next)
+		}
+	}
+
+	var moves []rune
+	for cell := to; cell != from; cell = prev[cell] {
+		moves = append(moves, lurd[p.direction(prev[cell], cell)])
+	}
+	for i, j := 0, len(moves)-1; i < j; i, j = i+1, j-1 {
+		moves[i], moves[j] = moves[j], moves[i]
+	}
+
+	return string(moves)
+}
+
+// key identifies a state by the positions of its blocks and the area
+// where the player is, since the player can move freely within it.
+func (p *Problem) key(boxes []int, reach []bool) string {
+	var sb strings.Builder
+	for _, box := range boxes {
+		writeInt(&sb, box)
+	}
+	for cell, ok := range reach {
+		if ok {
+			writeInt(&sb, cell)
+			break
+		}
+	}
+
+	return sb.String()
+}
+
+func writeInt(sb *strings.Builder, n int) {
+	sb.WriteByte(byte(n >> 24))
+	sb.WriteByte(byte(n >> 16))
+	sb.WriteByte(byte(n >> 8))
+	sb.WriteByte(byte(n))
+}
+
+// next returns the cell adjacent to `cell` in direction d, or -1 if
+// it falls outside the board.
+func (p *Problem) next(cell int, d game.Direction) int {
+	row, col := cell/p.width, cell%p.width
+
+	switch d {
+	case game.Up:
+		row--
+	case game.Down:
+		row++
+	case game.Left:
+		col--
+	case game.Right:
+		col++
+	}
+
+	if row < 0 || row >= p.height || col < 0 || col >= p.width {
+		return -1
+	}
+
+	return row*p.width + col
+}
+
+// direction returns the direction that takes the player from a cell
+// to an adjacent one.
+func (p *Problem) direction(from, to int) game.Direction {
+	switch to - from {
+	case -p.width:
+		return game.Up
+	case p.width:
+		return game.Down
+	case -1:
+		return game.Left
+	}
+
+	return game.Right
+}
+
+var lurd = map[game.Direction]rune{
+	game.Up:    'u',
+	game.Down:  'd',
+	game.Left:  'l',
+	game.Right: 'r',
+}
+
+func opposite(d game.Direction) game.Direction {
+	switch d {
+	case game.Up:
+		return game.Down
+	case game.Down:
+		return game.Up
+	case game.Left:
+		return game.Right
+	}
+
+	return game.Left
+}
+
+func stopped(stop <-chan struct{}) bool {
+	select {
+	case <-stop:
+		return true
+	default:
+		return false
+	}
+}
+
+func hasBox(boxes []int, cell int) bool {
+	i := sort.SearchInts(boxes, cell)
+	return i < len(boxes) && boxes[i] == cell
+}
+
+///-----------------------------------------
+///          Search queue
+
+type node struct {
+	boxes  []int
+	player int // Cell of the player, right after the push
+	parent *node
+	dir    game.Direction // Direction of the push that led to this state
+	g, f   int            // Pushes so far, and with the heuristic added
+}
+
+// queue is a priority queue of nodes, ordered by f. Among nodes with
+// the same f, the deepest ones come first.
+type queue []*node
+
+func (q queue) Len() int { return len(q) }
+
+func (q queue) Less(i, j int) bool {
+	if q[i].f != q[j].f {
+		return q[i].f < q[j].f
+	}
+	return q[i].g > q[j].g
+}
+
+func (q queue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *queue) Push(x interface{}) { *q = append(*q, x.(*node)) }
+
+func (q *queue) Pop() interface{} {
+	old := *q
+	n := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return n
+}
@@ -0,0 +1,204 @@
+// MIT License
+//
+// Copyright (c) 2020 Pedro Rodrigues
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package solver
+
+import (
+	"testing"
+
+	"github.com/csixteen/sokoban/pkg/game"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSolve(t *testing.T) {
+	levels := [][]string{
+		{
+			"wwwwwwww",
+			"wffffffw",
+			"wfffwffw",
+			"wwwffffw",
+			"wgwbfffw",
+			"wbgffffw",
+			"wffkfffw",
+			"wwwwwwww",
+		},
+		{
+			"wwwwwwww",
+			"wffwfffw",
+			"wjbggbfw",
+			"wfbgbffw",
+			"wffggbfw",
+			"wffffffw",
+			"wfwwfwww",
+			"wwwwwwww",
+		},
+	}
+
+	for _, data := range levels {
+		board, err := game.NewBoard(data)
+		assert.NoError(t, err)
+
+		moves, stats, err := Solve(board, Options{})
+		assert.NoError(t, err)
+		assert.True(t, stats.Nodes > 0)
+
+		board, err = game.NewBoard(data)
+		assert.NoError(t, err)
+		solved, err := game.Replay(board, moves)
+		assert.NoError(t, err)
+		assert.True(t, solved, moves)
+		assert.Equal(t, board.Pushes(), stats.Depth)
+	}
+}
+
+func TestSolvePushOptimal(t *testing.T) {
+	data := []string{
+		"#######",
+		"#     #",
+		"# @$. #",
+		"#     #",
+		"#######",
+	}
+
+	board, err := game.NewBoardFromXSB(data)
+	assert.NoError(t, err)
+
+	moves, stats, err := Solve(board, Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, "R", moves)
+	assert.Equal(t, 1, stats.Depth)
+}
+
+func TestSolveLineOfBlocks(t *testing.T) {
+	data := []string{
+		"########",
+		"#@$$.. #",
+		"########",
+	}
+
+	board, err := game.NewBoardFromXSB(data)
+	assert.NoError(t, err)
+
+	moves, stats, err := Solve(board, Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, "RR", moves)
+	assert.Equal(t, 2, stats.Depth, "Pushing a line of blocks should count as a single push")
+}
+
+func TestSolveLineOfBlocksOptimal(t *testing.T) {
+	data := []string{
+		"######",
+		"#.. ##",
+		"# $$ #",
+		"#   @#",
+		"######",
+	}
+
+	board, err := game.NewBoardFromXSB(data)
+	assert.NoError(t, err)
+
+	moves, stats, err := Solve(board, Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, stats.Depth, "Both blocks should be pushed left at once")
+
+	solved, err := game.Replay(board, moves)
+	assert.NoError(t, err)
+	assert.True(t, solved, moves)
+	assert.Equal(t, 3, board.Pushes())
+}
+
+func TestSolveFromCurrentState(t *testing.T) {
+	data := []string{
+		"#######",
+		"#@ $ .#",
+		"#######",
+	}
+
+	board, err := game.NewBoardFromXSB(data)
+	assert.NoError(t, err)
+	board.MoveRight()
+
+	moves, _, err := Solve(board, Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, "RR", moves)
+}
+
+func TestSolveUnsolvable(t *testing.T) {
+	data := []string{
+		"######",
+		"#@  .#",
+		"#   ##",
+		"#  $ #",
+		"######",
+	}
+
+	board, err := game.NewBoardFromXSB(data)
+	assert.NoError(t, err)
+
+	_, _, err = Solve(board, Options{})
+	assert.Equal(t, ErrNoSolution, err)
+}
+
+func TestSolveDeadBlock(t *testing.T) {
+	data := []string{
+		"#####",
+		"#$  #",
+		"#@ .#",
+		"#####",
+	}
+
+	board, err := game.NewBoardFromXSB(data)
+	assert.NoError(t, err)
+
+	_, stats, err := Solve(board, Options{})
+	assert.Equal(t, ErrNoSolution, err)
+	assert.Equal(t, 0, stats.Nodes, "A block stuck in a corner should give up right away")
+}
+
+func TestSolveLimits(t *testing.T) {
+	data := []string{
+		"wwwwwwww",
+		"wffwfffw",
+		"wjbggbfw",
+		"wfbgbffw",
+		"wffggbfw",
+		"wffffffw",
+		"wfwwfwww",
+		"wwwwwwww",
+	}
+
+	board, err := game.NewBoard(data)
+	assert.NoError(t, err)
+
+	_, stats, err := Solve(board, Options{MaxNodes: 3})
+	assert.Equal(t, ErrNodeLimit, err)
+	assert.Equal(t, 4, stats.Nodes)
+
+	_, _, err = Solve(board, Options{MaxMemory: 1024})
+	assert.Equal(t, ErrMemoryLimit, err)
+
+	stop := make(chan struct{})
+	close(stop)
+	_, stats, err = Solve(board, Options{Stop: stop})
+	assert.Equal(t, ErrStopped, err)
+	assert.Equal(t, 1, stats.Nodes)
+}
@@ -61,3 +61,13 @@ func (s *Stack) Top() (rune, error) {
 
 	return s.s[l-1], nil
 }
+
+func (s *Stack) Contains(v rune) bool {
+	for _, e := range s.s {
+		if e == v {
+			return true
+		}
+	}
+
+	return false
+}
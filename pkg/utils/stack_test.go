@@ -61,3 +61,14 @@ func TestTopFromEmptyStack(t *testing.T) {
 	_, err := s.Top()
 	assert.Error(t, err)
 }
+
+func TestStackContains(t *testing.T) {
+	s := NewStack()
+
+	s.Push('f')
+	s.Push('g')
+
+	assert.True(t, s.Contains('f'))
+	assert.True(t, s.Contains('g'))
+	assert.False(t, s.Contains('w'))
+}